@@ -0,0 +1,96 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+// cloudflare-go doesn't support the SaaS configuration of Access Applications
+// yet so applications are created, read and updated here using the raw client.
+
+// accessApplication is an Access Application along with the configuration of
+// a SaaS application, which is only set for the `saas` type.
+type accessApplication struct {
+	cloudflare.AccessApplication
+	SaasApplication *accessApplicationSaasApp `json:"saas_app,omitempty"`
+}
+
+// accessApplicationSaasApp is the configuration of a SaaS application using
+// Access as its SAML or OIDC identity provider.
+type accessApplicationSaasApp struct {
+	AuthType string `json:"auth_type,omitempty"`
+
+	// SAML
+	SPEntityID         string                                 `json:"sp_entity_id,omitempty"`
+	ConsumerServiceURL string                                 `json:"consumer_service_url,omitempty"`
+	NameIDFormat       string                                 `json:"name_id_format,omitempty"`
+	CustomAttributes   []accessApplicationSaasCustomAttribute `json:"custom_attributes,omitempty"`
+	IDPEntityID        string                                 `json:"idp_entity_id,omitempty"`
+	SSOEndpoint        string                                 `json:"sso_endpoint,omitempty"`
+	PublicKey          string                                 `json:"public_key,omitempty"`
+
+	// OIDC
+	RedirectURIs []string `json:"redirect_uris,omitempty"`
+	GrantTypes   []string `json:"grant_types,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+	ClientID     string   `json:"client_id,omitempty"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+}
+
+// accessApplicationSaasCustomAttribute is a SAML attribute sent to the SaaS
+// application, its value is taken from the named attribute of the identity
+// provider the user authenticated with.
+type accessApplicationSaasCustomAttribute struct {
+	Name       string `json:"name"`
+	NameFormat string `json:"name_format,omitempty"`
+	Source     struct {
+		Name string `json:"name"`
+	} `json:"source"`
+}
+
+func accessApplicationsURI(identifier *AccessIdentifier) string {
+	if identifier.Type == AccountType {
+		return fmt.Sprintf("/accounts/%s/access/apps", identifier.Value)
+	}
+	return fmt.Sprintf("/zones/%s/access/apps", identifier.Value)
+}
+
+func fetchAccessApplication(ctx context.Context, client *providerClient, identifier *AccessIdentifier, applicationID string) (accessApplication, error) {
+	res, err := client.request(ctx, http.MethodGet, accessApplicationsURI(identifier)+"/"+applicationID, nil)
+	if err != nil {
+		return accessApplication{}, err
+	}
+
+	return unmarshalAccessApplication(res)
+}
+
+func createAccessApplication(ctx context.Context, client *providerClient, identifier *AccessIdentifier, application accessApplication) (accessApplication, error) {
+	res, err := client.request(ctx, http.MethodPost, accessApplicationsURI(identifier), application)
+	if err != nil {
+		return accessApplication{}, err
+	}
+
+	return unmarshalAccessApplication(res)
+}
+
+func updateAccessApplication(ctx context.Context, client *providerClient, identifier *AccessIdentifier, application accessApplication) (accessApplication, error) {
+	res, err := client.request(ctx, http.MethodPut, accessApplicationsURI(identifier)+"/"+application.ID, application)
+	if err != nil {
+		return accessApplication{}, err
+	}
+
+	return unmarshalAccessApplication(res)
+}
+
+func unmarshalAccessApplication(res json.RawMessage) (accessApplication, error) {
+	var result accessApplication
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling Access Application: %w", err)
+	}
+
+	return result, nil
+}
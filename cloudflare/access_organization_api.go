@@ -0,0 +1,123 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// cloudflare-go only supports the name, auth domain and part of the login
+// design of Access organizations and doesn't support custom pages yet so the
+// endpoints are called here using the raw client.
+
+// accessOrganization is the Zero Trust organization of an account or zone.
+type accessOrganization struct {
+	Name                           string                        `json:"name,omitempty"`
+	AuthDomain                     string                        `json:"auth_domain"`
+	LoginDesign                    accessOrganizationLoginDesign `json:"login_design"`
+	IsUIReadOnly                   bool                          `json:"is_ui_read_only"`
+	UserSeatExpirationInactiveTime string                        `json:"user_seat_expiration_inactive_time,omitempty"`
+	AutoRedirectToIdentity         bool                          `json:"auto_redirect_to_identity"`
+	CustomPages                    accessOrganizationCustomPages `json:"custom_pages"`
+}
+
+type accessOrganizationLoginDesign struct {
+	BackgroundColor string `json:"background_color,omitempty"`
+	TextColor       string `json:"text_color,omitempty"`
+	LogoPath        string `json:"logo_path,omitempty"`
+	HeaderText      string `json:"header_text,omitempty"`
+	FooterText      string `json:"footer_text,omitempty"`
+}
+
+// accessOrganizationCustomPages are the IDs of the custom pages shown instead
+// of the default ones.
+type accessOrganizationCustomPages struct {
+	Forbidden      string `json:"forbidden,omitempty"`
+	IdentityDenied string `json:"identity_denied,omitempty"`
+}
+
+// accessCustomPage is a custom page shown when a user is denied access.
+type accessCustomPage struct {
+	ID         string `json:"uid,omitempty"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	CustomHTML string `json:"custom_html"`
+	AppCount   int    `json:"app_count,omitempty"`
+}
+
+func accessOrganizationURI(identifier *AccessIdentifier) string {
+	if identifier.Type == AccountType {
+		return fmt.Sprintf("/accounts/%s/access/organizations", identifier.Value)
+	}
+	return fmt.Sprintf("/zones/%s/access/organizations", identifier.Value)
+}
+
+func accessCustomPagesURI(identifier *AccessIdentifier) string {
+	if identifier.Type == AccountType {
+		return fmt.Sprintf("/accounts/%s/access/custom_pages", identifier.Value)
+	}
+	return fmt.Sprintf("/zones/%s/access/custom_pages", identifier.Value)
+}
+
+func fetchAccessOrganization(ctx context.Context, client *providerClient, identifier *AccessIdentifier) (accessOrganization, error) {
+	var result accessOrganization
+
+	res, err := client.request(ctx, http.MethodGet, accessOrganizationURI(identifier), nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling Access Organization: %w", err)
+	}
+
+	return result, nil
+}
+
+func createAccessOrganization(ctx context.Context, client *providerClient, identifier *AccessIdentifier, organization accessOrganization) error {
+	_, err := client.request(ctx, http.MethodPost, accessOrganizationURI(identifier), organization)
+	return err
+}
+
+func updateAccessOrganization(ctx context.Context, client *providerClient, identifier *AccessIdentifier, organization accessOrganization) error {
+	_, err := client.request(ctx, http.MethodPut, accessOrganizationURI(identifier), organization)
+	return err
+}
+
+func fetchAccessCustomPage(ctx context.Context, client *providerClient, identifier *AccessIdentifier, customPageID string) (accessCustomPage, error) {
+	res, err := client.request(ctx, http.MethodGet, accessCustomPagesURI(identifier)+"/"+customPageID, nil)
+	if err != nil {
+		return accessCustomPage{}, err
+	}
+
+	return unmarshalAccessCustomPage(res)
+}
+
+func createAccessCustomPage(ctx context.Context, client *providerClient, identifier *AccessIdentifier, customPage accessCustomPage) (accessCustomPage, error) {
+	res, err := client.request(ctx, http.MethodPost, accessCustomPagesURI(identifier), customPage)
+	if err != nil {
+		return accessCustomPage{}, err
+	}
+
+	return unmarshalAccessCustomPage(res)
+}
+
+func updateAccessCustomPage(ctx context.Context, client *providerClient, identifier *AccessIdentifier, customPage accessCustomPage) error {
+	_, err := client.request(ctx, http.MethodPut, accessCustomPagesURI(identifier)+"/"+customPage.ID, customPage)
+	return err
+}
+
+func deleteAccessCustomPage(ctx context.Context, client *providerClient, identifier *AccessIdentifier, customPageID string) error {
+	_, err := client.request(ctx, http.MethodDelete, accessCustomPagesURI(identifier)+"/"+customPageID, nil)
+	return err
+}
+
+func unmarshalAccessCustomPage(res json.RawMessage) (accessCustomPage, error) {
+	var result accessCustomPage
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling Access Custom Page: %w", err)
+	}
+
+	return result, nil
+}
@@ -1,29 +1,108 @@
 package cloudflare
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
+	"math"
+	"net/http"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
+	"golang.org/x/time/rate"
 )
 
+// defaultRateLimit is the rate of cloudflare-go when none is configured.
+const defaultRateLimit = 4
+
+// providerClient is the API client of a configured provider along with the
+// state shared by its resources. It is passed to them as their meta.
+type providerClient struct {
+	*cloudflare.API
+
+	// httpClient is the HTTP client of the API client, used to call the
+	// endpoints cloudflare-go doesn't support.
+	httpClient *http.Client
+
+	// rateLimiter and retryPolicy are the ones of cloudflare-go for the
+	// requests made with request, which cloudflare-go doesn't make.
+	rateLimiter *rate.Limiter
+	retryPolicy cloudflare.RetryPolicy
+
+	// cache holds the lookups which don't change during a run.
+	cache *providerCache
+}
+
+// APIClient returns the API client of a provider configured with meta.
+func APIClient(meta interface{}) *cloudflare.API {
+	return meta.(*providerClient).API
+}
+
 type Config struct {
 	Email             string
 	APIKey            string
 	APIUserServiceKey string
 	APIToken          string
+	APITokenFile      string
+	CredentialProcess string
+	HTTPClient        *http.Client
 	Options           []cloudflare.Option
+
+	// RateLimit is the maximum number of requests per second and RetryPolicy
+	// how failed requests are retried, both for cloudflare-go and
+	// providerClient.request.
+	RateLimit   float64
+	RetryPolicy cloudflare.RetryPolicy
+
+	tokenSource *apiTokenSource
 }
 
-// Client returns a new client for accessing cloudflare.
-func (c *Config) Client() (*cloudflare.API, error) {
+// Client returns a new client for accessing cloudflare. ctx is only used to
+// get the first API token from the token source, if any.
+func (c *Config) Client(ctx context.Context) (*providerClient, error) {
 	var err error
 	var client *cloudflare.API
 
-	if c.APIToken != "" {
-		client, err = cloudflare.NewWithAPIToken(c.APIToken, c.Options...)
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	if c.tokenSource == nil {
+		if c.APITokenFile != "" {
+			c.tokenSource = newAPITokenFileSource(c.APITokenFile)
+		} else if c.CredentialProcess != "" {
+			c.tokenSource = newCredentialProcessSource(c.CredentialProcess)
+		}
+	}
+
+	if c.tokenSource != nil {
+		// The token is set on each request by the transport so it can be
+		// refreshed without creating a new client.
+		var token string
+		token, err = c.tokenSource.Token(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		transport := httpClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+
+		refreshingClient := *httpClient
+		refreshingClient.Transport = &apiTokenTransport{transport: transport, source: c.tokenSource}
+		httpClient = &refreshingClient
+
+		client, err = cloudflare.NewWithAPIToken(token, c.clientOptions(httpClient)...)
+	} else if c.APIToken != "" {
+		client, err = cloudflare.NewWithAPIToken(c.APIToken, c.clientOptions(httpClient)...)
 	} else {
-		client, err = cloudflare.New(c.APIKey, c.Email, c.Options...)
+		client, err = cloudflare.New(c.APIKey, c.Email, c.clientOptions(httpClient)...)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("Error creating new Cloudflare client: %s", err)
@@ -34,5 +113,152 @@ func (c *Config) Client() (*cloudflare.API, error) {
 	}
 
 	log.Printf("[INFO] Cloudflare Client configured for user: %s", c.Email)
-	return client, nil
+	return &providerClient{
+		API:         client,
+		httpClient:  httpClient,
+		rateLimiter: rate.NewLimiter(rate.Limit(c.rateLimit()), 1),
+		retryPolicy: c.RetryPolicy,
+		cache:       newProviderCache(),
+	}, nil
+}
+
+func (c *Config) clientOptions(httpClient *http.Client) []cloudflare.Option {
+	return append(append([]cloudflare.Option{}, c.Options...),
+		cloudflare.HTTPClient(httpClient),
+		cloudflare.UsingRateLimit(c.rateLimit()),
+		cloudflare.UsingRetryPolicy(c.RetryPolicy.MaxRetries, int(c.RetryPolicy.MinRetryDelay/time.Second), int(c.RetryPolicy.MaxRetryDelay/time.Second)),
+	)
+}
+
+func (c *Config) rateLimit() float64 {
+	if c.RateLimit <= 0 {
+		return defaultRateLimit
+	}
+	return c.RateLimit
+}
+
+// request calls an endpoint of the API and returns the result of the response.
+// It is used for the endpoints cloudflare-go doesn't support and, unlike
+// cloudflare.API.Raw, is cancelled along with ctx. Requests are rate limited
+// and retried on errors the same way as cloudflare-go.
+func (c *providerClient) request(ctx context.Context, method, endpoint string, params interface{}) (json.RawMessage, error) {
+	return c.requestWithHeaders(ctx, method, endpoint, params, nil)
+}
+
+// requestWithHeaders is request with additional headers, e.g. to send a body
+// which isn't JSON. A []byte params is sent as is.
+func (c *providerClient) requestWithHeaders(ctx context.Context, method, endpoint string, params interface{}, headers http.Header) (json.RawMessage, error) {
+	var body []byte
+	switch params := params.(type) {
+	case nil:
+	case []byte:
+		body = params
+	default:
+		var err error
+		body, err = json.Marshal(params)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling params to JSON: %w", err)
+		}
+	}
+
+	var resp *http.Response
+	var respBody []byte
+	for i := 0; i <= c.retryPolicy.MaxRetries; i++ {
+		if i > 0 {
+			delay := time.Duration(math.Pow(2, float64(i-1)) * float64(c.retryPolicy.MinRetryDelay))
+			if delay > c.retryPolicy.MaxRetryDelay {
+				delay = c.retryPolicy.MaxRetryDelay
+			}
+			log.Printf("[DEBUG] Sleeping %s before retry attempt number %d for request %s %s", delay, i, method, endpoint)
+
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, fmt.Errorf("operation aborted during backoff: %w", ctx.Err())
+			}
+		}
+
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("error caused by request rate limiting: %w", err)
+		}
+
+		var err error
+		resp, respBody, err = c.do(ctx, method, endpoint, body, headers)
+		if err != nil {
+			if ctx.Err() != nil || i == c.retryPolicy.MaxRetries {
+				return nil, err
+			}
+			log.Printf("[DEBUG] Error performing request %s %s: %s", method, endpoint, err)
+			continue
+		}
+
+		// Retry when the API is rate limiting or failed, assuming failed
+		// operations are rolled back.
+		if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < http.StatusInternalServerError {
+			break
+		}
+		log.Printf("[DEBUG] Request %s %s got an error response %d", method, endpoint, resp.StatusCode)
+	}
+
+	// Errors are returned the same way as cloudflare-go so they can be handled
+	// alike, e.g. by looking for "HTTP status 404".
+	var r cloudflare.RawResponse
+	if resp.StatusCode >= http.StatusBadRequest {
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return nil, fmt.Errorf("HTTP status %d: service failure", resp.StatusCode)
+		}
+		if err := json.Unmarshal(respBody, &r); err != nil {
+			return nil, fmt.Errorf("HTTP status %d: error unmarshalling the JSON response error body: %w", resp.StatusCode, err)
+		}
+		return nil, &cloudflare.APIRequestError{StatusCode: resp.StatusCode, Errors: r.Errors}
+	}
+
+	if err := json.Unmarshal(respBody, &r); err != nil {
+		return nil, fmt.Errorf("error unmarshalling the JSON response: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// do makes a single request and returns its response along with its body.
+func (c *providerClient) do(ctx context.Context, method, endpoint string, body []byte, headers http.Header) (*http.Response, []byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+endpoint, reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("HTTP request creation failed: %w", err)
+	}
+
+	for name, values := range headers {
+		req.Header[name] = values
+	}
+
+	if c.APIToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.APIToken)
+	} else {
+		req.Header.Set("X-Auth-Key", c.APIKey)
+		req.Header.Set("X-Auth-Email", c.APIEmail)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read response body: %w", err)
+	}
+
+	return resp, respBody, nil
 }
@@ -0,0 +1,136 @@
+package cloudflare
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+func testProviderClient(t *testing.T, handler http.HandlerFunc) *providerClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config := Config{
+		APIToken:   "token",
+		HTTPClient: server.Client(),
+		Options:    []cloudflare.Option{cloudflare.BaseURL(server.URL), cloudflare.UserAgent("terraform-provider-cloudflare/test")},
+		RateLimit:  100,
+		// Retries don't wait so the tests stay fast.
+		RetryPolicy: cloudflare.RetryPolicy{MaxRetries: 2},
+	}
+
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestProviderClientRequest(t *testing.T) {
+	client := testProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		switch {
+		case r.Header.Get("Authorization") != "Bearer token":
+			w.WriteHeader(http.StatusForbidden)
+		case r.Header.Get("User-Agent") != "terraform-provider-cloudflare/test":
+			w.WriteHeader(http.StatusBadRequest)
+		case r.URL.Path == "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"success":false,"errors":[{"code":1001,"message":"not found"}],"result":null}`))
+		case r.Header.Get("Content-Type") == "text/plain":
+			w.Write([]byte(`{"success":true,"errors":[],"result":"` + string(body) + `"}`))
+		default:
+			w.Write([]byte(`{"success":true,"errors":[],"result":` + string(body) + `}`))
+		}
+	})
+	ctx := context.Background()
+
+	res, err := client.request(ctx, http.MethodPost, "/echo", map[string]string{"name": "example"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res) != `{"name":"example"}` {
+		t.Errorf("expected the result of the response, got %s", res)
+	}
+
+	headers := http.Header{"Content-Type": []string{"text/plain"}}
+	res, err = client.requestWithHeaders(ctx, http.MethodPut, "/echo", []byte("plain"), headers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res) != `"plain"` {
+		t.Errorf("expected the body to be sent as is, got %s", res)
+	}
+
+	_, err = client.request(ctx, http.MethodGet, "/missing", nil)
+	if err == nil || !strings.Contains(err.Error(), "HTTP status 404: not found (1001)") {
+		t.Errorf("expected the API error to be returned, got %v", err)
+	}
+}
+
+func TestProviderClientRequestCancelled(t *testing.T) {
+	client := testProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected no request to be made")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.request(ctx, http.MethodGet, "/zones", nil); err == nil || !strings.Contains(err.Error(), context.Canceled.Error()) {
+		t.Errorf("expected the request to be cancelled, got %v", err)
+	}
+}
+
+func TestProviderClientRequestRetried(t *testing.T) {
+	var attempts int
+	client := testProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+
+		switch {
+		case string(body) != `{"name":"example"}`:
+			w.WriteHeader(http.StatusBadRequest)
+		case attempts == 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"success":false,"errors":[{"code":10000,"message":"rate limited"}],"result":null}`))
+		case attempts == 2:
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.Write([]byte(`{"success":true,"errors":[],"result":` + string(body) + `}`))
+		}
+	})
+
+	res, err := client.request(context.Background(), http.MethodPost, "/echo", map[string]string{"name": "example"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res) != `{"name":"example"}` {
+		t.Errorf("expected the result of the response, got %s", res)
+	}
+	if attempts != 3 {
+		t.Errorf("expected the request to be retried twice with the same body, got %d attempts", attempts)
+	}
+}
+
+func TestProviderClientRequestRetriesExhausted(t *testing.T) {
+	var attempts int
+	client := testProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.request(context.Background(), http.MethodGet, "/zones", nil)
+	if err == nil || !strings.Contains(err.Error(), "HTTP status 500: service failure") {
+		t.Errorf("expected a service failure, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected the request and 2 retries, got %d attempts", attempts)
+	}
+}
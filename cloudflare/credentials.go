@@ -0,0 +1,185 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// credentialRefreshWindow is how long before it expires a token is refreshed
+// so requests in flight don't fail.
+const credentialRefreshWindow = 5 * time.Minute
+
+// apiTokenSource provides the API token used for each request. Tokens are
+// fetched again once they are about to expire or have been rejected.
+type apiTokenSource struct {
+	description string
+	fetch       func(ctx context.Context) (string, time.Time, error)
+	now         func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// newAPITokenFileSource reads the token from a file, e.g. one kept up to date
+// by a secret manager. The file is read again when the API rejects the token.
+func newAPITokenFileSource(path string) *apiTokenSource {
+	return &apiTokenSource{
+		description: fmt.Sprintf("API token file %s", path),
+		now:         time.Now,
+		fetch: func(ctx context.Context) (string, time.Time, error) {
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				return "", time.Time{}, fmt.Errorf("error reading API token file: %s", err)
+			}
+
+			token := strings.TrimSpace(string(content))
+			if token == "" {
+				return "", time.Time{}, fmt.Errorf("API token file %s is empty", path)
+			}
+
+			return token, time.Time{}, nil
+		},
+	}
+}
+
+// credentialProcessOutput is the JSON the credential process writes to
+// stdout. The expiration is optional, tokens without one are only fetched
+// again when the API rejects them.
+type credentialProcessOutput struct {
+	Token      string    `json:"token"`
+	Expiration time.Time `json:"expiration"`
+}
+
+// newCredentialProcessSource runs the command to fetch the token.
+func newCredentialProcessSource(command string) *apiTokenSource {
+	return &apiTokenSource{
+		description: "credential process",
+		now:         time.Now,
+		fetch: func(ctx context.Context) (string, time.Time, error) {
+			var cmd *exec.Cmd
+			if runtime.GOOS == "windows" {
+				cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+			} else {
+				cmd = exec.CommandContext(ctx, "sh", "-c", command)
+			}
+
+			var stdout, stderr bytes.Buffer
+			cmd.Stdout = &stdout
+			cmd.Stderr = &stderr
+
+			if err := cmd.Run(); err != nil {
+				return "", time.Time{}, fmt.Errorf("error running credential process: %s: %s", err, strings.TrimSpace(stderr.String()))
+			}
+
+			var output credentialProcessOutput
+			if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+				return "", time.Time{}, fmt.Errorf("error parsing credential process output: %s", err)
+			}
+
+			if output.Token == "" {
+				return "", time.Time{}, fmt.Errorf("credential process returned no token")
+			}
+
+			return output.Token, output.Expiration, nil
+		},
+	}
+}
+
+// Token returns the current token, fetching a new one if there is none yet or
+// it's about to expire.
+func (s *apiTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expires.IsZero() || s.now().Before(s.expires.Add(-credentialRefreshWindow))) {
+		return s.token, nil
+	}
+
+	log.Printf("[DEBUG] Fetching Cloudflare API token from %s", s.description)
+
+	token, expires, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	s.token = token
+	s.expires = expires
+
+	if !expires.IsZero() {
+		log.Printf("[DEBUG] Cloudflare API token from %s expires at %s", s.description, expires.Format(time.RFC3339))
+	}
+
+	return token, nil
+}
+
+// invalidate drops the token if it's still the given one so the next call to
+// Token fetches a new one.
+func (s *apiTokenSource) invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == token {
+		s.token = ""
+	}
+}
+
+// apiTokenTransport authenticates each request with the token of the source.
+// Requests rejected with a 401 are retried once with a newly fetched token.
+type apiTokenTransport struct {
+	transport http.RoundTripper
+	source    *apiTokenSource
+}
+
+func (t *apiTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(authorizedRequest(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	t.source.invalidate(token)
+	refreshed, err := t.source.Token(req.Context())
+	if err != nil || refreshed == token {
+		return resp, nil
+	}
+
+	log.Printf("[DEBUG] Retrying Cloudflare API request with a refreshed API token from %s", t.source.description)
+
+	retry := authorizedRequest(req, refreshed)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+
+	resp.Body.Close()
+
+	return t.transport.RoundTrip(retry)
+}
+
+func authorizedRequest(req *http.Request, token string) *http.Request {
+	authorized := req.Clone(req.Context())
+	authorized.Header.Set("Authorization", "Bearer "+token)
+	return authorized
+}
@@ -0,0 +1,144 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPITokenFileSourceRefreshedOnUnauthorized(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(path, []byte("old-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := "old-token"
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s", r.Header.Get("Authorization"), body))
+
+		if r.Header.Get("Authorization") != "Bearer "+valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &apiTokenTransport{
+		transport: http.DefaultTransport,
+		source:    newAPITokenFileSource(path),
+	}}
+
+	post := func() int {
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if status := post(); status != http.StatusOK {
+		t.Errorf("expected the token from the file to be used but got %d", status)
+	}
+
+	// Rotate the token.
+	valid = "new-token"
+	if err := ioutil.WriteFile(path, []byte("new-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if status := post(); status != http.StatusOK {
+		t.Errorf("expected the request to be retried with the new token but got %d", status)
+	}
+
+	// Rotate the token without updating the file.
+	valid = "newer-token"
+
+	if status := post(); status != http.StatusUnauthorized {
+		t.Errorf("expected the rejected token to be returned as is but got %d", status)
+	}
+
+	expected := []string{
+		"Bearer old-token {}",
+		"Bearer old-token {}",
+		"Bearer new-token {}",
+		"Bearer new-token {}",
+	}
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected requests %v but got %v", expected, requests)
+	}
+}
+
+func TestCredentialProcessSource(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the credential process test uses a shell script")
+	}
+
+	dir := t.TempDir()
+	counter := filepath.Join(dir, "counter")
+	script := filepath.Join(dir, "credentials.sh")
+	content := fmt.Sprintf(`#!/bin/sh
+echo x >> %[1]s
+echo '{"token": "token-'$(wc -l < %[1]s | tr -d ' ')'", "expiration": "2021-10-01T13:00:00Z"}'
+`, counter)
+	if err := ioutil.WriteFile(script, []byte(content), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	source := newCredentialProcessSource(script)
+	source.now = func() time.Time { return now }
+
+	for _, step := range []struct {
+		at       time.Time
+		expected string
+	}{
+		{now, "token-1"},
+		{now.Add(50 * time.Minute), "token-1"},
+		{now.Add(56 * time.Minute), "token-2"},
+	} {
+		now = step.at
+		token, err := source.Token(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != step.expected {
+			t.Errorf("expected %q at %s but got %q", step.expected, step.at, token)
+		}
+	}
+}
+
+func TestCredentialProcessSourceErrors(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the credential process test uses a shell command")
+	}
+
+	for command, expected := range map[string]string{
+		"exit 1":                      "error running credential process",
+		"echo not json":               "error parsing credential process output",
+		`echo '{"expiration": null}'`: "credential process returned no token",
+	} {
+		_, err := newCredentialProcessSource(command).Token(context.Background())
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected %q to fail with %q but got %v", command, expected, err)
+		}
+	}
+}
+
+func TestAPITokenFileSourceMissingFile(t *testing.T) {
+	_, err := newAPITokenFileSource(filepath.Join(os.TempDir(), "does-not-exist", "token")).Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "error reading API token file") {
+		t.Errorf("expected an error reading the file but got %v", err)
+	}
+}
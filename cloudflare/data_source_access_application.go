@@ -0,0 +1,138 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessApplication() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareAccessApplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"account_id", "zone_id"},
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"account_id", "zone_id"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "domain"},
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "domain"},
+			},
+			"aud": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"session_duration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allowed_idps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareAccessApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	domain := d.Get("domain").(string)
+
+	log.Printf("[DEBUG] Reading Access Applications")
+	applications, err := accessApplications(ctx, client, identifier)
+	if err != nil {
+		return diag.Errorf("error listing Access Applications: %s", err)
+	}
+
+	var matches []cloudflare.AccessApplication
+	for _, application := range applications {
+		if (name != "" && application.Name == name) || (domain != "" && application.Domain == domain) {
+			matches = append(matches, application)
+		}
+	}
+
+	lookup := fmt.Sprintf("name %q", name)
+	if domain != "" {
+		lookup = fmt.Sprintf("domain %q", domain)
+	}
+
+	if len(matches) == 0 {
+		return diag.Errorf("no Access Application found with %s", lookup)
+	}
+
+	if len(matches) > 1 {
+		return diag.Errorf("more than one Access Application found with %s", lookup)
+	}
+
+	application := matches[0]
+	d.SetId(application.ID)
+	d.Set("name", application.Name)
+	d.Set("domain", application.Domain)
+	d.Set("aud", application.AUD)
+	d.Set("type", string(application.Type))
+	d.Set("session_duration", application.SessionDuration)
+	d.Set("allowed_idps", application.AllowedIdps)
+
+	return nil
+}
+
+// accessApplications returns all Access Applications of the account or zone,
+// going through every page of the results.
+func accessApplications(ctx context.Context, client *providerClient, identifier *AccessIdentifier) ([]cloudflare.AccessApplication, error) {
+	var applications []cloudflare.AccessApplication
+	pageOpts := cloudflare.PaginationOptions{Page: 1, PerPage: 100}
+
+	for {
+		var page []cloudflare.AccessApplication
+		var resultInfo cloudflare.ResultInfo
+		var err error
+		if identifier.Type == AccountType {
+			page, resultInfo, err = client.AccessApplications(ctx, identifier.Value, pageOpts)
+		} else {
+			page, resultInfo, err = client.ZoneLevelAccessApplications(ctx, identifier.Value, pageOpts)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		applications = append(applications, page...)
+
+		if pageOpts.Page >= resultInfo.TotalPages {
+			return applications, nil
+		}
+		pageOpts.Page++
+	}
+}
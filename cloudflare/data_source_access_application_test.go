@@ -0,0 +1,50 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccessApplicationDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_access_application.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationDataSourceConfig(rnd, domain, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", "cloudflare_access_application."+rnd, "id"),
+					resource.TestCheckResourceAttrPair(name, "aud", "cloudflare_access_application."+rnd, "aud"),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "domain", fmt.Sprintf("%s.%s", rnd, domain)),
+					resource.TestCheckResourceAttr(name, "type", "self_hosted"),
+					resource.TestCheckResourceAttr(name, "session_duration", "24h"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessApplicationDataSourceConfig(rnd, domain, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id       = "%[3]s"
+  name             = "%[1]s"
+  domain           = "%[1]s.%[2]s"
+  type             = "self_hosted"
+  session_duration = "24h"
+}
+
+data "cloudflare_access_application" "%[1]s" {
+  account_id = "%[3]s"
+  domain     = cloudflare_access_application.%[1]s.domain
+}`, rnd, domain, accountID)
+}
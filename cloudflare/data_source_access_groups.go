@@ -0,0 +1,157 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareAccessGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"account_id", "zone_id"},
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"account_id", "zone_id"},
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareAccessGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter, err := expandFilterAccessGroups(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Reading Access Groups")
+	groups, err := accessGroups(ctx, client, identifier)
+	if err != nil {
+		return diag.Errorf("error listing Access Groups: %s", err)
+	}
+
+	groupIds := make([]string, 0)
+	groupDetails := make([]interface{}, 0)
+	for _, group := range groups {
+		if filter.Name != nil && !filter.Name.MatchString(group.Name) {
+			continue
+		}
+
+		groupDetails = append(groupDetails, map[string]interface{}{
+			"id":   group.ID,
+			"name": group.Name,
+		})
+		groupIds = append(groupIds, group.ID)
+	}
+
+	err = d.Set("groups", groupDetails)
+	if err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("groups"), fmt.Errorf("error setting Access Groups: %s", err))
+	}
+
+	d.SetId(stringListChecksum(groupIds))
+	return nil
+}
+
+func expandFilterAccessGroups(d *schema.ResourceData) (*searchFilterAccessGroups, error) {
+	filter := &searchFilterAccessGroups{}
+
+	cfg := d.Get("filter").([]interface{})
+	if len(cfg) == 0 || cfg[0] == nil {
+		return filter, nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+	if name, ok := m["name"]; ok && name.(string) != "" {
+		match, err := regexp.Compile(name.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Name = match
+	}
+
+	return filter, nil
+}
+
+type searchFilterAccessGroups struct {
+	Name *regexp.Regexp
+}
+
+// accessGroups returns all Access Groups of the account or zone, going
+// through every page of the results.
+func accessGroups(ctx context.Context, client *providerClient, identifier *AccessIdentifier) ([]cloudflare.AccessGroup, error) {
+	var groups []cloudflare.AccessGroup
+	pageOpts := cloudflare.PaginationOptions{Page: 1, PerPage: 100}
+
+	for {
+		var page []cloudflare.AccessGroup
+		var resultInfo cloudflare.ResultInfo
+		var err error
+		if identifier.Type == AccountType {
+			page, resultInfo, err = client.AccessGroups(ctx, identifier.Value, pageOpts)
+		} else {
+			page, resultInfo, err = client.ZoneLevelAccessGroups(ctx, identifier.Value, pageOpts)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, page...)
+
+		if pageOpts.Page >= resultInfo.TotalPages {
+			return groups, nil
+		}
+		pageOpts.Page++
+	}
+}
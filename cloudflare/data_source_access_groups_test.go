@@ -0,0 +1,54 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccessGroups(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_access_groups.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessGroupsConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "groups.0.id", "cloudflare_access_group."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "groups.0.name", rnd),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessGroupsConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_group" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+
+  include {
+    email = ["test@example.com"]
+  }
+}
+
+data "cloudflare_access_groups" "%[1]s" {
+  account_id = "%[2]s"
+
+  filter {
+    name = "^%[1]s$"
+  }
+
+  depends_on = [cloudflare_access_group.%[1]s]
+}`, rnd, accountID)
+}
@@ -0,0 +1,160 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessIdentityProviders() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareAccessIdentityProvidersRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"account_id", "zone_id"},
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"account_id", "zone_id"},
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"identity_providers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareAccessIdentityProvidersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter, err := expandFilterAccessIdentityProviders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Reading Access Identity Providers")
+	var providers []cloudflare.AccessIdentityProvider
+	if identifier.Type == AccountType {
+		providers, err = client.AccessIdentityProviders(ctx, identifier.Value)
+	} else {
+		providers, err = client.ZoneLevelAccessIdentityProviders(ctx, identifier.Value)
+	}
+	if err != nil {
+		return diag.Errorf("error listing Access Identity Providers: %s", err)
+	}
+
+	providerIds := make([]string, 0)
+	providerDetails := make([]interface{}, 0)
+	for _, provider := range providers {
+		if !filter.matches(provider) {
+			continue
+		}
+
+		providerDetails = append(providerDetails, map[string]interface{}{
+			"id":   provider.ID,
+			"name": provider.Name,
+			"type": provider.Type,
+		})
+		providerIds = append(providerIds, provider.ID)
+	}
+
+	err = d.Set("identity_providers", providerDetails)
+	if err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("identity_providers"), fmt.Errorf("error setting Access Identity Providers: %s", err))
+	}
+
+	d.SetId(stringListChecksum(providerIds))
+	return nil
+}
+
+func expandFilterAccessIdentityProviders(d *schema.ResourceData) (*searchFilterAccessIdentityProviders, error) {
+	filter := &searchFilterAccessIdentityProviders{}
+
+	cfg := d.Get("filter").([]interface{})
+	if len(cfg) == 0 || cfg[0] == nil {
+		return filter, nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+	if name, ok := m["name"]; ok && name.(string) != "" {
+		match, err := regexp.Compile(name.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Name = match
+	}
+
+	if providerType, ok := m["type"]; ok {
+		filter.Type = providerType.(string)
+	}
+
+	return filter, nil
+}
+
+type searchFilterAccessIdentityProviders struct {
+	Name *regexp.Regexp
+	Type string
+}
+
+func (f *searchFilterAccessIdentityProviders) matches(provider cloudflare.AccessIdentityProvider) bool {
+	if f.Name != nil && !f.Name.MatchString(provider.Name) {
+		return false
+	}
+
+	if f.Type != "" && f.Type != provider.Type {
+		return false
+	}
+
+	return true
+}
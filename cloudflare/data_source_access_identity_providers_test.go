@@ -0,0 +1,104 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareAccessIdentityProviders(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_access_identity_providers.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessIdentityProvidersConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "identity_providers.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "identity_providers.0.id", "cloudflare_access_identity_provider."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "identity_providers.0.name", rnd),
+					resource.TestCheckResourceAttr(name, "identity_providers.0.type", "github"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessIdentityProvidersConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_identity_provider" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  type       = "github"
+  config {
+    client_id     = "test"
+    client_secret = "secret"
+  }
+}
+
+data "cloudflare_access_identity_providers" "%[1]s" {
+  account_id = "%[2]s"
+
+  filter {
+    name = "^%[1]s$"
+    type = "github"
+  }
+
+  depends_on = [cloudflare_access_identity_provider.%[1]s]
+}`, rnd, accountID)
+}
+
+func TestAccessIdentityProvidersFilter(t *testing.T) {
+	providers := []cloudflare.AccessIdentityProvider{
+		{Name: "github-engineering", Type: "github"},
+		{Name: "okta-corporate", Type: "okta"},
+		{Name: "github-support", Type: "github"},
+	}
+
+	testCases := map[string]struct {
+		filter   map[string]interface{}
+		expected []string
+	}{
+		"no filter": {nil, []string{"github-engineering", "okta-corporate", "github-support"}},
+		"name":      {map[string]interface{}{"name": "corporate$"}, []string{"okta-corporate"}},
+		"type":      {map[string]interface{}{"type": "github"}, []string{"github-engineering", "github-support"}},
+		"all":       {map[string]interface{}{"name": "^github-s", "type": "github"}, []string{"github-support"}},
+		"none":      {map[string]interface{}{"name": "^okta", "type": "github"}, []string{}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{"account_id": "f037e56e89293a057740de681ac9abbe"}
+			if tc.filter != nil {
+				raw["filter"] = []interface{}{tc.filter}
+			}
+			d := schema.TestResourceDataRaw(t, dataSourceCloudflareAccessIdentityProviders().Schema, raw)
+
+			filter, err := expandFilterAccessIdentityProviders(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			matched := []string{}
+			for _, provider := range providers {
+				if filter.matches(provider) {
+					matched = append(matched, provider.Name)
+				}
+			}
+
+			if fmt.Sprint(matched) != fmt.Sprint(tc.expected) {
+				t.Errorf("expected %v but got %v", tc.expected, matched)
+			}
+		})
+	}
+}
@@ -5,13 +5,14 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceCloudflareAccountRoles() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceCloudflareAccountRolesRead,
+		ReadContext: dataSourceCloudflareAccountRolesRead,
 
 		Schema: map[string]*schema.Schema{
 			"account_id": {
@@ -43,14 +44,14 @@ func dataSourceCloudflareAccountRoles() *schema.Resource {
 	}
 }
 
-func dataSourceCloudflareAccountRolesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func dataSourceCloudflareAccountRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 
 	log.Printf("[DEBUG] Reading Account Roles")
-	roles, err := client.AccountRoles(context.Background(), accountID)
+	roles, err := client.AccountRoles(ctx, accountID)
 	if err != nil {
-		return fmt.Errorf("error listing Account Roles: %s", err)
+		return diag.Errorf("error listing Account Roles: %s", err)
 	}
 
 	roleIds := make([]string, 0)
@@ -67,7 +68,7 @@ func dataSourceCloudflareAccountRolesRead(d *schema.ResourceData, meta interface
 
 	err = d.Set("roles", roleDetails)
 	if err != nil {
-		return fmt.Errorf("error setting roles: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("roles"), fmt.Errorf("error setting roles: %s", err))
 	}
 
 	d.SetId(stringListChecksum(roleIds))
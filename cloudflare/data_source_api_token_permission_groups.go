@@ -5,13 +5,14 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceCloudflareApiTokenPermissionGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceCloudflareApiTokenPermissionGroupsRead,
+		ReadContext: dataSourceCloudflareApiTokenPermissionGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"permissions": {
@@ -22,13 +23,13 @@ func dataSourceCloudflareApiTokenPermissionGroups() *schema.Resource {
 	}
 }
 
-func dataSourceCloudflareApiTokenPermissionGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceCloudflareApiTokenPermissionGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Reading API Token Permission Groups")
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 
-	permissions, err := client.ListAPITokensPermissionGroups(context.Background())
+	permissions, err := cachedAPITokenPermissionGroups(ctx, client)
 	if err != nil {
-		return fmt.Errorf("error listing API Token Permission Groups: %s", err)
+		return diag.Errorf("error listing API Token Permission Groups: %s", err)
 	}
 
 	permissionDetails := make(map[string]interface{}, 0)
@@ -40,7 +41,7 @@ func dataSourceCloudflareApiTokenPermissionGroupsRead(d *schema.ResourceData, me
 
 	err = d.Set("permissions", permissionDetails)
 	if err != nil {
-		return fmt.Errorf("error setting API Token Permission Groups: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("permissions"), fmt.Errorf("error setting API Token Permission Groups: %s", err))
 	}
 
 	d.SetId(stringListChecksum(ids))
@@ -0,0 +1,223 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareLoadBalancerMonitors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareLoadBalancerMonitorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"http", "https", "tcp", "udp_icmp", "icmp_ping", "smtp"}, false),
+						},
+					},
+				},
+			},
+
+			"monitors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"header": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"header": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"retries": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"expected_body": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expected_codes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"follow_redirects": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"allow_insecure": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"probe_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"modified_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareLoadBalancerMonitorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	filter, err := expandFilterLoadBalancerMonitors(d.Get("filter"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Reading Load Balancer Monitors")
+	monitors, err := client.ListLoadBalancerMonitors(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing load balancer monitors: %s", err))
+	}
+
+	monitorIds := make([]string, 0)
+	monitorDetails := make([]interface{}, 0)
+	for _, monitor := range monitors {
+		if filter.Description != nil && !filter.Description.MatchString(monitor.Description) {
+			continue
+		}
+
+		if filter.Type != "" && filter.Type != monitor.Type {
+			continue
+		}
+
+		details := map[string]interface{}{
+			"id":               monitor.ID,
+			"type":             monitor.Type,
+			"description":      monitor.Description,
+			"method":           monitor.Method,
+			"path":             monitor.Path,
+			"port":             int(monitor.Port),
+			"header":           flattenLoadBalancerMonitorHeader(monitor.Header),
+			"timeout":          monitor.Timeout,
+			"retries":          monitor.Retries,
+			"interval":         monitor.Interval,
+			"expected_body":    monitor.ExpectedBody,
+			"expected_codes":   monitor.ExpectedCodes,
+			"follow_redirects": monitor.FollowRedirects,
+			"allow_insecure":   monitor.AllowInsecure,
+			"probe_zone":       monitor.ProbeZone,
+		}
+
+		if monitor.CreatedOn != nil {
+			details["created_on"] = monitor.CreatedOn.Format(time.RFC3339Nano)
+		}
+		if monitor.ModifiedOn != nil {
+			details["modified_on"] = monitor.ModifiedOn.Format(time.RFC3339Nano)
+		}
+
+		monitorDetails = append(monitorDetails, details)
+		monitorIds = append(monitorIds, monitor.ID)
+	}
+
+	err = d.Set("monitors", monitorDetails)
+	if err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("monitors"), fmt.Errorf("error setting load balancer monitors: %s", err))
+	}
+
+	d.SetId(stringListChecksum(monitorIds))
+	return nil
+}
+
+func expandFilterLoadBalancerMonitors(d interface{}) (*searchFilterLoadBalancerMonitors, error) {
+	cfg := d.([]interface{})
+	filter := &searchFilterLoadBalancerMonitors{}
+	if len(cfg) == 0 || cfg[0] == nil {
+		return filter, nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+	if description, ok := m["description"]; ok && description.(string) != "" {
+		match, err := regexp.Compile(description.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Description = match
+	}
+
+	if monitorType, ok := m["type"]; ok {
+		filter.Type = monitorType.(string)
+	}
+
+	return filter, nil
+}
+
+type searchFilterLoadBalancerMonitors struct {
+	Description *regexp.Regexp
+	Type        string
+}
@@ -0,0 +1,53 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareLoadBalancerMonitors(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_load_balancer_monitors.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareLoadBalancerMonitorsConfig(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "monitors.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "monitors.0.id", "cloudflare_load_balancer_monitor."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "monitors.0.type", "https"),
+					resource.TestCheckResourceAttr(name, "monitors.0.path", "/health"),
+					resource.TestCheckResourceAttr(name, "monitors.0.expected_codes", "2xx"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareLoadBalancerMonitorsConfig(rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_load_balancer_monitor" "%[1]s" {
+  type           = "https"
+  description    = "my-tf-monitor-%[1]s"
+  path           = "/health"
+  expected_codes = "2xx"
+}
+
+data "cloudflare_load_balancer_monitors" "%[1]s" {
+  filter {
+    description = "^my-tf-monitor-%[1]s$"
+    type        = "https"
+  }
+
+  depends_on = [cloudflare_load_balancer_monitor.%[1]s]
+}`, rnd)
+}
@@ -0,0 +1,196 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+func dataSourceCloudflareLoadBalancerPoolHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareLoadBalancerPoolHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"healthy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"origins": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"healthy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"healthy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"origins": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"healthy": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"rtt": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"failure_reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"response_code": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareLoadBalancerPoolHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	poolID := d.Get("pool_id").(string)
+
+	log.Printf("[DEBUG] Reading Load Balancer Pool health for %s", poolID)
+	health, err := client.PoolHealthDetails(ctx, poolID)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading health of load balancer pool %q", poolID)))
+	}
+
+	regions := make([]string, 0, len(health.PopHealth))
+	for region := range health.PopHealth {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	healthyRegions := 0
+	regionDetails := make([]interface{}, 0, len(regions))
+	for _, region := range regions {
+		popHealth := health.PopHealth[region]
+		if popHealth.Healthy {
+			healthyRegions++
+		}
+
+		origins := make([]interface{}, 0)
+		for _, originHealth := range popHealth.Origins {
+			for address, h := range originHealth {
+				origins = append(origins, map[string]interface{}{
+					"address":        address,
+					"healthy":        h.Healthy,
+					"rtt":            h.RTT.String(),
+					"failure_reason": h.FailureReason,
+					"response_code":  h.ResponseCode,
+				})
+			}
+		}
+		sort.SliceStable(origins, func(i, j int) bool {
+			return origins[i].(map[string]interface{})["address"].(string) < origins[j].(map[string]interface{})["address"].(string)
+		})
+
+		regionDetails = append(regionDetails, map[string]interface{}{
+			"region":  region,
+			"healthy": popHealth.Healthy,
+			"origins": origins,
+		})
+	}
+
+	originHealth := loadBalancerOriginHealth(health)
+	addresses := make([]string, 0, len(originHealth))
+	for address := range originHealth {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	origins := make([]interface{}, 0, len(addresses))
+	for _, address := range addresses {
+		origins = append(origins, map[string]interface{}{
+			"address": address,
+			"healthy": originHealth[address],
+		})
+	}
+
+	d.SetId(poolID)
+	d.Set("healthy", len(regions) > 0 && healthyRegions*2 > len(regions))
+
+	if err := d.Set("origins", origins); err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("origins"), fmt.Errorf("error setting origins: %s", err))
+	}
+
+	if err := d.Set("regions", regionDetails); err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("regions"), fmt.Errorf("error setting regions: %s", err))
+	}
+
+	return nil
+}
+
+// loadBalancerOriginHealth returns whether each origin of the pool is healthy.
+// Like the pool itself, an origin is healthy when the majority of the regions
+// checking it report it as healthy.
+func loadBalancerOriginHealth(health cloudflare.LoadBalancerPoolHealth) map[string]bool {
+	checks := make(map[string]int)
+	healthy := make(map[string]int)
+
+	for _, popHealth := range health.PopHealth {
+		for _, origins := range popHealth.Origins {
+			for address, originHealth := range origins {
+				checks[address]++
+				if originHealth.Healthy {
+					healthy[address]++
+				}
+			}
+		}
+	}
+
+	result := make(map[string]bool, len(checks))
+	for address, count := range checks {
+		result[address] = healthy[address]*2 > count
+	}
+
+	return result
+}
@@ -0,0 +1,96 @@
+package cloudflare
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareLoadBalancerPoolHealth(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_load_balancer_pool_health.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareLoadBalancerPoolHealthConfig(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", "cloudflare_load_balancer_pool."+rnd, "id"),
+					resource.TestCheckResourceAttrSet(name, "healthy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareLoadBalancerPoolHealthConfig(rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_load_balancer_monitor" "%[1]s" {
+  description = "my-tf-monitor-%[1]s"
+}
+
+resource "cloudflare_load_balancer_pool" "%[1]s" {
+  name    = "my-tf-pool-%[1]s"
+  monitor = cloudflare_load_balancer_monitor.%[1]s.id
+
+  origins {
+    name    = "example-1"
+    address = "192.0.2.1"
+  }
+}
+
+data "cloudflare_load_balancer_pool_health" "%[1]s" {
+  pool_id = cloudflare_load_balancer_pool.%[1]s.id
+}`, rnd)
+}
+
+func TestLoadBalancerOriginHealth(t *testing.T) {
+	health := cloudflare.LoadBalancerPoolHealth{
+		PopHealth: map[string]cloudflare.LoadBalancerPoolPopHealth{
+			"Amsterdam, NL": {Origins: []map[string]cloudflare.LoadBalancerOriginHealth{
+				{"192.0.2.1": {Healthy: true}},
+				{"192.0.2.2": {Healthy: true}},
+				{"192.0.2.3": {Healthy: false}},
+			}},
+			"Frankfurt, DE": {Origins: []map[string]cloudflare.LoadBalancerOriginHealth{
+				{"192.0.2.1": {Healthy: true}},
+				{"192.0.2.2": {Healthy: false}},
+				{"192.0.2.3": {Healthy: false}},
+			}},
+			"Paris, FR": {Origins: []map[string]cloudflare.LoadBalancerOriginHealth{
+				{"192.0.2.1": {Healthy: false}},
+				{"192.0.2.2": {Healthy: true}},
+				{"192.0.2.3": {Healthy: true}},
+			}},
+		},
+	}
+
+	expected := map[string]bool{
+		"192.0.2.1": true,
+		"192.0.2.2": true,
+		"192.0.2.3": false,
+	}
+
+	originHealth := loadBalancerOriginHealth(health)
+	if !reflect.DeepEqual(originHealth, expected) {
+		t.Errorf("expected %v but got %v", expected, originHealth)
+	}
+
+	origins := []cloudflare.LoadBalancerOrigin{
+		{Address: "192.0.2.1", Enabled: true},
+		{Address: "192.0.2.2", Enabled: false},
+		{Address: "192.0.2.3", Enabled: true},
+		{Address: "192.0.2.4", Enabled: true},
+	}
+	if healthy := countHealthyLoadBalancerOrigins(origins, originHealth); healthy != 1 {
+		t.Errorf("expected 1 healthy enabled origin but got %d", healthy)
+	}
+}
@@ -0,0 +1,321 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"regexp"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareLoadBalancerPools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareLoadBalancerPoolsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"origin_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"minimum_origins": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"monitor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notification_email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latitude": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"longitude": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"check_regions": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"origins": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"weight": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"header": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"header": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"values": {
+													Type:     schema.TypeSet,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"origin_steering": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"load_shedding": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_percent": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"default_policy": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"session_percent": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"session_policy": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"created_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"modified_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareLoadBalancerPoolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	filter, err := expandFilterLoadBalancerPools(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Reading Load Balancer Pools")
+	pools, err := client.ListLoadBalancerPools(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing load balancer pools: %s", err))
+	}
+
+	poolIds := make([]string, 0)
+	poolDetails := make([]interface{}, 0)
+	for _, pool := range pools {
+		if !filter.matches(pool) {
+			continue
+		}
+
+		poolDetails = append(poolDetails, flattenLoadBalancerPoolDetails(pool))
+		poolIds = append(poolIds, pool.ID)
+	}
+
+	err = d.Set("pools", poolDetails)
+	if err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("pools"), fmt.Errorf("error setting load balancer pools: %s", err))
+	}
+
+	d.SetId(stringListChecksum(poolIds))
+	return nil
+}
+
+func flattenLoadBalancerPoolDetails(pool cloudflare.LoadBalancerPool) map[string]interface{} {
+	origins := make([]interface{}, 0, len(pool.Origins))
+	for _, o := range pool.Origins {
+		origins = append(origins, map[string]interface{}{
+			"name":    o.Name,
+			"address": o.Address,
+			"weight":  o.Weight,
+			"enabled": o.Enabled,
+			"header":  flattenLoadBalancerPoolHeader(o.Header),
+		})
+	}
+
+	details := map[string]interface{}{
+		"id":                 pool.ID,
+		"name":               pool.Name,
+		"description":        pool.Description,
+		"enabled":            pool.Enabled,
+		"minimum_origins":    pool.MinimumOrigins,
+		"monitor":            pool.Monitor,
+		"notification_email": pool.NotificationEmail,
+		"check_regions":      schema.NewSet(schema.HashString, flattenStringList(pool.CheckRegions)),
+		"origins":            origins,
+		"origin_steering":    []interface{}{},
+		"load_shedding":      []interface{}{},
+	}
+
+	if pool.Latitude != nil {
+		details["latitude"] = math.Round(float64(*pool.Latitude)*10000) / 10000
+	}
+	if pool.Longitude != nil {
+		details["longitude"] = math.Round(float64(*pool.Longitude)*10000) / 10000
+	}
+	if pool.CreatedOn != nil {
+		details["created_on"] = pool.CreatedOn.Format(time.RFC3339Nano)
+	}
+	if pool.ModifiedOn != nil {
+		details["modified_on"] = pool.ModifiedOn.Format(time.RFC3339Nano)
+	}
+	if steering := flattenLoadBalancerOriginSteering(pool.OriginSteering); steering != nil {
+		details["origin_steering"] = steering.List()
+	}
+	if shedding := flattenLoadBalancerLoadShedding(pool.LoadShedding); shedding != nil {
+		details["load_shedding"] = shedding.List()
+	}
+
+	return details
+}
+
+func expandFilterLoadBalancerPools(d *schema.ResourceData) (*searchFilterLoadBalancerPools, error) {
+	filter := &searchFilterLoadBalancerPools{}
+
+	cfg := d.Get("filter").([]interface{})
+	if len(cfg) == 0 || cfg[0] == nil {
+		return filter, nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+	if name, ok := m["name"]; ok && name.(string) != "" {
+		match, err := regexp.Compile(name.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Name = match
+	}
+
+	// A boolean is always set so check whether it's in the configuration to
+	// tell filtering on disabled pools apart from not filtering at all.
+	if enabled, ok := d.GetOkExists("filter.0.enabled"); ok {
+		e := enabled.(bool)
+		filter.Enabled = &e
+	}
+
+	if address, ok := m["origin_address"]; ok {
+		filter.OriginAddress = address.(string)
+	}
+
+	return filter, nil
+}
+
+type searchFilterLoadBalancerPools struct {
+	Name          *regexp.Regexp
+	Enabled       *bool
+	OriginAddress string
+}
+
+func (f *searchFilterLoadBalancerPools) matches(pool cloudflare.LoadBalancerPool) bool {
+	if f.Name != nil && !f.Name.MatchString(pool.Name) {
+		return false
+	}
+
+	if f.Enabled != nil && *f.Enabled != pool.Enabled {
+		return false
+	}
+
+	if f.OriginAddress != "" {
+		for _, origin := range pool.Origins {
+			if origin.Address == f.OriginAddress {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
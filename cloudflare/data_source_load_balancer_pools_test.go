@@ -0,0 +1,122 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareLoadBalancerPools(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_load_balancer_pools.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareLoadBalancerPoolsConfig(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "pools.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "pools.0.id", "cloudflare_load_balancer_pool."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "pools.0.name", "my-tf-pool-"+rnd),
+					resource.TestCheckResourceAttr(name, "pools.0.origins.#", "1"),
+					resource.TestCheckResourceAttr(name, "pools.0.origins.0.address", "192.0.2.1"),
+					resource.TestCheckResourceAttr(name, "pools.0.origins.0.weight", "0.5"),
+					resource.TestCheckResourceAttr(name, "pools.0.origin_steering.0.policy", "hash"),
+					resource.TestCheckResourceAttr(name, "pools.0.load_shedding.0.default_percent", "55"),
+					resource.TestCheckResourceAttrPair(name, "pools.0.monitor", "cloudflare_load_balancer_monitor."+rnd, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareLoadBalancerPoolsConfig(rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_load_balancer_monitor" "%[1]s" {
+  description = "my-tf-monitor-%[1]s"
+}
+
+resource "cloudflare_load_balancer_pool" "%[1]s" {
+  name    = "my-tf-pool-%[1]s"
+  monitor = cloudflare_load_balancer_monitor.%[1]s.id
+
+  origins {
+    name    = "example-1"
+    address = "192.0.2.1"
+    weight  = 0.5
+  }
+
+  origin_steering {
+    policy = "hash"
+  }
+
+  load_shedding {
+    default_percent = 55
+    default_policy  = "random"
+  }
+}
+
+data "cloudflare_load_balancer_pools" "%[1]s" {
+  filter {
+    name           = "^my-tf-pool-%[1]s$"
+    enabled        = true
+    origin_address = "192.0.2.1"
+  }
+
+  depends_on = [cloudflare_load_balancer_pool.%[1]s]
+}`, rnd)
+}
+
+func TestLoadBalancerPoolsFilter(t *testing.T) {
+	pools := []cloudflare.LoadBalancerPool{
+		{Name: "primary", Enabled: true, Origins: []cloudflare.LoadBalancerOrigin{{Address: "192.0.2.1"}}},
+		{Name: "secondary", Enabled: false, Origins: []cloudflare.LoadBalancerOrigin{{Address: "192.0.2.2"}}},
+	}
+
+	testCases := map[string]struct {
+		filter   map[string]interface{}
+		expected []string
+	}{
+		"no filter":      {nil, []string{"primary", "secondary"}},
+		"name":           {map[string]interface{}{"name": "^sec"}, []string{"secondary"}},
+		"enabled":        {map[string]interface{}{"enabled": true}, []string{"primary"}},
+		"disabled":       {map[string]interface{}{"enabled": false}, []string{"secondary"}},
+		"origin address": {map[string]interface{}{"origin_address": "192.0.2.1"}, []string{"primary"}},
+		"all":            {map[string]interface{}{"name": "^sec", "enabled": true}, []string{}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{}
+			if tc.filter != nil {
+				raw["filter"] = []interface{}{tc.filter}
+			}
+			d := schema.TestResourceDataRaw(t, dataSourceCloudflareLoadBalancerPools().Schema, raw)
+
+			filter, err := expandFilterLoadBalancerPools(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			matched := []string{}
+			for _, pool := range pools {
+				if filter.matches(pool) {
+					matched = append(matched, pool.Name)
+				}
+			}
+
+			if fmt.Sprint(matched) != fmt.Sprint(tc.expected) {
+				t.Errorf("expected %v but got %v", tc.expected, matched)
+			}
+		})
+	}
+}
@@ -1,17 +1,20 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceCloudflareOriginCARootCertificate() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceCloudflareOriginCARootCertificateRead,
+		ReadContext: dataSourceCloudflareOriginCARootCertificateRead,
 
 		Schema: map[string]*schema.Schema{
 			"algorithm": {
@@ -28,11 +31,11 @@ func dataSourceCloudflareOriginCARootCertificate() *schema.Resource {
 	}
 }
 
-func dataSourceCloudflareOriginCARootCertificateRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceCloudflareOriginCARootCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	algorithm := strings.ToLower(fmt.Sprintf("%s", d.Get("algorithm")))
 	certBytes, err := cloudflare.OriginCARootCertificate(algorithm)
 	if err != nil {
-		return fmt.Errorf("failed to fetch Cloudflare Origin CA root %s certificate: %s", algorithm, err)
+		return diag.Errorf("failed to fetch Cloudflare Origin CA root %s certificate: %s", algorithm, err)
 	}
 
 	cert := string(certBytes[:])
@@ -40,7 +43,7 @@ func dataSourceCloudflareOriginCARootCertificateRead(d *schema.ResourceData, met
 	d.SetId(stringChecksum(cert))
 
 	if err := d.Set("cert_pem", cert); err != nil {
-		return fmt.Errorf("error setting cert_pem: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("cert_pem"), fmt.Errorf("error setting cert_pem: %s", err))
 	}
 
 	return nil
@@ -0,0 +1,316 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"lookup_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"contains", "exact"}, false),
+							Default:      "exact",
+						},
+						"match": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"proxied": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"proxied": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"proxiable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"locked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"created_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"modified_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	zoneID := d.Get("zone_id").(string)
+
+	filter, err := expandFilterRecords(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Exact names, types and values are filtered by the API, everything else
+	// is filtered client side.
+	lookup := cloudflare.DNSRecord{
+		Type:    filter.Type,
+		Content: filter.Value,
+	}
+	if filter.LookupType == "exact" {
+		lookup.Name = strings.TrimSuffix(filter.Name, ".")
+	}
+
+	log.Printf("[DEBUG] Reading DNS Records")
+	dnsRecords, err := client.DNSRecords(ctx, zoneID, lookup)
+	if err != nil {
+		return diag.Errorf("error listing DNS records: %s", err)
+	}
+
+	recordIds := make([]string, 0)
+	recordDetails := make([]interface{}, 0)
+	for _, record := range dnsRecords {
+		if !filter.matches(record) {
+			continue
+		}
+
+		var priority int
+		if record.Priority != nil {
+			priority = int(*record.Priority)
+		}
+
+		var proxied bool
+		if record.Proxied != nil {
+			proxied = *record.Proxied
+		}
+
+		recordDetails = append(recordDetails, map[string]interface{}{
+			"id":          record.ID,
+			"name":        record.Name,
+			"type":        record.Type,
+			"value":       record.Content,
+			"data":        flattenDNSRecordData(record.Data),
+			"ttl":         record.TTL,
+			"priority":    priority,
+			"proxied":     proxied,
+			"proxiable":   record.Proxiable,
+			"locked":      record.Locked,
+			"created_on":  record.CreatedOn.Format(time.RFC3339Nano),
+			"modified_on": record.ModifiedOn.Format(time.RFC3339Nano),
+		})
+		recordIds = append(recordIds, record.ID)
+	}
+
+	err = d.Set("records", recordDetails)
+	if err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("records"), fmt.Errorf("error setting DNS records: %s", err))
+	}
+
+	d.SetId(stringListChecksum(recordIds))
+	return nil
+}
+
+// flattenDNSRecordData converts the structured data of SRV, CAA, LOC, etc.
+// records into a map of strings.
+func flattenDNSRecordData(data interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	fields, ok := data.(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	for key, value := range fields {
+		if value == nil {
+			continue
+		}
+		result[key] = fmt.Sprintf("%v", value)
+	}
+
+	return result
+}
+
+func expandFilterRecords(d *schema.ResourceData) (*searchFilterRecords, error) {
+	cfg := d.Get("filter").([]interface{})
+	filter := &searchFilterRecords{LookupType: "exact"}
+	if len(cfg) == 0 || cfg[0] == nil {
+		return filter, nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+	name, ok := m["name"]
+	if ok {
+		filter.Name = strings.ToLower(name.(string))
+	}
+
+	lookupType, ok := m["lookup_type"]
+	if ok {
+		filter.LookupType = lookupType.(string)
+	}
+
+	match, ok := m["match"]
+	if ok && match.(string) != "" {
+		match, err := regexp.Compile(match.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Match = match
+	}
+
+	recordType, ok := m["type"]
+	if ok {
+		filter.Type = strings.ToUpper(recordType.(string))
+	}
+
+	value, ok := m["value"]
+	if ok {
+		filter.Value = value.(string)
+	}
+
+	// A nested boolean is always present in the map so check whether it has
+	// actually been configured.
+	if proxied, ok := d.GetOkExists("filter.0.proxied"); ok {
+		p := proxied.(bool)
+		filter.Proxied = &p
+	}
+
+	ttl, ok := m["ttl"]
+	if ok {
+		filter.TTL = ttl.(int)
+	}
+
+	return filter, nil
+}
+
+type searchFilterRecords struct {
+	Name       string
+	LookupType string
+	Match      *regexp.Regexp
+	Type       string
+	Value      string
+	Proxied    *bool
+	TTL        int
+}
+
+func (f *searchFilterRecords) matches(record cloudflare.DNSRecord) bool {
+	name := strings.ToLower(record.Name)
+
+	if f.Name != "" {
+		if f.LookupType == "contains" && !strings.Contains(name, f.Name) {
+			return false
+		}
+		if f.LookupType == "exact" && name != strings.TrimSuffix(f.Name, ".") {
+			return false
+		}
+	}
+
+	if f.Match != nil && !f.Match.MatchString(record.Name) {
+		return false
+	}
+
+	if f.Type != "" && f.Type != record.Type {
+		return false
+	}
+
+	if f.Value != "" && f.Value != record.Content {
+		return false
+	}
+
+	if f.Proxied != nil && (record.Proxied != nil && *record.Proxied) != *f.Proxied {
+		return false
+	}
+
+	if f.TTL != 0 && f.TTL != record.TTL {
+		return false
+	}
+
+	return true
+}
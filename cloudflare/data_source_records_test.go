@@ -0,0 +1,183 @@
+package cloudflare
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRecords_MatchName(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_records.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordsConfigMatchName(zoneID, zoneName, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "1"),
+					resource.TestCheckResourceAttr(name, "records.0.name", fmt.Sprintf("%s.%s", rnd, zoneName)),
+					resource.TestCheckResourceAttr(name, "records.0.type", "A"),
+					resource.TestCheckResourceAttr(name, "records.0.value", "192.0.2.1"),
+					resource.TestCheckResourceAttr(name, "records.0.proxied", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecords_SRVData(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_records.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordsConfigSRVData(zoneID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "1"),
+					resource.TestCheckResourceAttr(name, "records.0.type", "SRV"),
+					resource.TestCheckResourceAttr(name, "records.0.priority", "5"),
+					resource.TestCheckResourceAttr(name, "records.0.data.port", "5060"),
+					resource.TestCheckResourceAttr(name, "records.0.data.weight", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRecordsConfigMatchName(zoneID, zoneName, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[3]s" {
+  zone_id = "%[1]s"
+  name    = "%[3]s"
+  value   = "192.0.2.1"
+  type    = "A"
+  proxied = true
+}
+
+resource "cloudflare_record" "%[3]s_txt" {
+  zone_id = "%[1]s"
+  name    = "%[3]s"
+  value   = "not matched"
+  type    = "TXT"
+}
+
+data "cloudflare_records" "%[3]s" {
+  zone_id = "%[1]s"
+
+  filter {
+    name    = "${cloudflare_record.%[3]s.name}.%[2]s"
+    type    = "A"
+    proxied = true
+  }
+
+  depends_on = [cloudflare_record.%[3]s_txt]
+}
+`, zoneID, zoneName, rnd)
+}
+
+func testAccCloudflareRecordsConfigSRVData(zoneID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+  zone_id = "%[1]s"
+  name    = "_sip._tcp.%[2]s"
+  type    = "SRV"
+
+  data {
+    service  = "_sip"
+    proto    = "_tcp"
+    name     = "%[2]s"
+    priority = 5
+    weight   = 10
+    port     = 5060
+    target   = "sip.example.com"
+  }
+}
+
+data "cloudflare_records" "%[2]s" {
+  zone_id = "%[1]s"
+
+  filter {
+    name        = "_sip._tcp.%[2]s"
+    lookup_type = "contains"
+    type        = "SRV"
+  }
+
+  depends_on = [cloudflare_record.%[2]s]
+}
+`, zoneID, rnd)
+}
+
+func TestSearchFilterRecordsMatches(t *testing.T) {
+	proxied, notProxied := true, false
+	record := cloudflare.DNSRecord{
+		Name:    "WWW.example.com",
+		Type:    "A",
+		Content: "192.0.2.1",
+		TTL:     1,
+		Proxied: &proxied,
+	}
+
+	filters := map[string]struct {
+		filter   searchFilterRecords
+		expected bool
+	}{
+		"empty":              {searchFilterRecords{}, true},
+		"exact name":         {searchFilterRecords{Name: "www.example.com", LookupType: "exact"}, true},
+		"exact name dot":     {searchFilterRecords{Name: "www.example.com.", LookupType: "exact"}, true},
+		"exact name miss":    {searchFilterRecords{Name: "www", LookupType: "exact"}, false},
+		"contains name":      {searchFilterRecords{Name: "www", LookupType: "contains"}, true},
+		"contains name miss": {searchFilterRecords{Name: "api", LookupType: "contains"}, false},
+		"match":              {searchFilterRecords{Match: regexp.MustCompile("^WWW\\.")}, true},
+		"match miss":         {searchFilterRecords{Match: regexp.MustCompile("^api\\.")}, false},
+		"type":               {searchFilterRecords{Type: "A"}, true},
+		"type miss":          {searchFilterRecords{Type: "AAAA"}, false},
+		"value":              {searchFilterRecords{Value: "192.0.2.1"}, true},
+		"value miss":         {searchFilterRecords{Value: "192.0.2.2"}, false},
+		"proxied":            {searchFilterRecords{Proxied: &proxied}, true},
+		"proxied miss":       {searchFilterRecords{Proxied: &notProxied}, false},
+		"ttl":                {searchFilterRecords{TTL: 1}, true},
+		"ttl miss":           {searchFilterRecords{TTL: 300}, false},
+	}
+
+	for name, tc := range filters {
+		if tc.filter.matches(record) != tc.expected {
+			t.Errorf("%s: expected match to be %t", name, tc.expected)
+		}
+	}
+
+	unproxiable := cloudflare.DNSRecord{Name: "example.com", Type: "TXT"}
+	if !(&searchFilterRecords{Proxied: &notProxied}).matches(unproxiable) {
+		t.Error("records without a proxied status should match proxied = false")
+	}
+}
+
+func TestFlattenDNSRecordData(t *testing.T) {
+	data := flattenDNSRecordData(map[string]interface{}{
+		"flags": float64(0),
+		"tag":   "issue",
+		"value": "letsencrypt.org",
+		"empty": nil,
+	})
+
+	expected := map[string]interface{}{"flags": "0", "tag": "issue", "value": "letsencrypt.org"}
+	if fmt.Sprint(data) != fmt.Sprint(expected) {
+		t.Errorf("got %v, want %v", data, expected)
+	}
+
+	if len(flattenDNSRecordData(nil)) != 0 {
+		t.Error("records without data should have an empty map")
+	}
+}
@@ -7,13 +7,15 @@ import (
 	"regexp"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceCloudflareWAFGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceCloudflareWAFGroupsRead,
+		ReadContext: dataSourceCloudflareWAFGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -85,14 +87,14 @@ func dataSourceCloudflareWAFGroups() *schema.Resource {
 	}
 }
 
-func dataSourceCloudflareWAFGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func dataSourceCloudflareWAFGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	// Prepare the filters to be applied to the search
 	filter, err := expandFilterWAFGroups(d.Get("filter"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// If no package ID is given, we will consider all for the zone
@@ -101,9 +103,9 @@ func dataSourceCloudflareWAFGroupsRead(d *schema.ResourceData, meta interface{})
 	if packageID == "" {
 		var err error
 		log.Printf("[DEBUG] Reading WAF Packages")
-		pkgList, err = client.ListWAFPackages(context.Background(), zoneID)
+		pkgList, err = cachedWAFPackages(ctx, client, zoneID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		pkgList = append(pkgList, cloudflare.WAFPackage{ID: packageID})
@@ -113,9 +115,9 @@ func dataSourceCloudflareWAFGroupsRead(d *schema.ResourceData, meta interface{})
 	groupIds := make([]string, 0)
 	groupDetails := make([]interface{}, 0)
 	for _, pkg := range pkgList {
-		groupList, err := client.ListWAFGroups(context.Background(), zoneID, pkg.ID)
+		groupList, err := client.ListWAFGroups(ctx, zoneID, pkg.ID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, group := range groupList {
@@ -142,7 +144,7 @@ func dataSourceCloudflareWAFGroupsRead(d *schema.ResourceData, meta interface{})
 
 	err = d.Set("groups", groupDetails)
 	if err != nil {
-		return fmt.Errorf("error setting WAF groups: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("groups"), fmt.Errorf("error setting WAF groups: %s", err))
 	}
 
 	d.SetId(stringListChecksum(groupIds))
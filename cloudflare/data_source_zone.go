@@ -5,13 +5,14 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceCloudflareZone() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceCloudflareZoneRead,
+		ReadContext: dataSourceCloudflareZoneRead,
 
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -61,37 +62,33 @@ func dataSourceCloudflareZone() *schema.Resource {
 	}
 }
 
-func dataSourceCloudflareZoneRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Reading Zones")
-	client := meta.(*cloudflare.API)
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	name := d.Get("name").(string)
 	accountID := d.Get("account_id").(string)
 
-	var zone cloudflare.Zone
 	if name != "" && zoneID == "" {
-		zoneFilter := cloudflare.WithZoneFilters(name, accountID, "")
-		zonesResp, err := client.ListZonesContext(context.Background(), zoneFilter)
-
+		zoneIDs, err := cachedZoneIDsByName(ctx, client, name, accountID)
 		if err != nil {
-			return fmt.Errorf("error listing zones: %s", err)
+			return diag.Errorf("error listing zones: %s", err)
 		}
 
-		if zonesResp.Total > 1 {
-			return fmt.Errorf("more than one zone was returned; consider adding the `account_id` to the existing resource or use the `cloudflare_zones` data source with filtering to target the zone more specifically")
+		if len(zoneIDs) > 1 {
+			return diag.Errorf("more than one zone was returned; consider adding the `account_id` to the existing resource or use the `cloudflare_zones` data source with filtering to target the zone more specifically")
 		}
 
-		if zonesResp.Total == 0 {
-			return fmt.Errorf("no zone found")
+		if len(zoneIDs) == 0 {
+			return diag.Errorf("no zone found")
 		}
 
-		zone = zonesResp.Result[0]
-	} else {
-		var err error
-		zone, err = client.ZoneDetails(context.Background(), zoneID)
-		if err != nil {
-			return fmt.Errorf("error getting zone details: %s", err)
-		}
+		zoneID = zoneIDs[0]
+	}
+
+	zone, err := client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return diag.Errorf("error getting zone details: %s", err)
 	}
 
 	d.SetId(zone.ID)
@@ -103,11 +100,11 @@ func dataSourceCloudflareZoneRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("plan", zone.Plan.Name)
 
 	if err := d.Set("name_servers", zone.NameServers); err != nil {
-		return fmt.Errorf("failed to set name_servers attribute: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("name_servers"), fmt.Errorf("failed to set name_servers attribute: %s", err))
 	}
 
 	if err := d.Set("vanity_name_servers", zone.VanityNS); err != nil {
-		return fmt.Errorf("failed to set vanity_name_servers attribute: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("vanity_name_servers"), fmt.Errorf("failed to set vanity_name_servers attribute: %s", err))
 	}
 
 	return nil
@@ -0,0 +1,75 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// cloudflare-go only supports the legacy virtual DNS fields of DNS Firewall
+// clusters so the endpoints are called here using the raw client.
+
+// dnsFirewallCluster is a DNS Firewall cluster proxying queries to the
+// upstream name servers. A nil rate limit disables rate limiting.
+type dnsFirewallCluster struct {
+	ID                   string   `json:"id,omitempty"`
+	Name                 string   `json:"name"`
+	UpstreamIPs          []string `json:"upstream_ips"`
+	DNSFirewallIPs       []string `json:"dns_firewall_ips,omitempty"`
+	MinimumCacheTTL      int      `json:"minimum_cache_ttl"`
+	MaximumCacheTTL      int      `json:"maximum_cache_ttl"`
+	DeprecateAnyRequests bool     `json:"deprecate_any_requests"`
+	ECSFallback          bool     `json:"ecs_fallback"`
+	Ratelimit            *int     `json:"ratelimit"`
+	OriginDirect         bool     `json:"origin_direct"`
+	ModifiedOn           string   `json:"modified_on,omitempty"`
+}
+
+func dnsFirewallClusterURI(accountID, clusterID string) string {
+	uri := fmt.Sprintf("/accounts/%s/dns_firewall", accountID)
+	if clusterID != "" {
+		uri += "/" + clusterID
+	}
+	return uri
+}
+
+func fetchDNSFirewallCluster(ctx context.Context, client *providerClient, accountID, clusterID string) (dnsFirewallCluster, error) {
+	res, err := client.request(ctx, http.MethodGet, dnsFirewallClusterURI(accountID, clusterID), nil)
+	if err != nil {
+		return dnsFirewallCluster{}, err
+	}
+
+	return unmarshalDNSFirewallCluster(res)
+}
+
+func createDNSFirewallCluster(ctx context.Context, client *providerClient, accountID string, cluster dnsFirewallCluster) (dnsFirewallCluster, error) {
+	res, err := client.request(ctx, http.MethodPost, dnsFirewallClusterURI(accountID, ""), cluster)
+	if err != nil {
+		return dnsFirewallCluster{}, err
+	}
+
+	return unmarshalDNSFirewallCluster(res)
+}
+
+func updateDNSFirewallCluster(ctx context.Context, client *providerClient, accountID string, cluster dnsFirewallCluster) error {
+	clusterID := cluster.ID
+	cluster.ID = ""
+	cluster.DNSFirewallIPs = nil
+	_, err := client.request(ctx, http.MethodPatch, dnsFirewallClusterURI(accountID, clusterID), cluster)
+	return err
+}
+
+func deleteDNSFirewallCluster(ctx context.Context, client *providerClient, accountID, clusterID string) error {
+	_, err := client.request(ctx, http.MethodDelete, dnsFirewallClusterURI(accountID, clusterID), nil)
+	return err
+}
+
+func unmarshalDNSFirewallCluster(res json.RawMessage) (dnsFirewallCluster, error) {
+	var cluster dnsFirewallCluster
+	if err := json.Unmarshal(res, &cluster); err != nil {
+		return cluster, fmt.Errorf("error unmarshalling DNS Firewall cluster: %w", err)
+	}
+
+	return cluster, nil
+}
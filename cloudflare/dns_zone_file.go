@@ -0,0 +1,547 @@
+package cloudflare
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// recordSetTypes are the record types which can be managed in bulk. Types
+// with more involved data (LOC, DNSKEY, etc) need to use cloudflare_record.
+var recordSetTypes = []string{"A", "AAAA", "CAA", "CNAME", "MX", "NS", "PTR", "SPF", "SRV", "TXT"}
+
+// dnsRecordSetRecord is the normalised form of a DNS record that the zone
+// file, the `record` blocks and the records returned by the API are all
+// converted to before being compared.
+type dnsRecordSetRecord struct {
+	ID       string
+	Name     string
+	Type     string
+	Content  string
+	TTL      int
+	Priority int
+	Proxied  bool
+}
+
+// key identifies a record regardless of its TTL, priority or proxy status.
+func (r dnsRecordSetRecord) key() string {
+	return r.Type + " " + r.Name + " " + r.Content
+}
+
+// equal compares everything but the ID.
+func (r dnsRecordSetRecord) equal(o dnsRecordSetRecord) bool {
+	return r.key() == o.key() && r.TTL == o.TTL && r.Priority == o.Priority && r.Proxied == o.Proxied
+}
+
+// sortDNSRecordSetRecords orders the records so they are stable in state and
+// when rendered.
+func sortDNSRecordSetRecords(records []dnsRecordSetRecord) {
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Name != records[j].Name {
+			return records[i].Name < records[j].Name
+		}
+		if records[i].Type != records[j].Type {
+			return records[i].Type < records[j].Type
+		}
+		return records[i].Content < records[j].Content
+	})
+}
+
+// dnsRecordSetsEqual reports whether both lists contain the same records
+// irrespective of order and IDs.
+func dnsRecordSetsEqual(a, b []dnsRecordSetRecord) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]dnsRecordSetRecord(nil), a...)
+	b = append([]dnsRecordSetRecord(nil), b...)
+	sortDNSRecordSetRecords(a)
+	sortDNSRecordSetRecords(b)
+
+	for i := range a {
+		if !a[i].equal(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newDNSRecordSetRecord normalises the values so they compare equal to what
+// the API returns. Names are fully qualified using the zone name.
+func newDNSRecordSetRecord(zoneName, name, recordType, content string, ttl, priority int, proxied bool) (dnsRecordSetRecord, error) {
+	record := dnsRecordSetRecord{
+		Name:     qualifyDNSName(name, zoneName),
+		Type:     strings.ToUpper(recordType),
+		Content:  content,
+		TTL:      ttl,
+		Priority: priority,
+		Proxied:  proxied,
+	}
+
+	if record.TTL == 0 {
+		record.TTL = 1
+	}
+
+	if !contains(recordSetTypes, record.Type) {
+		return record, fmt.Errorf("record type %q is not supported, valid types are %s. Use cloudflare_record for other types", record.Type, strings.Join(recordSetTypes, ", "))
+	}
+
+	switch record.Type {
+	case "CNAME", "MX", "NS", "PTR":
+		record.Content = normalizeDNSTarget(record.Content)
+	case "SRV":
+		fields := strings.Fields(record.Content)
+		if len(fields) != 3 {
+			return record, fmt.Errorf("SRV record %s must have a value of \"weight port target\", got %q", record.Name, record.Content)
+		}
+		fields[2] = normalizeDNSTarget(fields[2])
+		record.Content = strings.Join(fields, " ")
+		if _, _, _, err := splitSRVName(record.Name); err != nil {
+			return record, err
+		}
+	case "CAA":
+		flags, tag, value, err := splitCAAContent(record.Content)
+		if err != nil {
+			return record, fmt.Errorf("CAA record %s: %s", record.Name, err)
+		}
+		record.Content = fmt.Sprintf("%d %s %s", flags, tag, strconv.Quote(value))
+	}
+
+	if record.Type != "MX" && record.Type != "SRV" {
+		record.Priority = 0
+	}
+
+	if err := validateRecordType(record.Type, record.Proxied); err != nil {
+		return record, fmt.Errorf("error validating record type %q for %s: %s", record.Type, record.Name, err)
+	}
+
+	if err := validateRecordName(record.Type, record.Content); err != nil {
+		return record, fmt.Errorf("error validating record name %q: %s", record.Name, err)
+	}
+
+	if record.Proxied && record.TTL != 1 {
+		return record, fmt.Errorf("error validating record %s: ttl must be set to 1 when `proxied` is true", record.Name)
+	}
+
+	return record, nil
+}
+
+// splitSRVName splits the owner name of an SRV record into the service,
+// protocol and name, e.g. "_sip._tcp.example.com".
+func splitSRVName(name string) (string, string, string, error) {
+	labels := strings.SplitN(name, ".", 3)
+	if len(labels) != 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+		return "", "", "", fmt.Errorf("SRV record name %q must be in the format \"_service._proto.name\"", name)
+	}
+
+	return labels[0], labels[1], labels[2], nil
+}
+
+// splitCAAContent splits the content of a CAA record (`0 issue "ca.example"`)
+// into the flags, tag and value. Quoting the value is optional.
+func splitCAAContent(content string) (int, string, string, error) {
+	fields := strings.SplitN(strings.TrimSpace(content), " ", 2)
+	if len(fields) == 2 {
+		rest := strings.SplitN(strings.TrimSpace(fields[1]), " ", 2)
+		if len(rest) == 2 {
+			flags, err := strconv.Atoi(fields[0])
+			if err != nil {
+				return 0, "", "", fmt.Errorf("invalid flags %q", fields[0])
+			}
+
+			value := strings.TrimSpace(rest[1])
+			if unquoted, err := strconv.Unquote(value); err == nil {
+				value = unquoted
+			}
+
+			return flags, strings.ToLower(rest[0]), value, nil
+		}
+	}
+
+	return 0, "", "", fmt.Errorf("value must be in the format `flags tag \"value\"`, got %q", content)
+}
+
+// qualifyDNSName turns a name relative to the zone, "@" or a fully qualified
+// name (with or without the trailing dot) into a lower case fully qualified
+// name without the trailing dot.
+func qualifyDNSName(name, zoneName string) string {
+	name = strings.ToLower(name)
+	zoneName = strings.TrimSuffix(strings.ToLower(zoneName), ".")
+
+	switch {
+	case name == "@" || name == "":
+		return zoneName
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case name == zoneName || strings.HasSuffix(name, "."+zoneName):
+		return name
+	default:
+		return name + "." + zoneName
+	}
+}
+
+// normalizeDNSTarget lower cases a domain name used as record content and
+// drops the trailing dot to match what the API returns.
+func normalizeDNSTarget(target string) string {
+	return strings.TrimSuffix(strings.ToLower(target), ".")
+}
+
+// relativeDNSName is the inverse of qualifyDNSName for rendering.
+func relativeDNSName(name, zoneName string) string {
+	switch {
+	case name == zoneName:
+		return "@"
+	case strings.HasSuffix(name, "."+zoneName):
+		return strings.TrimSuffix(name, "."+zoneName)
+	default:
+		return name + "."
+	}
+}
+
+// zoneFileLine is a logical line of a zone file once comments have been
+// stripped and parentheses joined.
+type zoneFileLine struct {
+	number int
+	// indented lines inherit the owner name of the previous record.
+	indented bool
+	tokens   []zoneFileToken
+	comment  string
+}
+
+type zoneFileToken struct {
+	value  string
+	quoted bool
+}
+
+// parseZoneFile parses the records out of BIND zone file text. Relative names
+// are qualified with `$ORIGIN` or the zone name when there isn't one. The SOA
+// and apex NS records are managed by Cloudflare and therefore skipped.
+//
+// Cloudflare's zone file exports mark proxied records with a
+// `cf_tags=cf-proxied:true` comment which is honoured here too.
+func parseZoneFile(zoneFile, zoneName string) ([]dnsRecordSetRecord, error) {
+	lines, err := splitZoneFile(zoneFile)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneName = strings.TrimSuffix(strings.ToLower(zoneName), ".")
+	origin := zoneName
+	defaultTTL := 0
+	previousName := ""
+
+	var records []dnsRecordSetRecord
+	for _, line := range lines {
+		tokens := line.tokens
+
+		if !line.indented && strings.HasPrefix(tokens[0].value, "$") {
+			switch strings.ToUpper(tokens[0].value) {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN expects a single domain name", line.number)
+				}
+				origin = qualifyDNSName(tokens[1].value, origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL expects a single value", line.number)
+				}
+				if defaultTTL, err = parseZoneFileTTL(tokens[1].value); err != nil {
+					return nil, fmt.Errorf("line %d: %s", line.number, err)
+				}
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", line.number, tokens[0].value)
+			}
+			continue
+		}
+
+		name := previousName
+		if !line.indented {
+			name = qualifyDNSName(tokens[0].value, origin)
+			tokens = tokens[1:]
+		}
+		if name == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", line.number)
+		}
+		previousName = name
+
+		ttl := defaultTTL
+		for len(tokens) > 0 {
+			if v, err := parseZoneFileTTL(tokens[0].value); err == nil {
+				ttl = v
+			} else if !strings.EqualFold(tokens[0].value, "IN") {
+				break
+			}
+			tokens = tokens[1:]
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: record for %s has no type", line.number, name)
+		}
+
+		recordType := strings.ToUpper(tokens[0].value)
+		rdata := tokens[1:]
+
+		if recordType == "SOA" || (recordType == "NS" && name == zoneName) {
+			continue
+		}
+
+		content, priority, err := parseZoneFileRdata(recordType, rdata, origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s record for %s: %s", line.number, recordType, name, err)
+		}
+
+		proxied := strings.Contains(line.comment, "cf-proxied:true")
+
+		record, err := newDNSRecordSetRecord(zoneName, name+".", recordType, content, ttl, priority, proxied)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line.number, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// parseZoneFileRdata converts the record data into the content (and priority)
+// used by the API. Domain names are qualified with the origin.
+func parseZoneFileRdata(recordType string, rdata []zoneFileToken, origin string) (string, int, error) {
+	expect := func(n int, format string) error {
+		if len(rdata) != n {
+			return fmt.Errorf("expected %q but got %d values", format, len(rdata))
+		}
+		return nil
+	}
+
+	switch recordType {
+	case "A", "AAAA":
+		if err := expect(1, "address"); err != nil {
+			return "", 0, err
+		}
+		return rdata[0].value, 0, nil
+	case "CNAME", "NS", "PTR":
+		if err := expect(1, "target"); err != nil {
+			return "", 0, err
+		}
+		return qualifyDNSName(rdata[0].value, origin) + ".", 0, nil
+	case "MX":
+		if err := expect(2, "preference exchange"); err != nil {
+			return "", 0, err
+		}
+		priority, err := strconv.Atoi(rdata[0].value)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid preference %q", rdata[0].value)
+		}
+		return qualifyDNSName(rdata[1].value, origin) + ".", priority, nil
+	case "SRV":
+		if err := expect(4, "priority weight port target"); err != nil {
+			return "", 0, err
+		}
+		priority, err := strconv.Atoi(rdata[0].value)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid priority %q", rdata[0].value)
+		}
+		return fmt.Sprintf("%s %s %s.", rdata[1].value, rdata[2].value, qualifyDNSName(rdata[3].value, origin)), priority, nil
+	case "CAA":
+		if err := expect(3, "flags tag value"); err != nil {
+			return "", 0, err
+		}
+		return fmt.Sprintf("%s %s %s", rdata[0].value, rdata[1].value, strconv.Quote(rdata[2].value)), 0, nil
+	case "TXT", "SPF":
+		if len(rdata) == 0 {
+			return "", 0, fmt.Errorf("expected at least one string")
+		}
+		var content strings.Builder
+		for _, token := range rdata {
+			content.WriteString(token.value)
+		}
+		return content.String(), 0, nil
+	}
+
+	// Let the record validation report the unsupported type.
+	var values []string
+	for _, token := range rdata {
+		values = append(values, token.value)
+	}
+	return strings.Join(values, " "), 0, nil
+}
+
+// parseZoneFileTTL parses a TTL in seconds or using BIND's unit suffixes
+// (e.g. "1h30m").
+func parseZoneFileTTL(value string) (int, error) {
+	if v, err := strconv.Atoi(value); err == nil && v >= 0 {
+		return v, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, current := 0, -1
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= '0' && c <= '9':
+			if current < 0 {
+				current = 0
+			}
+			current = current*10 + int(c-'0')
+		case current >= 0 && units[c|0x20] > 0:
+			total += current * units[c|0x20]
+			current = -1
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+	}
+
+	if current >= 0 || value == "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+
+	return total, nil
+}
+
+// splitZoneFile tokenises the zone file into logical lines, dealing with
+// quoting, escapes, comments and parentheses spanning multiple lines.
+func splitZoneFile(zoneFile string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var current zoneFileLine
+	var token strings.Builder
+	inToken, quoted, inQuotes, inComment := false, false, false, false
+	parens, number, start := 0, 1, 1
+	atLineStart := true
+
+	endToken := func() {
+		if inToken {
+			current.tokens = append(current.tokens, zoneFileToken{value: token.String(), quoted: quoted})
+		}
+		token.Reset()
+		inToken, quoted = false, false
+	}
+
+	endLine := func() {
+		endToken()
+		if len(current.tokens) > 0 {
+			current.number = start
+			lines = append(lines, current)
+		}
+		current = zoneFileLine{}
+	}
+
+	for i := 0; i < len(zoneFile); i++ {
+		c := zoneFile[i]
+
+		if inComment {
+			if c == '\n' {
+				inComment = false
+			} else {
+				current.comment += string(c)
+				continue
+			}
+		}
+
+		if atLineStart && parens == 0 {
+			start = number
+			current.indented = c == ' ' || c == '\t'
+			atLineStart = false
+		}
+
+		switch {
+		case inQuotes && c == '\\':
+			if i+3 < len(zoneFile) && isDigits(zoneFile[i+1:i+4]) {
+				v, _ := strconv.Atoi(zoneFile[i+1 : i+4])
+				token.WriteByte(byte(v))
+				i += 3
+			} else if i+1 < len(zoneFile) {
+				i++
+				token.WriteByte(zoneFile[i])
+			}
+		case inQuotes && c == '"':
+			inQuotes = false
+		case inQuotes:
+			if c == '\n' {
+				return nil, fmt.Errorf("line %d: unterminated string", number)
+			}
+			token.WriteByte(c)
+		case c == '"':
+			endToken()
+			inToken, quoted, inQuotes = true, true, true
+		case c == ';':
+			endToken()
+			inComment = true
+		case c == '(':
+			endToken()
+			parens++
+		case c == ')':
+			endToken()
+			if parens == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+			}
+			parens--
+		case c == '\n':
+			number++
+			if parens > 0 {
+				endToken()
+				continue
+			}
+			endLine()
+			atLineStart = true
+		case c == ' ' || c == '\t' || c == '\r':
+			endToken()
+		default:
+			inToken = true
+			token.WriteByte(c)
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated string", number)
+	}
+	if parens > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", start)
+	}
+	endLine()
+
+	return lines, nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+// renderZoneFile writes the records out as a zone file with names relative to
+// the zone.
+func renderZoneFile(records []dnsRecordSetRecord, zoneName string) string {
+	records = append([]dnsRecordSetRecord(nil), records...)
+	sortDNSRecordSetRecords(records)
+
+	var b strings.Builder
+	for _, r := range records {
+		var rdata string
+		switch r.Type {
+		case "CNAME", "NS", "PTR":
+			rdata = r.Content + "."
+		case "MX":
+			rdata = fmt.Sprintf("%d %s.", r.Priority, r.Content)
+		case "SRV":
+			rdata = fmt.Sprintf("%d %s.", r.Priority, r.Content)
+		case "TXT", "SPF":
+			rdata = strconv.Quote(r.Content)
+		default:
+			rdata = r.Content
+		}
+
+		fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s", relativeDNSName(r.Name, zoneName), r.TTL, r.Type, rdata)
+		if r.Proxied {
+			b.WriteString(" ; cf_tags=cf-proxied:true")
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,161 @@
+package cloudflare
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	zoneFile := `
+$ORIGIN example.com.
+$TTL 1h
+@	3600	IN	SOA	ns1.example.com. admin.example.com. (
+			2021010101 ; serial
+			7200       ; refresh
+			3600       ; retry
+			1209600    ; expire
+			3600 )     ; minimum
+@		IN	NS	ns1.cloudflare.com.
+@	1	IN	A	192.0.2.1 ; cf_tags=cf-proxied:true
+	IN 300	AAAA	2001:db8::1
+www		CNAME	@
+WWW2.example.com.	300	CNAME	Target.Example.NET.
+@	IN	MX	10 mail
+_sip._tcp	IN	SRV	10 5 5060 sip.example.com.
+@	CAA	0 issue "letsencrypt.org"
+txt	1d	TXT	"v=spf1 include:_spf.example.com" " ~all" ; a comment
+escaped	TXT	"say \"hi\"; \065"
+sub	NS	ns.other.net.
+`
+
+	records, err := parseZoneFile(zoneFile, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []dnsRecordSetRecord{
+		{Name: "example.com", Type: "A", Content: "192.0.2.1", TTL: 1, Proxied: true},
+		{Name: "example.com", Type: "AAAA", Content: "2001:db8::1", TTL: 300},
+		{Name: "www.example.com", Type: "CNAME", Content: "example.com", TTL: 3600},
+		{Name: "www2.example.com", Type: "CNAME", Content: "target.example.net", TTL: 300},
+		{Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 3600, Priority: 10},
+		{Name: "_sip._tcp.example.com", Type: "SRV", Content: "5 5060 sip.example.com", TTL: 3600, Priority: 10},
+		{Name: "example.com", Type: "CAA", Content: `0 issue "letsencrypt.org"`, TTL: 3600},
+		{Name: "txt.example.com", Type: "TXT", Content: "v=spf1 include:_spf.example.com ~all", TTL: 86400},
+		{Name: "escaped.example.com", Type: "TXT", Content: `say "hi"; A`, TTL: 3600},
+		{Name: "sub.example.com", Type: "NS", Content: "ns.other.net", TTL: 3600},
+	}
+
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("unexpected records:\n got: %+v\nwant: %+v", records, expected)
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	zoneFiles := map[string]string{
+		`$INCLUDE other.zone`:                           "unsupported directive $INCLUDE",
+		`$GENERATE 1-10 host$ A 192.0.2.$`:              "unsupported directive $GENERATE",
+		`www A 192.0.2.1 192.0.2.2`:                     "line 1: A record for www.example.com",
+		`www A not-an-ip`:                               "A record must be a valid IPv4 address",
+		"www A 192.0.2.1\nwww 300 AAAA 192.0.2.1":       "line 2",
+		`www LOC 51 30 12.748 N 0 7 39.611 W 0.00m`:     `record type "LOC" is not supported`,
+		`www TXT "unterminated`:                         "unterminated string",
+		`www MX ( 10 mail.example.com.`:                 "unbalanced parentheses",
+		`www MX 10 mail.example.com. )`:                 "unbalanced parentheses",
+		`www 1x A 192.0.2.1`:                            `record type "1X" is not supported`,
+		`_sip SRV 10 5 5060 sip.example.com.`:           "must be in the format",
+		`www 300 A 192.0.2.1 ; cf_tags=cf-proxied:true`: "ttl must be set to 1",
+		`www TXT "a" ; cf_tags=cf-proxied:true`:         `type "TXT" cannot be proxied`,
+		"\tA 192.0.2.1":                                 "record has no owner name",
+	}
+
+	for zoneFile, expected := range zoneFiles {
+		_, err := parseZoneFile(zoneFile, "example.com")
+		if err == nil {
+			t.Errorf("%q should be invalid", zoneFile)
+			continue
+		}
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("%q should fail with %q but got %q", zoneFile, expected, err)
+		}
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	ttls := map[string]int{
+		"0":      0,
+		"300":    300,
+		"5m":     300,
+		"1h30m":  5400,
+		"1D":     86400,
+		"2w":     1209600,
+		"1h30m5": -1,
+		"h":      -1,
+		"":       -1,
+		"1y":     -1,
+	}
+
+	for value, expected := range ttls {
+		ttl, err := parseZoneFileTTL(value)
+		if expected < 0 {
+			if err == nil {
+				t.Errorf("%q should be invalid", value)
+			}
+			continue
+		}
+		if err != nil || ttl != expected {
+			t.Errorf("%q should be %d but got %d (%v)", value, expected, ttl, err)
+		}
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	records := []dnsRecordSetRecord{
+		{Name: "www.example.com", Type: "CNAME", Content: "example.com", TTL: 1, Proxied: true},
+		{Name: "example.com", Type: "TXT", Content: `say "hi"`, TTL: 300},
+		{Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 1, Priority: 10},
+		{Name: "_sip._tcp.example.com", Type: "SRV", Content: "5 5060 sip.example.com", TTL: 1, Priority: 10},
+		{Name: "example.com", Type: "CAA", Content: `0 issue "letsencrypt.org"`, TTL: 1},
+		{Name: "other.example.net", Type: "A", Content: "192.0.2.1", TTL: 1},
+	}
+
+	expected := strings.Join([]string{
+		"_sip._tcp\t1\tIN\tSRV\t10 5 5060 sip.example.com.",
+		"@\t1\tIN\tCAA\t0 issue \"letsencrypt.org\"",
+		"@\t1\tIN\tMX\t10 mail.example.com.",
+		"@\t300\tIN\tTXT\t\"say \\\"hi\\\"\"",
+		"other.example.net.\t1\tIN\tA\t192.0.2.1",
+		"www\t1\tIN\tCNAME\texample.com. ; cf_tags=cf-proxied:true",
+		"",
+	}, "\n")
+
+	zoneFile := renderZoneFile(records, "example.com")
+	if zoneFile != expected {
+		t.Errorf("unexpected zone file:\n got: %q\nwant: %q", zoneFile, expected)
+	}
+
+	parsed, err := parseZoneFile(zoneFile, "example.com")
+	if err != nil {
+		t.Fatalf("rendered zone file should be valid: %s", err)
+	}
+	if !dnsRecordSetsEqual(parsed, records) {
+		t.Errorf("rendered zone file should round trip:\n got: %+v\nwant: %+v", parsed, records)
+	}
+}
+
+func TestNewDNSRecordSetRecord(t *testing.T) {
+	record, err := newDNSRecordSetRecord("example.com", "@", "caa", `0 ISSUE letsencrypt.org`, 0, 5, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := dnsRecordSetRecord{Name: "example.com", Type: "CAA", Content: `0 issue "letsencrypt.org"`, TTL: 1}
+	if record != expected {
+		t.Errorf("got %+v, want %+v", record, expected)
+	}
+
+	if _, err := newDNSRecordSetRecord("example.com", "www", "LOC", "51 30 12.748 N", 1, 0, false); err == nil || !strings.Contains(err.Error(), "cloudflare_record") {
+		t.Errorf("expected an unsupported type error but got %v", err)
+	}
+}
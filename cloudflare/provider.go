@@ -1,14 +1,19 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"regexp"
+	"strings"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/version"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -41,6 +46,20 @@ func Provider() *schema.Provider {
 				ValidateFunc: validation.StringMatch(regexp.MustCompile("[A-Za-z0-9-_]{40}"), "API tokens must only contain characters a-z, A-Z, 0-9, hyphens and underscores"),
 			},
 
+			"api_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_API_TOKEN_FILE", nil),
+				Description: "Path to a file containing the API Token for operations. The file is read again when the token is rejected.",
+			},
+
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_CREDENTIAL_PROCESS", nil),
+				Description: "Command returning the API Token for operations and its expiration as JSON. The command is run again before the token expires.",
+			},
+
 			"api_user_service_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -76,6 +95,13 @@ func Provider() *schema.Provider {
 				Description: "Maximum backoff period in seconds after failed API calls",
 			},
 
+			"adaptive_rate_limiting": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_ADAPTIVE_RATE_LIMITING", false),
+				Description: "Whether to adjust the rate of API calls based on the rate limit responses of the API, using `rps` as the maximum rate",
+			},
+
 			"api_client_logging": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -103,13 +129,81 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_API_BASE_PATH", "/client/v4"),
 				Description: "Configure the base path used by the API client",
 			},
+
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_HTTP_PROXY", nil),
+				Description: "URL of the HTTP proxy to use for API calls, optionally including the proxy credentials",
+			},
+
+			"ca_bundle_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("CLOUDFLARE_CA_BUNDLE_FILE", nil),
+				ConflictsWith: []string{"ca_bundle_pem"},
+				Description:   "Path to a PEM file with additional CA certificates to trust for API calls",
+			},
+
+			"ca_bundle_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_bundle_file"},
+				Description:   "PEM encoded additional CA certificates to trust for API calls",
+			},
+
+			"client_certificate_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("CLOUDFLARE_CLIENT_CERTIFICATE_FILE", nil),
+				ConflictsWith: []string{"client_certificate_pem"},
+				Description:   "Path to a PEM file with the client certificate to use for mutual TLS",
+			},
+
+			"client_certificate_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_certificate_file"},
+				Description:   "PEM encoded client certificate to use for mutual TLS",
+			},
+
+			"client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("CLOUDFLARE_CLIENT_KEY_FILE", nil),
+				ConflictsWith: []string{"client_key_pem"},
+				Description:   "Path to a PEM file with the private key of the client certificate",
+			},
+
+			"client_key_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"client_key_file"},
+				Description:   "PEM encoded private key of the client certificate",
+			},
+
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_INSECURE_SKIP_VERIFY", false),
+				Description: "Whether to skip verifying the TLS certificate of the API. Only use this for testing",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"cloudflare_access_application":          dataSourceCloudflareAccessApplication(),
+			"cloudflare_access_groups":               dataSourceCloudflareAccessGroups(),
+			"cloudflare_access_identity_providers":   dataSourceCloudflareAccessIdentityProviders(),
 			"cloudflare_account_roles":               dataSourceCloudflareAccountRoles(),
 			"cloudflare_api_token_permission_groups": dataSourceCloudflareApiTokenPermissionGroups(),
 			"cloudflare_ip_ranges":                   dataSourceCloudflareIPRanges(),
+			"cloudflare_load_balancer_monitors":      dataSourceCloudflareLoadBalancerMonitors(),
+			"cloudflare_load_balancer_pool_health":   dataSourceCloudflareLoadBalancerPoolHealth(),
+			"cloudflare_load_balancer_pools":         dataSourceCloudflareLoadBalancerPools(),
 			"cloudflare_origin_ca_root_certificate":  dataSourceCloudflareOriginCARootCertificate(),
+			"cloudflare_records":                     dataSourceCloudflareRecords(),
 			"cloudflare_waf_groups":                  dataSourceCloudflareWAFGroups(),
 			"cloudflare_waf_packages":                dataSourceCloudflareWAFPackages(),
 			"cloudflare_waf_rules":                   dataSourceCloudflareWAFRules(),
@@ -128,6 +222,8 @@ func Provider() *schema.Provider {
 			"cloudflare_access_identity_provider":               resourceCloudflareAccessIdentityProvider(),
 			"cloudflare_access_mutual_tls_certificate":          resourceCloudflareAccessMutualTLSCertificate(),
 			"cloudflare_access_keys_configuration":              resourceCloudflareAccessKeysConfiguration(),
+			"cloudflare_access_organization":                    resourceCloudflareAccessOrganization(),
+			"cloudflare_access_custom_page":                     resourceCloudflareAccessCustomPage(),
 			"cloudflare_account_member":                         resourceCloudflareAccountMember(),
 			"cloudflare_api_token":                              resourceCloudflareApiToken(),
 			"cloudflare_argo":                                   resourceCloudflareArgo(),
@@ -141,6 +237,7 @@ func Provider() *schema.Provider {
 			"cloudflare_custom_pages":                           resourceCloudflareCustomPages(),
 			"cloudflare_custom_ssl":                             resourceCloudflareCustomSsl(),
 			"cloudflare_device_posture_rule":                    resourceCloudflareDevicePostureRule(),
+			"cloudflare_dns_firewall":                           resourceCloudflareDNSFirewall(),
 			"cloudflare_filter":                                 resourceCloudflareFilter(),
 			"cloudflare_firewall_rule":                          resourceCloudflareFirewallRule(),
 			"cloudflare_healthcheck":                            resourceCloudflareHealthcheck(),
@@ -156,13 +253,17 @@ func Provider() *schema.Provider {
 			"cloudflare_page_rule":                              resourceCloudflarePageRule(),
 			"cloudflare_rate_limit":                             resourceCloudflareRateLimit(),
 			"cloudflare_record":                                 resourceCloudflareRecord(),
+			"cloudflare_record_set":                             resourceCloudflareRecordSet(),
 			"cloudflare_ruleset":                                resourceCloudflareRuleset(),
+			"cloudflare_ruleset_phase_entrypoint":               resourceCloudflareRulesetPhaseEntrypoint(),
 			"cloudflare_spectrum_application":                   resourceCloudflareSpectrumApplication(),
 			"cloudflare_static_route":                           resourceCloudflareStaticRoute(),
 			"cloudflare_teams_list":                             resourceCloudflareTeamsList(),
 			"cloudflare_teams_location":                         resourceCloudflareTeamsLocation(),
 			"cloudflare_teams_account":                          resourceCloudflareTeamsAccount(),
 			"cloudflare_teams_rule":                             resourceCloudflareTeamsRule(),
+			"cloudflare_tunnel_config":                          resourceCloudflareTunnelConfig(),
+			"cloudflare_tunnel_route":                           resourceCloudflareTunnelRoute(),
 			"cloudflare_waf_group":                              resourceCloudflareWAFGroup(),
 			"cloudflare_waf_package":                            resourceCloudflareWAFPackage(),
 			"cloudflare_waf_rule":                               resourceCloudflareWAFRule(),
@@ -177,56 +278,141 @@ func Provider() *schema.Provider {
 			"cloudflare_zone_settings_override":                 resourceCloudflareZoneSettingsOverride(),
 			"cloudflare_zone":                                   resourceCloudflareZone(),
 			"cloudflare_zone_dnssec":                            resourceCloudflareZoneDNSSEC(),
+			"cloudflare_zone_transfer_incoming":                 resourceCloudflareZoneTransferIncoming(),
+			"cloudflare_zone_transfer_outgoing":                 resourceCloudflareZoneTransferOutgoing(),
+			"cloudflare_zone_transfer_peer":                     resourceCloudflareZoneTransferPeer(),
+			"cloudflare_zone_transfer_tsig":                     resourceCloudflareZoneTransferTSIG(),
 			"cloudflare_notification_policy":                    resourceCloudflareNotificationPolicy(),
 			"cloudflare_notification_policy_webhooks":           resourceCloudflareNotificationPolicyWebhooks(),
 			"cloudflare_split_tunnel":                           resourceCloudflareSplitTunnel(),
 		},
 	}
 
-	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
 			// Terraform 0.12 introduced this field to the protocol
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigure(d, terraformVersion)
+
+		client, err := providerConfigure(ctx, d, terraformVersion)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		return client, nil
 	}
 
 	return provider
 }
 
-func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+// apiTransportWrapper wraps the HTTP transport used by the API client. The
+// default leaves it untouched but tests swap it out to record and replay the
+// API interactions.
+var apiTransportWrapper = func(transport http.RoundTripper) http.RoundTripper {
+	return transport
+}
+
+// credentialEnvVars are the environment variables of the kinds of credentials,
+// in the order they're used when more than one is set through the environment.
+var credentialEnvVars = []struct {
+	attribute string
+	envVar    string
+}{
+	{"api_token", "CLOUDFLARE_API_TOKEN"},
+	{"api_token_file", "CLOUDFLARE_API_TOKEN_FILE"},
+	{"credential_process", "CLOUDFLARE_CREDENTIAL_PROCESS"},
+	{"api_key", "CLOUDFLARE_API_KEY"},
+}
+
+// providerCredential returns the attribute of the credentials to use, if any.
+// The credentials set in the configuration conflict with each other and take
+// precedence over the ones set through the environment. The configuration
+// can't be told apart from the environment once the defaults are applied so
+// values equal to their environment variable are considered to come from it.
+func providerCredential(d *schema.ResourceData) (string, error) {
+	var configured, fromEnv []string
+	for _, c := range credentialEnvVars {
+		v, ok := d.GetOk(c.attribute)
+		if !ok {
+			continue
+		}
+
+		if env := os.Getenv(c.envVar); env != "" && env == v.(string) {
+			fromEnv = append(fromEnv, c.attribute)
+		} else {
+			configured = append(configured, c.attribute)
+		}
+	}
+
+	switch {
+	case len(configured) > 1:
+		return "", fmt.Errorf("only one of api_token, api_token_file, credential_process and api_key can be set, got %s", strings.Join(configured, ", "))
+	case len(configured) == 1:
+		return configured[0], nil
+	case len(fromEnv) > 0:
+		return fromEnv[0], nil
+	}
+
+	return "", nil
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	baseURL := cloudflare.BaseURL(
 		"https://" + d.Get("api_hostname").(string) + d.Get("api_base_path").(string),
 	)
-	limitOpt := cloudflare.UsingRateLimit(float64(d.Get("rps").(int)))
-	retryOpt := cloudflare.UsingRetryPolicy(d.Get("retries").(int), d.Get("min_backoff").(int), d.Get("max_backoff").(int))
-	options := []cloudflare.Option{limitOpt, retryOpt, baseURL}
+	options := []cloudflare.Option{baseURL}
 
 	if d.Get("api_client_logging").(bool) {
 		options = append(options, cloudflare.UsingLogger(log.New(os.Stderr, "", log.LstdFlags)))
 	}
 
 	c := cleanhttp.DefaultClient()
-	c.Transport = logging.NewTransport("Cloudflare", c.Transport)
-	options = append(options, cloudflare.HTTPClient(c))
+	if err := configureTransport(c.Transport.(*http.Transport), d); err != nil {
+		return nil, err
+	}
+
+	transport := apiTransportWrapper(c.Transport)
+	if d.Get("adaptive_rate_limiting").(bool) {
+		log.Printf("[INFO] Using adaptive rate limiting with a maximum of %d requests per second", d.Get("rps").(int))
+		transport = newAdaptiveRateLimiter(transport, float64(d.Get("rps").(int)), time.Duration(d.Get("min_backoff").(int))*time.Second)
+	}
+	c.Transport = logging.NewTransport("Cloudflare", transport)
 
 	ua := fmt.Sprintf("terraform/%s terraform-plugin-sdk/%s terraform-provider-cloudflare/%s", terraformVersion, meta.SDKVersionString(), version.ProviderVersion)
 	options = append(options, cloudflare.UserAgent(ua))
 
-	config := Config{Options: options}
+	config := Config{
+		Options:    options,
+		HTTPClient: c,
+		RateLimit:  float64(d.Get("rps").(int)),
+		RetryPolicy: cloudflare.RetryPolicy{
+			MaxRetries:    d.Get("retries").(int),
+			MinRetryDelay: time.Duration(d.Get("min_backoff").(int)) * time.Second,
+			MaxRetryDelay: time.Duration(d.Get("max_backoff").(int)) * time.Second,
+		},
+	}
 
-	if v, ok := d.GetOk("api_token"); ok {
-		config.APIToken = v.(string)
-	} else if v, ok := d.GetOk("api_key"); ok {
-		config.APIKey = v.(string)
-		if v, ok = d.GetOk("email"); ok {
+	credential, err := providerCredential(d)
+	if err != nil {
+		return nil, err
+	}
+
+	switch credential {
+	case "api_token":
+		config.APIToken = d.Get(credential).(string)
+	case "api_token_file":
+		config.APITokenFile = d.Get(credential).(string)
+	case "credential_process":
+		config.CredentialProcess = d.Get(credential).(string)
+	case "api_key":
+		config.APIKey = d.Get(credential).(string)
+		if v, ok := d.GetOk("email"); ok {
 			config.Email = v.(string)
 		} else {
 			return nil, fmt.Errorf("email is not set correctly")
 		}
-	} else {
+	default:
 		return nil, fmt.Errorf("credentials are not set correctly")
 	}
 
@@ -234,7 +420,7 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		config.APIUserServiceKey = v.(string)
 	}
 
-	client, err := config.Client()
+	client, err := config.Client(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -242,16 +428,14 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 	if accountID, ok := d.GetOk("account_id"); ok {
 		log.Printf("[INFO] Using specified account id %s in Cloudflare provider", accountID.(string))
 		options = append(options, cloudflare.UsingAccount(accountID.(string)))
-	} else {
-		return client, err
-	}
 
-	config.Options = options
+		config.Options = options
 
-	client, err = config.Client()
-	if err != nil {
-		return nil, err
+		client, err = config.Client(ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return client, err
+	return client, nil
 }
@@ -0,0 +1,150 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+// providerCache is an in-memory cache of lookups that don't change during a
+// run, such as the account a zone belongs to or the WAF packages of a zone.
+// Entries are keyed by the API path of the object they were read from so
+// writes to that object can drop them.
+type providerCache struct {
+	mu      sync.Mutex
+	entries map[string]interface{}
+	hits    int
+	misses  int
+}
+
+func newProviderCache() *providerCache {
+	return &providerCache{entries: make(map[string]interface{})}
+}
+
+// get returns the cached value for key, calling fetch to populate it on a
+// miss. Errors aren't cached.
+func (c *providerCache) get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if value, ok := c.entries[key]; ok {
+		c.hits++
+		log.Printf("[DEBUG] Cloudflare provider cache hit for %s (%d hits, %d misses)", key, c.hits, c.misses)
+		c.mu.Unlock()
+		return value, nil
+	}
+	c.misses++
+	log.Printf("[DEBUG] Cloudflare provider cache miss for %s (%d hits, %d misses)", key, c.hits, c.misses)
+	c.mu.Unlock()
+
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[key] = value
+	c.mu.Unlock()
+
+	return value, nil
+}
+
+// invalidate drops the entry for key and the entries of the objects nested
+// under it, e.g. invalidating a zone drops its WAF packages as well.
+func (c *providerCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k := range c.entries {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			log.Printf("[DEBUG] Cloudflare provider cache invalidated %s", k)
+			delete(c.entries, k)
+		}
+	}
+}
+
+func zoneCacheKey(zoneID string) string {
+	return fmt.Sprintf("zones/%s", zoneID)
+}
+
+func wafPackagesCacheKey(zoneID string) string {
+	return fmt.Sprintf("zones/%s/firewall/waf/packages", zoneID)
+}
+
+// zoneNamesCacheKey is the parent of all zone name lookups, which are dropped
+// whenever a zone is created, renamed or deleted.
+const zoneNamesCacheKey = "zone_names"
+
+// cachedZoneDetails returns the details of the zone. Only use it for
+// attributes that don't change, like the name and account of the zone.
+func cachedZoneDetails(ctx context.Context, client *providerClient, zoneID string) (cloudflare.Zone, error) {
+	zone, err := client.cache.get(zoneCacheKey(zoneID), func() (interface{}, error) {
+		return client.ZoneDetails(ctx, zoneID)
+	})
+	if err != nil {
+		return cloudflare.Zone{}, err
+	}
+	return zone.(cloudflare.Zone), nil
+}
+
+// cachedZoneIDsByName returns the IDs of the zones named name, optionally
+// limited to the zones of the account. Only the IDs are cached as the status,
+// plan and name servers of the zones can change at any time.
+func cachedZoneIDsByName(ctx context.Context, client *providerClient, name, accountID string) ([]string, error) {
+	key := fmt.Sprintf("%s/%s/%s", zoneNamesCacheKey, accountID, name)
+	ids, err := client.cache.get(key, func() (interface{}, error) {
+		resp, err := client.ListZonesContext(ctx, cloudflare.WithZoneFilters(name, accountID, ""))
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]string, 0, len(resp.Result))
+		for _, zone := range resp.Result {
+			ids = append(ids, zone.ID)
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids.([]string), nil
+}
+
+func cachedAPITokenPermissionGroups(ctx context.Context, client *providerClient) ([]cloudflare.APITokenPermissionGroups, error) {
+	groups, err := client.cache.get("user/tokens/permission_groups", func() (interface{}, error) {
+		return client.ListAPITokensPermissionGroups(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groups.([]cloudflare.APITokenPermissionGroups), nil
+}
+
+// cachedWAFPackages returns the WAF packages of the zone. Only the fields
+// identifying the packages are kept as their sensitivity and action mode can
+// be changed at any time.
+func cachedWAFPackages(ctx context.Context, client *providerClient, zoneID string) ([]cloudflare.WAFPackage, error) {
+	packages, err := client.cache.get(wafPackagesCacheKey(zoneID), func() (interface{}, error) {
+		packages, err := client.ListWAFPackages(ctx, zoneID)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]cloudflare.WAFPackage, 0, len(packages))
+		for _, pkg := range packages {
+			result = append(result, cloudflare.WAFPackage{
+				ID:          pkg.ID,
+				Name:        pkg.Name,
+				Description: pkg.Description,
+				ZoneID:      pkg.ZoneID,
+			})
+		}
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return packages.([]cloudflare.WAFPackage), nil
+}
@@ -0,0 +1,83 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestProviderCache(t *testing.T) {
+	cache := newProviderCache()
+
+	fetches := 0
+	fetch := func() (interface{}, error) {
+		fetches++
+		return fetches, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if value, _ := cache.get(wafPackagesCacheKey("zone"), fetch); value != 1 {
+			t.Errorf("expected the cached value 1 but got %v", value)
+		}
+	}
+	cache.get("zones/zone/firewall/waf/packages/package/groups", fetch)
+	cache.get(wafPackagesCacheKey("other-zone"), fetch)
+
+	if fetches != 3 {
+		t.Errorf("expected 3 fetches but got %d", fetches)
+	}
+	if cache.hits != 2 || cache.misses != 3 {
+		t.Errorf("expected 2 hits and 3 misses but got %d and %d", cache.hits, cache.misses)
+	}
+
+	cache.invalidate(zoneCacheKey("zone"))
+
+	if _, ok := cache.entries[wafPackagesCacheKey("zone")]; ok {
+		t.Error("expected the packages of the zone to be invalidated")
+	}
+	if _, ok := cache.entries["zones/zone/firewall/waf/packages/package/groups"]; ok {
+		t.Error("expected the groups of the zone to be invalidated")
+	}
+	if _, ok := cache.entries[wafPackagesCacheKey("other-zone")]; !ok {
+		t.Error("expected the packages of the other zone to be kept")
+	}
+}
+
+func TestProviderCacheErrorsAreNotCached(t *testing.T) {
+	cache := newProviderCache()
+
+	if _, err := cache.get("user/tokens/permission_groups", func() (interface{}, error) {
+		return nil, fmt.Errorf("HTTP status 500")
+	}); err == nil {
+		t.Error("expected the error to be returned")
+	}
+
+	if _, ok := cache.entries["user/tokens/permission_groups"]; ok {
+		t.Error("expected the error not to be cached")
+	}
+}
+
+func TestProviderCachePerProvider(t *testing.T) {
+	for _, env := range []string{"CLOUDFLARE_API_KEY", "CLOUDFLARE_API_TOKEN_FILE", "CLOUDFLARE_CREDENTIAL_PROCESS"} {
+		t.Setenv(env, "")
+	}
+
+	configure := func() *providerClient {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"api_token": "token"})
+		meta, err := providerConfigure(context.Background(), d, "0.15.0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return meta.(*providerClient)
+	}
+
+	a, b := configure(), configure()
+	if a.cache == nil || b.cache == nil {
+		t.Fatal("expected the configured providers to have a cache")
+	}
+	if a.cache == b.cache {
+		t.Error("expected a separate cache for each configured provider")
+	}
+}
@@ -1,11 +1,17 @@
 package cloudflare
 
 import (
+	"context"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const (
@@ -33,6 +39,7 @@ var (
 
 func init() {
 	testAccProvider = Provider()
+	testAccRecordProvider(testAccProvider)
 	testAccProviders = map[string]*schema.Provider{
 		ProviderNameCloudflare: testAccProvider,
 	}
@@ -44,6 +51,76 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// testProviderCredentialsEnv sets the environment variables of the
+// credentials, unsetting the others for the duration of the test.
+func testProviderCredentialsEnv(t *testing.T, env map[string]string) {
+	for _, c := range credentialEnvVars {
+		t.Setenv(c.envVar, env[c.envVar])
+	}
+	t.Setenv("CLOUDFLARE_EMAIL", env["CLOUDFLARE_EMAIL"])
+}
+
+func testProviderConfigure(t *testing.T, raw map[string]interface{}) (*providerClient, diag.Diagnostics) {
+	provider := Provider()
+	config := terraform.NewResourceConfigRaw(raw)
+
+	if diags := provider.Validate(config); diags.HasError() {
+		return nil, diags
+	}
+	if diags := provider.Configure(context.Background(), config); diags.HasError() {
+		return nil, diags
+	}
+
+	return provider.Meta().(*providerClient), nil
+}
+
+func TestProviderCredentialsConflict(t *testing.T) {
+	testProviderCredentialsEnv(t, nil)
+
+	_, diags := testProviderConfigure(t, map[string]interface{}{
+		"api_token":          "JUVNGlGbN5kL-UHvHRnZbZzkpwvvKrm8Yh3-Pr2e",
+		"credential_process": "vault read -field=token cloudflare/token",
+	})
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "only one of") {
+		t.Errorf("expected an error when more than one kind of credentials is set, got %v", diags)
+	}
+}
+
+func TestProviderCredentialsFromEnvironment(t *testing.T) {
+	testProviderCredentialsEnv(t, map[string]string{
+		"CLOUDFLARE_API_KEY":   "6e5d8f5a5c8f1e0b5a3c6d2e7f0a9b8c7d6e5",
+		"CLOUDFLARE_API_TOKEN": "JUVNGlGbN5kL-UHvHRnZbZzkpwvvKrm8Yh3-Pr2e",
+		"CLOUDFLARE_EMAIL":     "user@example.com",
+	})
+
+	client, diags := testProviderConfigure(t, map[string]interface{}{})
+	if diags.HasError() {
+		t.Fatalf("expected an API key and a token in the environment to be accepted, got %v", diags)
+	}
+	if client.APIToken != "JUVNGlGbN5kL-UHvHRnZbZzkpwvvKrm8Yh3-Pr2e" || client.APIKey != "" {
+		t.Errorf("expected the API token of the environment to be used")
+	}
+}
+
+func TestProviderCredentialsConfigurationOverEnvironment(t *testing.T) {
+	testProviderCredentialsEnv(t, map[string]string{
+		"CLOUDFLARE_API_TOKEN": "JUVNGlGbN5kL-UHvHRnZbZzkpwvvKrm8Yh3-Pr2e",
+	})
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(tokenFile, []byte("0123456789abcdefghijABCDEFGHIJ0123456789"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	client, diags := testProviderConfigure(t, map[string]interface{}{"api_token_file": tokenFile})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if client.APIToken != "0123456789abcdefghijABCDEFGHIJ0123456789" {
+		t.Errorf("expected the API token file of the configuration to be used, got %q", client.APIToken)
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
@@ -51,6 +128,8 @@ func TestProvider_impl(t *testing.T) {
 type preCheckFunc = func(*testing.T)
 
 func testAccPreCheck(t *testing.T) {
+	testAccUseCassette(t)
+
 	testAccPreCheckEmail(t)
 	testAccPreCheckApiKey(t)
 	testAccPreCheckDomain(t)
@@ -61,6 +140,8 @@ func testAccPreCheck(t *testing.T) {
 }
 
 func testAccessAccPreCheck(t *testing.T) {
+	testAccUseCassette(t)
+
 	testAccPreCheckEmail(t)
 	testAccPreCheckApiKey(t)
 	testAccPreCheckDomain(t)
@@ -73,48 +154,64 @@ func testAccessAccPreCheck(t *testing.T) {
 }
 
 func testAccPreCheckAltDomain(t *testing.T) {
+	testAccUseCassette(t)
+
 	if v := os.Getenv("CLOUDFLARE_ALT_DOMAIN"); v == "" {
 		t.Fatal("CLOUDFLARE_ALT_DOMAIN must be set for this acceptance test")
 	}
 }
 
 func testAccPreCheckAltZoneID(t *testing.T) {
+	testAccUseCassette(t)
+
 	if v := os.Getenv("CLOUDFLARE_ALT_ZONE_ID"); v == "" {
 		t.Fatal("CLOUDFLARE_ALT_ZONE_ID must be set for this acceptance test")
 	}
 }
 
 func testAccPreCheckAccount(t *testing.T) {
+	testAccUseCassette(t)
+
 	if v := os.Getenv("CLOUDFLARE_ACCOUNT_ID"); v == "" {
 		t.Fatal("CLOUDFLARE_ACCOUNT_ID must be set for this acceptance test")
 	}
 }
 
 func testAccPreCheckEmail(t *testing.T) {
+	testAccUseCassette(t)
+
 	if v := os.Getenv("CLOUDFLARE_EMAIL"); v == "" {
 		t.Fatal("CLOUDFLARE_EMAIL must be set for acceptance tests")
 	}
 }
 
 func testAccPreCheckApiKey(t *testing.T) {
+	testAccUseCassette(t)
+
 	if v := os.Getenv("CLOUDFLARE_API_KEY"); v == "" {
 		t.Fatal("CLOUDFLARE_API_KEY must be set for acceptance tests")
 	}
 }
 
 func testAccPreCheckApiUserServiceKey(t *testing.T) {
+	testAccUseCassette(t)
+
 	if v := os.Getenv("CLOUDFLARE_API_USER_SERVICE_KEY"); v == "" {
 		t.Fatal("CLOUDFLARE_API_USER_SERVICE_KEY must be set for acceptance tests")
 	}
 }
 
 func testAccPreCheckDomain(t *testing.T) {
+	testAccUseCassette(t)
+
 	if v := os.Getenv("CLOUDFLARE_DOMAIN"); v == "" {
 		t.Fatal("CLOUDFLARE_DOMAIN must be set for acceptance tests. The domain is used to create and destroy record against.")
 	}
 }
 
 func testAccPreCheckLogpushToken(t *testing.T) {
+	testAccUseCassette(t)
+
 	if v := os.Getenv("CLOUDFLARE_LOGPUSH_OWNERSHIP_TOKEN"); v == "" {
 		t.Fatal("CLOUDFLARE_LOGPUSH_OWNERSHIP_TOKEN must be set for this acceptance test")
 	}
@@ -124,12 +221,26 @@ func testAccPreCheckLogpushToken(t *testing.T) {
 }
 
 func testAccPreCheckBYOIPPrefix(t *testing.T) {
+	testAccUseCassette(t)
+
 	if v := os.Getenv("CLOUDFLARE_BYO_IP_PREFIX_ID"); v == "" {
 		t.Skip("Skipping acceptance test as CLOUDFLARE_BYO_IP_PREFIX_ID is not set")
 	}
 }
 
+func testAccPreCheckSecondaryZone(t *testing.T) {
+	testAccUseCassette(t)
+
+	if v := os.Getenv("CLOUDFLARE_SECONDARY_ZONE_ID"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_SECONDARY_ZONE_ID is not set")
+	}
+}
+
 func generateRandomResourceName() string {
+	if testAccRecorder != nil {
+		return testAccRecorderResourceName()
+	}
+
 	return acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 }
 
@@ -0,0 +1,204 @@
+package cloudflare
+
+import (
+	"context"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// adaptiveRateLimitMinRPS is the lowest rate the adaptive rate limiter
+	// backs off to.
+	adaptiveRateLimitMinRPS = 0.25
+
+	// adaptiveRateLimitRampUpInterval is how long the rate has to be stable
+	// before it's raised again.
+	adaptiveRateLimitRampUpInterval = 10 * time.Second
+
+	// adaptiveRateLimitRampUpStep is the share of the maximum rate added each
+	// time the rate is raised.
+	adaptiveRateLimitRampUpStep = 0.1
+)
+
+// adaptiveRateLimiter is a transport limiting the rate of the API requests
+// based on the responses of the API. Being rate limited halves the rate and
+// pauses all requests for the period in the Retry-After header, rate limit
+// headers set the rate to what is left of the budget and otherwise the rate
+// ramps back up to the maximum. The transport is shared by every request of
+// the provider so the rate applies across all goroutines.
+type adaptiveRateLimiter struct {
+	transport      http.RoundTripper
+	maxRPS         float64
+	defaultBackoff time.Duration
+	limiter        *rate.Limiter
+	now            func() time.Time
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+	lastChange  time.Time
+}
+
+func newAdaptiveRateLimiter(transport http.RoundTripper, maxRPS float64, defaultBackoff time.Duration) *adaptiveRateLimiter {
+	return &adaptiveRateLimiter{
+		transport:      transport,
+		maxRPS:         maxRPS,
+		defaultBackoff: defaultBackoff,
+		limiter:        rate.NewLimiter(rate.Limit(maxRPS), 1),
+		now:            time.Now,
+	}
+}
+
+func (l *adaptiveRateLimiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := l.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := l.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	l.observe(resp)
+
+	return resp, nil
+}
+
+func (l *adaptiveRateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	pause := l.pausedUntil.Sub(l.now())
+	l.mu.Unlock()
+
+	if pause > 0 {
+		log.Printf("[DEBUG] Cloudflare adaptive rate limit pausing request for %s", pause)
+
+		timer := time.NewTimer(pause)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return l.limiter.Wait(ctx)
+}
+
+// observe adjusts the rate based on the response.
+func (l *adaptiveRateLimiter) observe(resp *http.Response) {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	current := float64(l.limiter.Limit())
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		backoff, ok := retryAfter(resp.Header, now)
+		if !ok {
+			backoff = l.defaultBackoff
+		}
+		l.pause(now.Add(backoff))
+		l.setRate(now, current/2, "rate limited by the API")
+		return
+	}
+
+	if remaining, reset, ok := rateLimitHeaders(resp.Header, now); ok {
+		if remaining <= 0 {
+			l.pause(now.Add(reset))
+			l.setRate(now, adaptiveRateLimitMinRPS, "rate limit budget exhausted")
+			return
+		}
+		l.setRate(now, remaining/math.Max(reset.Seconds(), 1), "rate limit headers")
+		return
+	}
+
+	if current < l.maxRPS && now.Sub(l.lastChange) >= adaptiveRateLimitRampUpInterval {
+		l.setRate(now, current+l.maxRPS*adaptiveRateLimitRampUpStep, "ramping up")
+	}
+}
+
+func (l *adaptiveRateLimiter) pause(until time.Time) {
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// setRate sets the rate within the bounds of the limiter. It must be called
+// with the lock held.
+func (l *adaptiveRateLimiter) setRate(now time.Time, rps float64, reason string) {
+	rps = math.Max(adaptiveRateLimitMinRPS, math.Min(l.maxRPS, rps))
+	if math.Abs(rps-float64(l.limiter.Limit())) < 0.01 {
+		return
+	}
+
+	l.limiter.SetLimitAt(now, rate.Limit(rps))
+	l.lastChange = now
+
+	log.Printf("[DEBUG] Cloudflare adaptive rate limit is now %.2f requests per second (%s)", rps, reason)
+}
+
+// retryAfter parses the Retry-After header which is either a number of
+// seconds or a date.
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := strings.TrimSpace(header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// rateLimitHeaders returns the number of requests left and the time until the
+// budget resets from the `RateLimit-Remaining` and `RateLimit-Reset` headers
+// or their `X-RateLimit-` counterparts. The reset is either a number of
+// seconds or a Unix timestamp.
+func rateLimitHeaders(header http.Header, now time.Time) (float64, time.Duration, bool) {
+	var remainingValue, resetValue string
+	for _, prefix := range []string{"RateLimit-", "X-RateLimit-"} {
+		if header.Get(prefix+"Remaining") != "" && header.Get(prefix+"Reset") != "" {
+			remainingValue = header.Get(prefix + "Remaining")
+			resetValue = header.Get(prefix + "Reset")
+			break
+		}
+	}
+	if remainingValue == "" {
+		return 0, 0, false
+	}
+
+	remaining, err := strconv.ParseFloat(strings.TrimSpace(remainingValue), 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	reset, err := strconv.ParseInt(strings.TrimSpace(resetValue), 10, 64)
+	if err != nil || reset < 0 {
+		return 0, 0, false
+	}
+
+	// Values this large can't be a number of seconds within a rate limit
+	// window so they're timestamps.
+	if reset > 1000000000 {
+		return remaining, time.Unix(reset, 0).Sub(now), true
+	}
+
+	return remaining, time.Duration(reset) * time.Second, true
+}
@@ -0,0 +1,122 @@
+package cloudflare
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type staticResponseTransport struct {
+	resp *http.Response
+}
+
+func (t *staticResponseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.resp, nil
+}
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	transport := &staticResponseTransport{}
+	l := newAdaptiveRateLimiter(transport, 8, time.Second)
+	l.now = func() time.Time { return now }
+
+	respond := func(status int, header map[string]string) {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		for k, v := range header {
+			resp.Header.Set(k, v)
+		}
+		l.observe(resp)
+	}
+
+	respond(http.StatusTooManyRequests, map[string]string{"Retry-After": "30"})
+	if l.limiter.Limit() != rate.Limit(4) {
+		t.Errorf("expected the rate to be halved to 4 but got %v", l.limiter.Limit())
+	}
+	if !l.pausedUntil.Equal(now.Add(30 * time.Second)) {
+		t.Errorf("expected the requests to be paused until %s but got %s", now.Add(30*time.Second), l.pausedUntil)
+	}
+
+	respond(http.StatusTooManyRequests, nil)
+	if l.limiter.Limit() != rate.Limit(2) {
+		t.Errorf("expected the rate to be halved to 2 but got %v", l.limiter.Limit())
+	}
+	if !l.pausedUntil.Equal(now.Add(30 * time.Second)) {
+		t.Errorf("expected the longer pause to be kept but got %s", l.pausedUntil)
+	}
+
+	respond(http.StatusOK, nil)
+	if l.limiter.Limit() != rate.Limit(2) {
+		t.Errorf("expected the rate to stay at 2 within the ramp up interval but got %v", l.limiter.Limit())
+	}
+
+	now = now.Add(adaptiveRateLimitRampUpInterval)
+	respond(http.StatusOK, nil)
+	if float64(l.limiter.Limit()) != 2.8 {
+		t.Errorf("expected the rate to ramp up to 2.8 but got %v", l.limiter.Limit())
+	}
+
+	respond(http.StatusOK, map[string]string{"RateLimit-Remaining": "300", "RateLimit-Reset": "100"})
+	if l.limiter.Limit() != rate.Limit(3) {
+		t.Errorf("expected the rate to follow the rate limit headers to 3 but got %v", l.limiter.Limit())
+	}
+
+	respond(http.StatusOK, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "60"})
+	if l.limiter.Limit() != rate.Limit(adaptiveRateLimitMinRPS) {
+		t.Errorf("expected the rate to drop to the minimum but got %v", l.limiter.Limit())
+	}
+	if !l.pausedUntil.Equal(now.Add(60 * time.Second)) {
+		t.Errorf("expected the requests to be paused until the reset but got %s", l.pausedUntil)
+	}
+
+	for i := 0; i < 20; i++ {
+		now = now.Add(adaptiveRateLimitRampUpInterval)
+		respond(http.StatusOK, nil)
+	}
+	if l.limiter.Limit() != rate.Limit(8) {
+		t.Errorf("expected the rate to ramp up to the maximum of 8 but got %v", l.limiter.Limit())
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		"missing": {"", 0, false},
+		"seconds": {"120", 2 * time.Minute, true},
+		"date":    {"Fri, 01 Oct 2021 12:00:45 GMT", 45 * time.Second, true},
+		"past":    {"Fri, 01 Oct 2021 11:00:00 GMT", 0, true},
+		"invalid": {"soon", 0, false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			if tc.value != "" {
+				header.Set("Retry-After", tc.value)
+			}
+
+			got, ok := retryAfter(header, now)
+			if got != tc.expected || ok != tc.ok {
+				t.Errorf("expected %s, %t but got %s, %t", tc.expected, tc.ok, got, ok)
+			}
+		})
+	}
+}
+
+func TestRateLimitHeadersTimestamp(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	header := http.Header{}
+	header.Set("X-RateLimit-Remaining", "10")
+	header.Set("X-RateLimit-Reset", "1633089620")
+
+	remaining, reset, ok := rateLimitHeaders(header, now)
+	if !ok || remaining != 10 || reset != 20*time.Second {
+		t.Errorf("expected 10 requests left for 20s but got %v for %s", remaining, reset)
+	}
+}
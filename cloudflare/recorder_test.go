@@ -0,0 +1,716 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+const (
+	// recorderModeRecord performs the requests against the live API and
+	// writes the (scrubbed) interactions to a cassette per test.
+	recorderModeRecord = "record"
+	// recorderModeReplay serves the responses from the cassettes without
+	// touching the network.
+	recorderModeReplay = "replay"
+
+	// recorderRedacted replaces any sensitive values found in the request and
+	// response bodies.
+	recorderRedacted = "REDACTED"
+)
+
+var (
+	// testAccRecorderCassetteDir is where the cassettes are read from and
+	// written to.
+	testAccRecorderCassetteDir = filepath.Join("testdata", "cassettes")
+
+	// testAccRecorder is the recorder shared by the acceptance tests. It is
+	// nil unless CLOUDFLARE_RECORDER_MODE is set.
+	testAccRecorder *recorder
+
+	// testAccRecorderPlaceholders are the values the environment variables
+	// are scrubbed to when recording and set to when replaying. Secrets are
+	// replaced with dummy values whilst the identifiers point at the
+	// integration test account so the cassettes are consistent regardless of
+	// who recorded them.
+	testAccRecorderPlaceholders = map[string]string{
+		"CLOUDFLARE_EMAIL":                   "terraform-acceptance-test@cfapi.net",
+		"CLOUDFLARE_API_KEY":                 strings.Repeat("0", 37),
+		"CLOUDFLARE_API_USER_SERVICE_KEY":    "v1.0-" + strings.Repeat("0", 24),
+		"CLOUDFLARE_LOGPUSH_OWNERSHIP_TOKEN": strings.Repeat("0", 32),
+		"CLOUDFLARE_BYO_IP_PREFIX_ID":        strings.Repeat("0", 32),
+		"CLOUDFLARE_ACCOUNT_ID":              testAccCloudflareAccountID,
+		"CLOUDFLARE_ZONE_ID":                 testAccCloudflareZoneID,
+		"CLOUDFLARE_DOMAIN":                  testAccCloudflareZoneName,
+		"CLOUDFLARE_ALT_ZONE_ID":             testAccCloudflareAltZoneID,
+		"CLOUDFLARE_ALT_DOMAIN":              testAccCloudflareAltZoneName,
+	}
+
+	// testAccRecorderScrubbedEnvironment are environment variables which are
+	// scrubbed from the cassettes but not set when replaying.
+	testAccRecorderScrubbedEnvironment = map[string]string{
+		"CLOUDFLARE_API_TOKEN": strings.Repeat("0", 40),
+	}
+
+	// testAccRecorderSensitiveFields are JSON keys whose values are removed
+	// from the recorded requests and responses.
+	testAccRecorderSensitiveFields = []string{"client_secret", "secret", "tunnel_secret"}
+
+	// testAccRecorderSensitivePaths are the endpoints whose "value" in the
+	// response is a credential.
+	testAccRecorderSensitivePaths = regexp.MustCompile(`/user/tokens(/[0-9a-f]{32}(/value)?)?$`)
+
+	// testAccRecorderResponseHeaders are the only response headers kept in
+	// the cassettes.
+	testAccRecorderResponseHeaders = []string{"Content-Type"}
+)
+
+// cassette holds the HTTP interactions of a single test.
+type cassette struct {
+	Interactions []cassetteInteraction `json:"interactions"`
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       string              `json:"body"`
+}
+
+// recorder records or replays the API interactions of the test currently
+// running. As the provider isn't aware of which test it is serving, only a
+// single test can run at a time.
+type recorder struct {
+	mode string
+	dir  string
+
+	// scrubs maps the real values found in the interactions to their
+	// placeholders.
+	scrubs map[string]string
+
+	mu       sync.Mutex
+	name     string
+	cassette *cassette
+	used     []bool
+}
+
+func newRecorder(mode, dir string, scrubs map[string]string) (*recorder, error) {
+	if mode != recorderModeRecord && mode != recorderModeReplay {
+		return nil, fmt.Errorf("invalid recorder mode %q, should be either %q or %q", mode, recorderModeRecord, recorderModeReplay)
+	}
+
+	return &recorder{mode: mode, dir: dir, scrubs: scrubs}, nil
+}
+
+// testAccConfigureRecorder sets up the shared recorder from the
+// CLOUDFLARE_RECORDER_MODE environment variable. When replaying, the
+// environment is pointed at the placeholders used in the cassettes.
+func testAccConfigureRecorder() {
+	mode := os.Getenv("CLOUDFLARE_RECORDER_MODE")
+	if mode == "" {
+		return
+	}
+
+	scrubs := map[string]string{}
+	for _, env := range []map[string]string{testAccRecorderPlaceholders, testAccRecorderScrubbedEnvironment} {
+		for name, placeholder := range env {
+			if v := os.Getenv(name); v != "" && v != placeholder {
+				scrubs[v] = placeholder
+			}
+		}
+	}
+
+	r, err := newRecorder(mode, testAccRecorderCassetteDir, scrubs)
+	if err != nil {
+		log.Fatalf("[ERROR] %s", err)
+	}
+
+	if mode == recorderModeReplay {
+		for name, placeholder := range testAccRecorderPlaceholders {
+			os.Setenv(name, placeholder)
+		}
+		os.Unsetenv("CLOUDFLARE_API_TOKEN")
+
+		// Nothing is sent over the network so there is no need to hold back.
+		if os.Getenv("CLOUDFLARE_RPS") == "" {
+			os.Setenv("CLOUDFLARE_RPS", "1000")
+		}
+
+		// Package level values read from the environment before we got here.
+		zoneID = os.Getenv("CLOUDFLARE_ZONE_ID")
+		domain = os.Getenv("CLOUDFLARE_DOMAIN")
+	}
+
+	// The interactions are tied to whichever test is running so they can't
+	// be interleaved.
+	flag.Parse()
+	if err := flag.Set("test.parallel", "1"); err != nil {
+		log.Fatalf("[ERROR] failed to limit test parallelism: %s", err)
+	}
+
+	testAccRecorder = r
+	apiTransportWrapper = r.wrap
+}
+
+// testAccUseCassette switches the shared recorder over to the cassette of the
+// test. It is called from the pre-checks so it's safe to call more than once
+// for the same test. Without a cassette to replay the test fails.
+func testAccUseCassette(t *testing.T) {
+	if testAccRecorder == nil {
+		return
+	}
+
+	loaded, err := testAccRecorder.load(t.Name())
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			t.Fatalf("no cassette to replay, record it using `make testacc-record TESTARGS='-run=%s'`: %s", t.Name(), err)
+		}
+		t.Fatalf("failed to load cassette: %s", err)
+	}
+
+	if !loaded {
+		return
+	}
+
+	t.Cleanup(func() {
+		defer testAccRecorder.unload(t.Name())
+
+		if t.Failed() || t.Skipped() {
+			return
+		}
+		if err := testAccRecorder.save(t.Name()); err != nil {
+			t.Errorf("failed to save cassette: %s", err)
+		}
+	})
+}
+
+// testAccRecordProvider makes the provider refuse to be configured unless a
+// cassette is loaded. Without it, tests which don't use any of the pre-checks
+// would reach the live API unrecorded or fail obscurely whilst replaying.
+func testAccRecordProvider(p *schema.Provider) {
+	configure := p.ConfigureContextFunc
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		if testAccRecorder != nil && !testAccRecorder.loaded() {
+			return nil, diag.Errorf("no cassette is loaded, call testAccUseCassette from the PreCheck of the test")
+		}
+		return configure(ctx, d)
+	}
+}
+
+func (r *recorder) path(name string) string {
+	return filepath.Join(r.dir, strings.ReplaceAll(name, "/", "_")+".json")
+}
+
+// load makes the cassette for the named test the current one and reports
+// whether it had to be switched.
+func (r *recorder) load(name string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.name == name && r.cassette != nil {
+		return false, nil
+	}
+
+	c := &cassette{}
+	if r.mode == recorderModeReplay {
+		data, err := ioutil.ReadFile(r.path(name))
+		if err != nil {
+			return false, errors.Wrap(err, fmt.Sprintf("error reading cassette for %s", name))
+		}
+		if err := json.Unmarshal(data, c); err != nil {
+			return false, errors.Wrap(err, fmt.Sprintf("error decoding cassette for %s", name))
+		}
+	}
+
+	r.name = name
+	r.cassette = c
+	r.used = make([]bool, len(c.Interactions))
+
+	return true, nil
+}
+
+// unload drops the cassette of the named test once it has finished.
+func (r *recorder) unload(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.name != name {
+		return
+	}
+
+	r.name = ""
+	r.cassette = nil
+	r.used = nil
+}
+
+// loaded reports whether a cassette is in use.
+func (r *recorder) loaded() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette != nil
+}
+
+// save writes the recorded interactions of the named test to disk. Nothing is
+// written when replaying.
+func (r *recorder) save(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode != recorderModeRecord || r.name != name || r.cassette == nil {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("error encoding cassette for %s", name))
+	}
+
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return errors.Wrap(err, "error creating cassette directory")
+	}
+
+	return ioutil.WriteFile(r.path(name), append(data, '\n'), 0644)
+}
+
+func (r *recorder) wrap(transport http.RoundTripper) http.RoundTripper {
+	return &recorderTransport{recorder: r, transport: transport}
+}
+
+type recorderTransport struct {
+	recorder  *recorder
+	transport http.RoundTripper
+}
+
+func (rt *recorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	r := rt.recorder
+	if r.mode == recorderModeReplay {
+		return r.replay(req, body)
+	}
+
+	resp, err := rt.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	r.record(req, body, resp, respBody)
+
+	return resp, nil
+}
+
+func (r *recorder) record(req *http.Request, body []byte, resp *http.Response, respBody []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette == nil {
+		log.Printf("[WARN] Not recording %s %s as no test is running", req.Method, req.URL)
+		return
+	}
+
+	headers := map[string][]string{}
+	for _, name := range testAccRecorderResponseHeaders {
+		if v := resp.Header.Values(name); len(v) > 0 {
+			headers[name] = v
+		}
+	}
+
+	r.cassette.Interactions = append(r.cassette.Interactions, cassetteInteraction{
+		Request: cassetteRequest{
+			Method: req.Method,
+			URL:    r.scrub(req.URL.String()),
+			Body:   r.scrub(scrubSensitiveFields(req.URL.Path, body)),
+		},
+		Response: cassetteResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       r.scrub(scrubSensitiveFields(req.URL.Path, respBody)),
+		},
+	})
+	r.used = append(r.used, true)
+}
+
+// replay finds the first unused interaction for the request. Requests with the
+// same body are preferred but as some request bodies contain generated values
+// (timestamps, keys, multipart boundaries) the method and URL are enough to
+// fall back on.
+func (r *recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette == nil {
+		return nil, fmt.Errorf("no cassette loaded to replay %s %s", req.Method, req.URL)
+	}
+
+	url := req.URL.String()
+	match := -1
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || interaction.Request.Method != req.Method || interaction.Request.URL != url {
+			continue
+		}
+		if recorderBodiesEqual(interaction.Request.Body, scrubSensitiveFields(req.URL.Path, body)) {
+			match = i
+			break
+		}
+		if match == -1 {
+			match = i
+		}
+	}
+
+	if match == -1 {
+		return nil, fmt.Errorf("no recorded interaction left for %s %s in the cassette for %s", req.Method, url, r.name)
+	}
+
+	r.used[match] = true
+	recorded := r.cassette.Interactions[match].Response
+
+	header := http.Header{}
+	for name, values := range recorded.Headers {
+		for _, v := range values {
+			header.Add(name, v)
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// scrub replaces the real values with their placeholders, longest first so
+// that values containing one another are replaced whole.
+func (r *recorder) scrub(s string) string {
+	values := make([]string, 0, len(r.scrubs))
+	for v := range r.scrubs {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+
+	for _, v := range values {
+		s = strings.ReplaceAll(s, v, r.scrubs[v])
+	}
+
+	return s
+}
+
+// scrubSensitiveFields redacts the credentials sent or returned in JSON
+// bodies. Anything that isn't JSON is returned as is.
+func scrubSensitiveFields(path string, body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+
+	fields := testAccRecorderSensitiveFields
+	if testAccRecorderSensitivePaths.MatchString(path) {
+		fields = append([]string{"value"}, fields...)
+	}
+
+	redactRecorderFields(v, fields)
+
+	scrubbed, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+
+	return string(scrubbed)
+}
+
+func redactRecorderFields(v interface{}, fields []string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := value.(string); ok && contains(fields, key) {
+				v[key] = recorderRedacted
+				continue
+			}
+			redactRecorderFields(value, fields)
+		}
+	case []interface{}:
+		for _, value := range v {
+			redactRecorderFields(value, fields)
+		}
+	}
+}
+
+// recorderBodiesEqual compares JSON bodies semantically and anything else
+// byte for byte.
+func recorderBodiesEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var av, bv interface{}
+	if json.Unmarshal([]byte(a), &av) != nil || json.Unmarshal([]byte(b), &bv) != nil {
+		return false
+	}
+
+	an, _ := json.Marshal(av)
+	bn, _ := json.Marshal(bv)
+
+	return bytes.Equal(an, bn)
+}
+
+var (
+	testAccResourceNamesMu sync.Mutex
+	testAccResourceNames   = map[string]int{}
+)
+
+// testAccRecorderResourceName generates a resource name which is stable
+// between runs of the calling test so that the recorded requests match up.
+func testAccRecorderResourceName() string {
+	test := recorderCallingTest()
+
+	testAccResourceNamesMu.Lock()
+	n := testAccResourceNames[test]
+	testAccResourceNames[test]++
+	testAccResourceNamesMu.Unlock()
+
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", test, n)))
+	name := make([]byte, 10)
+	for i := range name {
+		name[i] = acctest.CharSetAlpha[int(sum[i])%len(acctest.CharSetAlpha)]
+	}
+
+	return string(name)
+}
+
+// recorderCallingTest walks up the stack to find the name of the test
+// function, if any, the caller is running in.
+func recorderCallingTest() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+		name := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		if parts := strings.Split(name, "."); len(parts) > 1 && strings.HasPrefix(parts[1], "Test") {
+			return parts[1]
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	const (
+		secret = "0123456789abcdef0123456789abcdef01234"
+		zone   = "e6b0f7ff2a2d4f27b7f3bb9f1e0e1f5a"
+	)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		fmt.Fprintf(w, `{"result":{"id":"%s","secret":"s3cr3t","name":"%s"},"request":%d}`, zone, r.Header.Get("X-Auth-Key"), requests)
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	scrubs := map[string]string{secret: "REDACTED_KEY", zone: testAccCloudflareZoneID}
+	do := func(client *http.Client, url, body string) (int, string, error) {
+		req, _ := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+		req.Header.Set("X-Auth-Key", secret)
+		resp, err := client.Do(req)
+		if err != nil {
+			return 0, "", err
+		}
+		defer resp.Body.Close()
+		data, err := ioutil.ReadAll(resp.Body)
+		return resp.StatusCode, string(data), err
+	}
+
+	r, _ := newRecorder(recorderModeRecord, dir, scrubs)
+	if _, err := r.load("TestExample/sub"); err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: r.wrap(http.DefaultTransport)}
+	for _, body := range []string{`{"a": 1, "b": 2}`, `{"a": 2, "tunnel_secret": "t0nn3l"}`} {
+		_, got, err := do(client, server.URL+"/zones/"+zone, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(got, secret) {
+			t.Errorf("expected the live response to be passed through untouched, got %s", got)
+		}
+	}
+	if err := r.save("TestExample/sub"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "TestExample_sub.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, leaked := range []string{secret, zone, "s3cr3t", "t0nn3l", "session=abc"} {
+		if strings.Contains(string(data), leaked) {
+			t.Errorf("expected %q to be scrubbed from the cassette:\n%s", leaked, data)
+		}
+	}
+
+	r, _ = newRecorder(recorderModeReplay, dir, nil)
+	if _, err := r.load("TestExample/sub"); err != nil {
+		t.Fatal(err)
+	}
+	client = &http.Client{Transport: r.wrap(http.DefaultTransport)}
+	url := server.URL + "/zones/" + testAccCloudflareZoneID
+
+	_, got, err := do(client, url, `{"a":2,"tunnel_secret":"an0th3r"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(got, `"request":2`) || !strings.Contains(got, testAccCloudflareZoneID) {
+		t.Errorf("expected the interaction with the matching body to be replayed, got %s", got)
+	}
+
+	status, got, err := do(client, url, `{"c":3}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != http.StatusOK || !strings.Contains(got, `"request":1`) {
+		t.Errorf("expected the remaining interaction to be replayed, got %d %s", status, got)
+	}
+
+	if _, _, err := do(client, url, `{}`); err == nil {
+		t.Error("expected an error once the cassette is used up")
+	}
+
+	if requests != 2 {
+		t.Errorf("expected the replay to stay offline but the server saw %d requests", requests)
+	}
+
+	if _, err := r.load("TestMissing"); !os.IsNotExist(errors.Cause(err)) {
+		t.Errorf("expected a missing cassette to be reported as such, got %v", err)
+	}
+}
+
+func TestRecordProviderRequiresCassette(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	previous := testAccRecorder
+	defer func() { testAccRecorder = previous }()
+	testAccRecorder, _ = newRecorder(recorderModeRecord, dir, nil)
+
+	configured := false
+	p := &schema.Provider{
+		ConfigureContextFunc: func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			configured = true
+			return nil, nil
+		},
+	}
+	testAccRecordProvider(p)
+
+	if _, diags := p.ConfigureContextFunc(context.Background(), nil); !diags.HasError() || configured {
+		t.Error("expected the provider not to be configured without a cassette")
+	}
+
+	if _, err := testAccRecorder.load("TestExample"); err != nil {
+		t.Fatal(err)
+	}
+	if _, diags := p.ConfigureContextFunc(context.Background(), nil); diags.HasError() || !configured {
+		t.Errorf("expected the provider to be configured once the cassette is loaded, got %v", diags)
+	}
+
+	testAccRecorder.unload("TestExample")
+	if testAccRecorder.loaded() {
+		t.Error("expected the cassette to be unloaded")
+	}
+}
+
+func TestScrubSensitiveFields(t *testing.T) {
+	body := []byte(`{"result":{"id":"1","value":"token","client_secret":"abc","nested":[{"secret":"def"}]}}`)
+
+	got := scrubSensitiveFields("/client/v4/user/tokens", body)
+	if strings.Contains(got, "token") || strings.Contains(got, "abc") || strings.Contains(got, "def") {
+		t.Errorf("expected the credentials to be redacted, got %s", got)
+	}
+
+	got = scrubSensitiveFields("/client/v4/zones/1/dns_records", body)
+	if !strings.Contains(got, `"value":"token"`) {
+		t.Errorf("expected values outside of the token endpoints to be kept, got %s", got)
+	}
+
+	if got := scrubSensitiveFields("/", []byte("not json")); got != "not json" {
+		t.Errorf("expected non JSON bodies to be kept, got %s", got)
+	}
+}
+
+func TestRecorderResourceName(t *testing.T) {
+	first := testAccRecorderResourceName()
+	second := testAccRecorderResourceName()
+
+	if len(first) != 10 || first == second {
+		t.Errorf("expected distinct names of 10 characters, got %q and %q", first, second)
+	}
+
+	if recorderCallingTest() != "TestRecorderResourceName" {
+		t.Errorf("expected the calling test to be found, got %q", recorderCallingTest())
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -15,13 +17,14 @@ import (
 
 func resourceCloudflareAccessApplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareAccessApplicationCreate,
-		Read:   resourceCloudflareAccessApplicationRead,
-		Update: resourceCloudflareAccessApplicationUpdate,
-		Delete: resourceCloudflareAccessApplicationDelete,
+		CreateContext: resourceCloudflareAccessApplicationCreate,
+		ReadContext:   resourceCloudflareAccessApplicationRead,
+		UpdateContext: resourceCloudflareAccessApplicationUpdate,
+		DeleteContext: resourceCloudflareAccessApplicationDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareAccessApplicationImport,
+			StateContext: resourceCloudflareAccessApplicationImport,
 		},
+		CustomizeDiff: resourceCloudflareAccessApplicationDiff,
 
 		Schema: map[string]*schema.Schema{
 			"account_id": {
@@ -46,13 +49,123 @@ func resourceCloudflareAccessApplication() *schema.Resource {
 			},
 			"domain": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"type": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "self_hosted",
-				ValidateFunc: validation.StringInSlice([]string{"self_hosted", "ssh", "vnc", "file"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"self_hosted", "ssh", "vnc", "file", "bookmark", "saas", "app_launcher", "warp", "biso"}, false),
+			},
+			"saas_app": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "saml",
+							ValidateFunc: validation.StringInSlice([]string{"saml", "oidc"}, false),
+						},
+						"sp_entity_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"consumer_service_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"name_id_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"id", "email"}, false),
+						},
+						"custom_attribute": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"name_format": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified",
+											"urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
+											"urn:oasis:names:tc:SAML:2.0:attrname-format:uri",
+										}, false),
+									},
+									"source": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"idp_entity_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sso_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"redirect_uris": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"grant_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"authorization_code", "authorization_code_with_pkce"}, false),
+							},
+						},
+						"scopes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"openid", "groups", "email", "profile"}, false),
+							},
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_secret": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
 			},
 			"session_duration": {
 				Type:     schema.TypeString,
@@ -164,13 +277,13 @@ func resourceCloudflareAccessApplication() *schema.Resource {
 	}
 }
 
-func resourceCloudflareAccessApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	allowedIDPList := expandInterfaceToStringList(d.Get("allowed_idps"))
 	appType := d.Get("type").(string)
 
-	newAccessApplication := cloudflare.AccessApplication{
+	newAccessApplication := accessApplication{AccessApplication: cloudflare.AccessApplication{
 		Name:                    d.Get("name").(string),
 		Domain:                  d.Get("domain").(string),
 		Type:                    cloudflare.AccessApplicationType(appType),
@@ -183,16 +296,20 @@ func resourceCloudflareAccessApplicationCreate(d *schema.ResourceData, meta inte
 		SameSiteCookieAttribute: d.Get("same_site_cookie_attribute").(string),
 		LogoURL:                 d.Get("logo_url").(string),
 		SkipInterstitial:        d.Get("skip_interstitial").(bool),
-	}
+	}}
 
 	if len(allowedIDPList) > 0 {
 		newAccessApplication.AllowedIdps = allowedIDPList
 	}
 
+	if _, ok := d.GetOk("saas_app"); ok {
+		newAccessApplication.SaasApplication = convertSaasAppSchemaToStruct(d)
+	}
+
 	if _, ok := d.GetOk("cors_headers"); ok {
 		CORSConfig, err := convertCORSSchemaToStruct(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		newAccessApplication.CorsHeaders = CORSConfig
 	}
@@ -201,46 +318,41 @@ func resourceCloudflareAccessApplicationCreate(d *schema.ResourceData, meta inte
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	var accessApplication cloudflare.AccessApplication
-	if identifier.Type == AccountType {
-		accessApplication, err = client.CreateAccessApplication(context.Background(), identifier.Value, newAccessApplication)
-	} else {
-		accessApplication, err = client.CreateZoneLevelAccessApplication(context.Background(), identifier.Value, newAccessApplication)
-	}
+	accessApplication, err := createAccessApplication(ctx, client, identifier, newAccessApplication)
 	if err != nil {
-		return fmt.Errorf("error creating Access Application for %s %q: %s", identifier.Type, identifier.Value, err)
+		return diag.Errorf("error creating Access Application for %s %q: %s", identifier.Type, identifier.Value, err)
 	}
 
 	d.SetId(accessApplication.ID)
 
-	return resourceCloudflareAccessApplicationRead(d, meta)
+	// The client secret of OIDC SaaS applications is only returned when the
+	// application is created so it has to be kept from the response.
+	if accessApplication.SaasApplication != nil {
+		d.Set("saas_app", convertSaasAppStructToSchema(d, accessApplication.SaasApplication))
+	}
+
+	return resourceCloudflareAccessApplicationRead(ctx, d, meta)
 }
 
-func resourceCloudflareAccessApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
-	}
-
-	var accessApplication cloudflare.AccessApplication
-	if identifier.Type == AccountType {
-		accessApplication, err = client.AccessApplication(context.Background(), identifier.Value, d.Id())
-	} else {
-		accessApplication, err = client.ZoneLevelAccessApplication(context.Background(), identifier.Value, d.Id())
+		return diag.FromErr(err)
 	}
 
+	accessApplication, err := fetchAccessApplication(ctx, client, identifier, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
 			log.Printf("[INFO] Access Application %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding Access Application %q: %s", d.Id(), err)
+		return diag.Errorf("error finding Access Application %q: %s", d.Id(), err)
 	}
 
 	d.Set("name", accessApplication.Name)
@@ -260,19 +372,24 @@ func resourceCloudflareAccessApplicationRead(d *schema.ResourceData, meta interf
 
 	corsConfig := convertCORSStructToSchema(d, accessApplication.CorsHeaders)
 	if corsConfigErr := d.Set("cors_headers", corsConfig); corsConfigErr != nil {
-		return fmt.Errorf("error setting Access Application CORS header configuration: %s", corsConfigErr)
+		return diag.Errorf("error setting Access Application CORS header configuration: %s", corsConfigErr)
+	}
+
+	saasConfig := convertSaasAppStructToSchema(d, accessApplication.SaasApplication)
+	if saasConfigErr := d.Set("saas_app", saasConfig); saasConfigErr != nil {
+		return diag.Errorf("error setting Access Application SaaS configuration: %s", saasConfigErr)
 	}
 
 	return nil
 }
 
-func resourceCloudflareAccessApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	allowedIDPList := expandInterfaceToStringList(d.Get("allowed_idps"))
 	appType := d.Get("type").(string)
 
-	updatedAccessApplication := cloudflare.AccessApplication{
+	updatedAccessApplication := accessApplication{AccessApplication: cloudflare.AccessApplication{
 		ID:                      d.Id(),
 		Name:                    d.Get("name").(string),
 		Domain:                  d.Get("domain").(string),
@@ -286,16 +403,20 @@ func resourceCloudflareAccessApplicationUpdate(d *schema.ResourceData, meta inte
 		SameSiteCookieAttribute: d.Get("same_site_cookie_attribute").(string),
 		LogoURL:                 d.Get("logo_url").(string),
 		SkipInterstitial:        d.Get("skip_interstitial").(bool),
-	}
+	}}
 
 	if len(allowedIDPList) > 0 {
 		updatedAccessApplication.AllowedIdps = allowedIDPList
 	}
 
+	if _, ok := d.GetOk("saas_app"); ok {
+		updatedAccessApplication.SaasApplication = convertSaasAppSchemaToStruct(d)
+	}
+
 	if _, ok := d.GetOk("cors_headers"); ok {
 		CORSConfig, err := convertCORSSchemaToStruct(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		updatedAccessApplication.CorsHeaders = CORSConfig
 	}
@@ -304,52 +425,47 @@ func resourceCloudflareAccessApplicationUpdate(d *schema.ResourceData, meta inte
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	var accessApplication cloudflare.AccessApplication
-	if identifier.Type == AccountType {
-		accessApplication, err = client.UpdateAccessApplication(context.Background(), identifier.Value, updatedAccessApplication)
-	} else {
-		accessApplication, err = client.UpdateZoneLevelAccessApplication(context.Background(), identifier.Value, updatedAccessApplication)
-	}
+	accessApplication, err := updateAccessApplication(ctx, client, identifier, updatedAccessApplication)
 	if err != nil {
-		return fmt.Errorf("error updating Access Application for %s %q: %s", identifier.Type, identifier.Value, err)
+		return diag.Errorf("error updating Access Application for %s %q: %s", identifier.Type, identifier.Value, err)
 	}
 
 	if accessApplication.ID == "" {
-		return fmt.Errorf("failed to find Access Application ID in update response; resource was empty")
+		return diag.Errorf("failed to find Access Application ID in update response; resource was empty")
 	}
 
-	return resourceCloudflareAccessApplicationRead(d, meta)
+	return resourceCloudflareAccessApplicationRead(ctx, d, meta)
 }
 
-func resourceCloudflareAccessApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	appID := d.Id()
 
 	log.Printf("[DEBUG] Deleting Cloudflare Access Application using ID: %s", appID)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if identifier.Type == AccountType {
-		err = client.DeleteAccessApplication(context.Background(), identifier.Value, appID)
+		err = client.DeleteAccessApplication(ctx, identifier.Value, appID)
 	} else {
-		err = client.DeleteZoneLevelAccessApplication(context.Background(), identifier.Value, appID)
+		err = client.DeleteZoneLevelAccessApplication(ctx, identifier.Value, appID)
 	}
 	if err != nil {
-		return fmt.Errorf("error deleting Access Application for %s %q: %s", identifier.Type, identifier.Value, err)
+		return diag.Errorf("error deleting Access Application for %s %q: %s", identifier.Type, identifier.Value, err)
 	}
 
-	resourceCloudflareAccessApplicationRead(d, meta)
+	resourceCloudflareAccessApplicationRead(ctx, d, meta)
 
 	return nil
 }
 
-func resourceCloudflareAccessApplicationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareAccessApplicationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 2)
 
 	if len(attributes) != 2 {
@@ -363,7 +479,7 @@ func resourceCloudflareAccessApplicationImport(d *schema.ResourceData, meta inte
 	d.Set("account_id", accountID)
 	d.SetId(accessApplicationID)
 
-	resourceCloudflareAccessApplicationRead(d, meta)
+	resourceCloudflareAccessApplicationRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -441,3 +557,151 @@ func convertCORSStructToSchema(d *schema.ResourceData, headers *cloudflare.Acces
 
 	return []interface{}{m}
 }
+
+func resourceCloudflareAccessApplicationDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	return validateAccessApplicationConfig(d.Get("type").(string), config)
+}
+
+// validateAccessApplicationConfig checks the configuration has the attributes
+// the application type needs and none of the ones it doesn't support. The raw
+// configuration is used as the domain is computed for some types and values
+// which aren't known yet must not be mistaken for missing ones.
+func validateAccessApplicationConfig(appType string, config cty.Value) error {
+	domainSet := !config.GetAttr("domain").IsNull()
+
+	switch appType {
+	case "self_hosted", "ssh", "vnc", "file", "bookmark":
+		if !domainSet {
+			return fmt.Errorf("domain is required for applications of type %q", appType)
+		}
+	case "saas", "app_launcher", "warp", "biso":
+		if domainSet {
+			return fmt.Errorf("domain can't be set for applications of type %q", appType)
+		}
+	}
+
+	if appType != "self_hosted" && blockSet(config.GetAttr("cors_headers")) {
+		return fmt.Errorf("cors_headers can only be set for applications of type \"self_hosted\"")
+	}
+
+	saasApp := config.GetAttr("saas_app")
+	if appType != "saas" {
+		if blockSet(saasApp) {
+			return fmt.Errorf("saas_app can only be set for applications of type \"saas\"")
+		}
+		return nil
+	}
+
+	if !blockSet(saasApp) {
+		return fmt.Errorf("saas_app is required for applications of type \"saas\"")
+	}
+
+	if !saasApp.IsKnown() {
+		return nil
+	}
+
+	saasConfig := saasApp.Index(cty.NumberIntVal(0))
+	authType := saasConfig.GetAttr("auth_type")
+	if authType.IsKnown() && !authType.IsNull() && authType.AsString() == "oidc" {
+		if saasConfig.GetAttr("redirect_uris").IsNull() {
+			return fmt.Errorf("saas_app.0.redirect_uris is required for OIDC SaaS applications")
+		}
+		return nil
+	}
+
+	if saasConfig.GetAttr("sp_entity_id").IsNull() || saasConfig.GetAttr("consumer_service_url").IsNull() {
+		return fmt.Errorf("saas_app.0.sp_entity_id and saas_app.0.consumer_service_url are required for SAML SaaS applications")
+	}
+
+	return nil
+}
+
+// blockSet returns whether a block is in the configuration, blocks which
+// aren't known yet are assumed to be.
+func blockSet(v cty.Value) bool {
+	return !v.IsNull() && (!v.IsKnown() || v.LengthInt() > 0)
+}
+
+func convertSaasAppSchemaToStruct(d *schema.ResourceData) *accessApplicationSaasApp {
+	saasApp := accessApplicationSaasApp{
+		AuthType: d.Get("saas_app.0.auth_type").(string),
+	}
+
+	if saasApp.AuthType == "oidc" {
+		saasApp.RedirectURIs = expandInterfaceToStringList(d.Get("saas_app.0.redirect_uris"))
+		saasApp.GrantTypes = expandInterfaceToStringList(d.Get("saas_app.0.grant_types"))
+		saasApp.Scopes = expandInterfaceToStringList(d.Get("saas_app.0.scopes"))
+
+		return &saasApp
+	}
+
+	saasApp.SPEntityID = d.Get("saas_app.0.sp_entity_id").(string)
+	saasApp.ConsumerServiceURL = d.Get("saas_app.0.consumer_service_url").(string)
+	saasApp.NameIDFormat = d.Get("saas_app.0.name_id_format").(string)
+
+	for _, attribute := range d.Get("saas_app.0.custom_attribute").([]interface{}) {
+		attributeCfg := attribute.(map[string]interface{})
+		customAttribute := accessApplicationSaasCustomAttribute{
+			Name:       attributeCfg["name"].(string),
+			NameFormat: attributeCfg["name_format"].(string),
+		}
+
+		if source := attributeCfg["source"].([]interface{}); len(source) > 0 && source[0] != nil {
+			customAttribute.Source.Name = source[0].(map[string]interface{})["name"].(string)
+		}
+
+		saasApp.CustomAttributes = append(saasApp.CustomAttributes, customAttribute)
+	}
+
+	return &saasApp
+}
+
+func convertSaasAppStructToSchema(d *schema.ResourceData, saasApp *accessApplicationSaasApp) []interface{} {
+	if saasApp == nil {
+		return []interface{}{}
+	}
+
+	authType := saasApp.AuthType
+	if authType == "" {
+		authType = "saml"
+	}
+
+	clientSecret := saasApp.ClientSecret
+	if clientSecret == "" {
+		clientSecret = d.Get("saas_app.0.client_secret").(string)
+	}
+
+	customAttributes := make([]interface{}, 0, len(saasApp.CustomAttributes))
+	for _, attribute := range saasApp.CustomAttributes {
+		customAttributes = append(customAttributes, map[string]interface{}{
+			"name":        attribute.Name,
+			"name_format": attribute.NameFormat,
+			"source": []interface{}{map[string]interface{}{
+				"name": attribute.Source.Name,
+			}},
+		})
+	}
+
+	m := map[string]interface{}{
+		"auth_type":            authType,
+		"sp_entity_id":         saasApp.SPEntityID,
+		"consumer_service_url": saasApp.ConsumerServiceURL,
+		"name_id_format":       saasApp.NameIDFormat,
+		"custom_attribute":     customAttributes,
+		"idp_entity_id":        saasApp.IDPEntityID,
+		"sso_endpoint":         saasApp.SSOEndpoint,
+		"public_key":           saasApp.PublicKey,
+		"redirect_uris":        flattenStringList(saasApp.RedirectURIs),
+		"grant_types":          flattenStringList(saasApp.GrantTypes),
+		"scopes":               flattenStringList(saasApp.Scopes),
+		"client_id":            saasApp.ClientID,
+		"client_secret":        clientSecret,
+	}
+
+	return []interface{}{m}
+}
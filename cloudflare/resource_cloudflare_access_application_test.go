@@ -7,7 +7,7 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -451,7 +451,7 @@ resource "cloudflare_access_application" "%[1]s" {
 }
 
 func testAccCheckCloudflareAccessApplicationDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*providerClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_access_application" {
@@ -761,3 +761,172 @@ func testAccessApplicationMisconfiguredCORSAllowWildcardOriginWithCredentials(re
   }
   `, resourceID, zone, zoneID)
 }
+
+func TestAccCloudflareAccessApplicationWithSaasApp(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithSaasApp(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "type", "saas"),
+					resource.TestCheckResourceAttr(name, "saas_app.#", "1"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.auth_type", "saml"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.sp_entity_id", "saas-app.example"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.consumer_service_url", "https://saas-app.example/sso/saml/consume"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.name_id_format", "email"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_attribute.#", "1"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_attribute.0.name", "department"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_attribute.0.source.0.name", "department"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.idp_entity_id"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.sso_endpoint"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.public_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessApplicationWithMissingSaasApp(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareAccessApplicationConfigWithMissingSaasApp(rnd, accountID),
+				ExpectError: regexp.MustCompile(`saas_app is required for applications of type "saas"`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessApplicationConfigWithSaasApp(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  type       = "saas"
+
+  saas_app {
+    sp_entity_id         = "saas-app.example"
+    consumer_service_url = "https://saas-app.example/sso/saml/consume"
+    name_id_format       = "email"
+
+    custom_attribute {
+      name = "department"
+
+      source {
+        name = "department"
+      }
+    }
+  }
+}
+`, rnd, accountID)
+}
+
+func testAccCloudflareAccessApplicationConfigWithMissingSaasApp(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  type       = "saas"
+}
+`, rnd, accountID)
+}
+
+func TestValidateAccessApplicationConfig(t *testing.T) {
+	saasAppType := cty.Object(map[string]cty.Type{
+		"auth_type":            cty.String,
+		"sp_entity_id":         cty.String,
+		"consumer_service_url": cty.String,
+		"redirect_uris":        cty.List(cty.String),
+	})
+	config := func(domain cty.Value, corsHeaders int, saasApps ...cty.Value) cty.Value {
+		cors := cty.ListValEmpty(cty.EmptyObject)
+		if corsHeaders > 0 {
+			cors = cty.ListVal([]cty.Value{cty.EmptyObjectVal})
+		}
+		saasApp := cty.ListValEmpty(saasAppType)
+		if len(saasApps) > 0 {
+			saasApp = cty.ListVal(saasApps)
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"domain":       domain,
+			"cors_headers": cors,
+			"saas_app":     saasApp,
+		})
+	}
+	saml := cty.ObjectVal(map[string]cty.Value{
+		"auth_type":            cty.NullVal(cty.String),
+		"sp_entity_id":         cty.StringVal("saas-app.example"),
+		"consumer_service_url": cty.StringVal("https://saas-app.example/sso/saml/consume"),
+		"redirect_uris":        cty.NullVal(cty.List(cty.String)),
+	})
+	samlWithoutEntityID := cty.ObjectVal(map[string]cty.Value{
+		"auth_type":            cty.StringVal("saml"),
+		"sp_entity_id":         cty.NullVal(cty.String),
+		"consumer_service_url": cty.StringVal("https://saas-app.example/sso/saml/consume"),
+		"redirect_uris":        cty.NullVal(cty.List(cty.String)),
+	})
+	oidc := cty.ObjectVal(map[string]cty.Value{
+		"auth_type":            cty.StringVal("oidc"),
+		"sp_entity_id":         cty.NullVal(cty.String),
+		"consumer_service_url": cty.NullVal(cty.String),
+		"redirect_uris":        cty.ListVal([]cty.Value{cty.StringVal("https://saas-app.example/callback")}),
+	})
+	oidcWithoutRedirectURIs := cty.ObjectVal(map[string]cty.Value{
+		"auth_type":            cty.StringVal("oidc"),
+		"sp_entity_id":         cty.NullVal(cty.String),
+		"consumer_service_url": cty.NullVal(cty.String),
+		"redirect_uris":        cty.NullVal(cty.List(cty.String)),
+	})
+	domain := cty.StringVal("app.example.com")
+	noDomain := cty.NullVal(cty.String)
+
+	testCases := map[string]struct {
+		appType string
+		config  cty.Value
+		err     string
+	}{
+		"self_hosted":                     {"self_hosted", config(domain, 1), ""},
+		"self_hosted with unknown domain": {"self_hosted", config(cty.UnknownVal(cty.String), 0), ""},
+		"self_hosted without domain":      {"self_hosted", config(noDomain, 0), `domain is required for applications of type "self_hosted"`},
+		"bookmark":                        {"bookmark", config(domain, 0), ""},
+		"ssh with cors_headers":           {"ssh", config(domain, 1), `cors_headers can only be set for applications of type "self_hosted"`},
+		"app_launcher":                    {"app_launcher", config(noDomain, 0), ""},
+		"warp with domain":                {"warp", config(domain, 0), `domain can't be set for applications of type "warp"`},
+		"biso with saas_app":              {"biso", config(noDomain, 0, saml), `saas_app can only be set for applications of type "saas"`},
+		"saas saml":                       {"saas", config(noDomain, 0, saml), ""},
+		"saas oidc":                       {"saas", config(noDomain, 0, oidc), ""},
+		"saas without saas_app":           {"saas", config(noDomain, 0), `saas_app is required for applications of type "saas"`},
+		"saas saml without entity ID":     {"saas", config(noDomain, 0, samlWithoutEntityID), "saas_app.0.sp_entity_id and saas_app.0.consumer_service_url are required for SAML SaaS applications"},
+		"saas oidc without redirects":     {"saas", config(noDomain, 0, oidcWithoutRedirectURIs), "saas_app.0.redirect_uris is required for OIDC SaaS applications"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateAccessApplicationConfig(tc.appType, tc.config)
+			if tc.err == "" && err != nil {
+				t.Errorf("expected no error but got %q", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Errorf("expected error %q but got %v", tc.err, err)
+			}
+		})
+	}
+}
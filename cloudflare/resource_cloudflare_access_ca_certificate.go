@@ -7,17 +7,18 @@ import (
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceCloudflareAccessCACertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareAccessCACertificateCreate,
-		Read:   resourceCloudflareAccessCACertificateRead,
-		Update: resourceCloudflareAccessCACertificateUpdate,
-		Delete: resourceCloudflareAccessCACertificateDelete,
+		CreateContext: resourceCloudflareAccessCACertificateCreate,
+		ReadContext:   resourceCloudflareAccessCACertificateRead,
+		UpdateContext: resourceCloudflareAccessCACertificateUpdate,
+		DeleteContext: resourceCloudflareAccessCACertificateDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareAccessCACertificateImport,
+			StateContext: resourceCloudflareAccessCACertificateImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,42 +50,42 @@ func resourceCloudflareAccessCACertificate() *schema.Resource {
 	}
 }
 
-func resourceCloudflareAccessCACertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessCACertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var accessCACert cloudflare.AccessCACertificate
 	if identifier.Type == AccountType {
-		accessCACert, err = client.CreateAccessCACertificate(context.Background(), identifier.Value, d.Get("application_id").(string))
+		accessCACert, err = client.CreateAccessCACertificate(ctx, identifier.Value, d.Get("application_id").(string))
 	} else {
-		accessCACert, err = client.CreateZoneLevelAccessCACertificate(context.Background(), identifier.Value, d.Get("application_id").(string))
+		accessCACert, err = client.CreateZoneLevelAccessCACertificate(ctx, identifier.Value, d.Get("application_id").(string))
 	}
 	if err != nil {
-		return fmt.Errorf("error creating Access CA Certificate for %s %q: %s", identifier.Type, identifier.Value, err)
+		return diag.Errorf("error creating Access CA Certificate for %s %q: %s", identifier.Type, identifier.Value, err)
 	}
 
 	d.SetId(accessCACert.ID)
 
-	return resourceCloudflareAccessCACertificateRead(d, meta)
+	return resourceCloudflareAccessCACertificateRead(ctx, d, meta)
 }
 
-func resourceCloudflareAccessCACertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessCACertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	applicationID := d.Get("application_id").(string)
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var accessCACert cloudflare.AccessCACertificate
 	if identifier.Type == AccountType {
-		accessCACert, err = client.AccessCACertificate(context.Background(), identifier.Value, applicationID)
+		accessCACert, err = client.AccessCACertificate(ctx, identifier.Value, applicationID)
 	} else {
-		accessCACert, err = client.ZoneLevelAccessCACertificate(context.Background(), identifier.Value, applicationID)
+		accessCACert, err = client.ZoneLevelAccessCACertificate(ctx, identifier.Value, applicationID)
 	}
 
 	if err != nil {
@@ -93,7 +94,7 @@ func resourceCloudflareAccessCACertificateRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding Access CA Certificate %q: %s", d.Id(), err)
+		return diag.Errorf("error finding Access CA Certificate %q: %s", d.Id(), err)
 	}
 
 	d.Set("aud", accessCACert.Aud)
@@ -102,29 +103,29 @@ func resourceCloudflareAccessCACertificateRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceCloudflareAccessCACertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCloudflareAccessCACertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceCloudflareAccessCACertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessCACertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	applicationID := d.Get("application_id").(string)
 
 	log.Printf("[DEBUG] Deleting Cloudflare CA Certificate using ID: %s", d.Id())
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if identifier.Type == AccountType {
-		err = client.DeleteAccessCACertificate(context.Background(), identifier.Value, applicationID)
+		err = client.DeleteAccessCACertificate(ctx, identifier.Value, applicationID)
 	} else {
-		err = client.DeleteZoneLevelAccessCACertificate(context.Background(), identifier.Value, applicationID)
+		err = client.DeleteZoneLevelAccessCACertificate(ctx, identifier.Value, applicationID)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -132,7 +133,7 @@ func resourceCloudflareAccessCACertificateDelete(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceCloudflareAccessCACertificateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareAccessCACertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 3)
 
 	if len(attributes) != 3 {
@@ -151,7 +152,7 @@ func resourceCloudflareAccessCACertificateImport(d *schema.ResourceData, meta in
 	d.Set(fmt.Sprintf("%s_id", identifierType), identifierID)
 	d.SetId(accessCACertificateID)
 
-	resourceCloudflareAccessCACertificateRead(d, meta)
+	resourceCloudflareAccessCACertificateRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
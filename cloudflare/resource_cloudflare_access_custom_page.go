@@ -0,0 +1,169 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareAccessCustomPage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareAccessCustomPageCreate,
+		ReadContext:   resourceCloudflareAccessCustomPageRead,
+		UpdateContext: resourceCloudflareAccessCustomPageUpdate,
+		DeleteContext: resourceCloudflareAccessCustomPageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAccessCustomPageImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"zone_id"},
+			},
+			"zone_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"account_id"},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"identity_denied", "forbidden"}, false),
+			},
+			"custom_html": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareAccessCustomPageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	newCustomPage := accessCustomPage{
+		Name:       d.Get("name").(string),
+		Type:       d.Get("type").(string),
+		CustomHTML: d.Get("custom_html").(string),
+	}
+
+	log.Printf("[DEBUG] Creating Cloudflare Access Custom Page from struct: %+v", newCustomPage)
+
+	customPage, err := createAccessCustomPage(ctx, client, identifier, newCustomPage)
+	if err != nil {
+		return diag.Errorf("error creating Access Custom Page for %s %q: %s", identifier.Type, identifier.Value, err)
+	}
+
+	d.SetId(customPage.ID)
+
+	return resourceCloudflareAccessCustomPageRead(ctx, d, meta)
+}
+
+func resourceCloudflareAccessCustomPageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	customPage, err := fetchAccessCustomPage(ctx, client, identifier, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] Access Custom Page %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error finding Access Custom Page %q: %s", d.Id(), err)
+	}
+
+	d.Set("name", customPage.Name)
+	d.Set("type", customPage.Type)
+	d.Set("custom_html", customPage.CustomHTML)
+	d.Set("app_count", customPage.AppCount)
+
+	return nil
+}
+
+func resourceCloudflareAccessCustomPageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedCustomPage := accessCustomPage{
+		ID:         d.Id(),
+		Name:       d.Get("name").(string),
+		Type:       d.Get("type").(string),
+		CustomHTML: d.Get("custom_html").(string),
+	}
+
+	log.Printf("[DEBUG] Updating Cloudflare Access Custom Page from struct: %+v", updatedCustomPage)
+
+	if err := updateAccessCustomPage(ctx, client, identifier, updatedCustomPage); err != nil {
+		return diag.Errorf("error updating Access Custom Page %q: %s", d.Id(), err)
+	}
+
+	return resourceCloudflareAccessCustomPageRead(ctx, d, meta)
+}
+
+func resourceCloudflareAccessCustomPageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	log.Printf("[DEBUG] Deleting Cloudflare Access Custom Page using ID: %s", d.Id())
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := deleteAccessCustomPage(ctx, client, identifier, d.Id()); err != nil {
+		return diag.Errorf("error deleting Access Custom Page %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareAccessCustomPageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/customPageID\"", d.Id())
+	}
+
+	accountID, customPageID := attributes[0], attributes[1]
+
+	log.Printf("[DEBUG] Importing Cloudflare Access Custom Page: id %s for account %s", customPageID, accountID)
+
+	d.Set("account_id", accountID)
+	d.SetId(customPageID)
+
+	err := diagnosticsError(resourceCloudflareAccessCustomPageRead(ctx, d, meta))
+	return []*schema.ResourceData{d}, err
+}
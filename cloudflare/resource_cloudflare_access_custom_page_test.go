@@ -0,0 +1,67 @@
+package cloudflare
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccessCustomPage(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_custom_page.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessCustomPageConfig(rnd, accountID, "identity_denied", "Access denied"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "type", "identity_denied"),
+					resource.TestCheckResourceAttr(name, "custom_html", "<html><body><h1>Access denied</h1></body></html>"),
+					resource.TestCheckResourceAttr(name, "app_count", "0"),
+				),
+			},
+			{
+				Config: testAccCloudflareAccessCustomPageConfig(rnd, accountID, "identity_denied", "Ask the security team for access"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "custom_html", "<html><body><h1>Ask the security team for access</h1></body></html>"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessCustomPageConfig(rnd, accountID, pageType, heading string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_custom_page" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  type        = "%[3]s"
+  custom_html = "<html><body><h1>%[4]s</h1></body></html>"
+}`, rnd, accountID, pageType, heading)
+}
@@ -7,17 +7,19 @@ import (
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceCloudflareAccessGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareAccessGroupCreate,
-		Read:   resourceCloudflareAccessGroupRead,
-		Update: resourceCloudflareAccessGroupUpdate,
-		Delete: resourceCloudflareAccessGroupDelete,
+		CreateContext: resourceCloudflareAccessGroupCreate,
+		ReadContext:   resourceCloudflareAccessGroupRead,
+		UpdateContext: resourceCloudflareAccessGroupUpdate,
+		DeleteContext: resourceCloudflareAccessGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareAccessGroupImport,
+			StateContext: resourceCloudflareAccessGroupImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -136,6 +138,13 @@ var AccessGroupOptionSchemaElement = &schema.Resource{
 				Type: schema.TypeString,
 			},
 		},
+		"ip_list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
 		"gsuite": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -236,22 +245,142 @@ var AccessGroupOptionSchemaElement = &schema.Resource{
 				},
 			},
 		},
+		"oidc_claim": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"claim_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"claim_value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"identity_provider_id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"onelogin": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"identity_provider_id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"centrify": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"identity_provider_id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"external_evaluation": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"evaluate_url": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"keys_url": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
 	},
 }
 
-func resourceCloudflareAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+// The following conditions aren't available in cloudflare-go yet so are
+// defined here in the same shape as the library's AccessGroup* structs.
+
+// accessGroupOIDCClaim is used for managing access based on an OIDC claim
+// of a generic OIDC or Google identity provider.
+type accessGroupOIDCClaim struct {
+	OIDC struct {
+		ClaimName          string `json:"claim_name"`
+		ClaimValue         string `json:"claim_value"`
+		IdentityProviderID string `json:"identity_provider_id"`
+	} `json:"oidc"`
+}
+
+// accessGroupOneLogin is used for managing access based on a OneLogin group.
+type accessGroupOneLogin struct {
+	OneLogin struct {
+		Name               string `json:"name"`
+		IdentityProviderID string `json:"identity_provider_id"`
+	} `json:"onelogin"`
+}
+
+// accessGroupCentrify is used for managing access based on a Centrify role.
+type accessGroupCentrify struct {
+	Centrify struct {
+		Name               string `json:"name"`
+		IdentityProviderID string `json:"identity_provider_id"`
+	} `json:"centrify"`
+}
+
+// accessGroupExternalEvaluation is used for managing access based on the
+// result of an external evaluation endpoint.
+type accessGroupExternalEvaluation struct {
+	ExternalEvaluation struct {
+		EvaluateURL string `json:"evaluate_url"`
+		KeysURL     string `json:"keys_url"`
+	} `json:"external_evaluation"`
+}
+
+// accessGroupIPList is used for managing access based on an IP list.
+type accessGroupIPList struct {
+	IPList struct {
+		ID string `json:"id"`
+	} `json:"ip_list"`
+}
+
+func resourceCloudflareAccessGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var accessGroup cloudflare.AccessGroup
 	if identifier.Type == AccountType {
-		accessGroup, err = client.AccessGroup(context.Background(), identifier.Value, d.Id())
+		accessGroup, err = client.AccessGroup(ctx, identifier.Value, d.Id())
 	} else {
-		accessGroup, err = client.ZoneLevelAccessGroup(context.Background(), identifier.Value, d.Id())
+		accessGroup, err = client.ZoneLevelAccessGroup(ctx, identifier.Value, d.Id())
 	}
 
 	if err != nil {
@@ -260,28 +389,28 @@ func resourceCloudflareAccessGroupRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding Access Group %q: %s", d.Id(), err)
+		return diag.Errorf("error finding Access Group %q: %s", d.Id(), err)
 	}
 
 	d.Set("name", accessGroup.Name)
 
 	if err := d.Set("require", TransformAccessGroupForSchema(accessGroup.Require)); err != nil {
-		return fmt.Errorf("failed to set require attribute: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("require"), fmt.Errorf("failed to set require attribute: %s", err))
 	}
 
 	if err := d.Set("exclude", TransformAccessGroupForSchema(accessGroup.Exclude)); err != nil {
-		return fmt.Errorf("failed to set exclude attribute: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("exclude"), fmt.Errorf("failed to set exclude attribute: %s", err))
 	}
 
 	if err := d.Set("include", TransformAccessGroupForSchema(accessGroup.Include)); err != nil {
-		return fmt.Errorf("failed to set include attribute: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("include"), fmt.Errorf("failed to set include attribute: %s", err))
 	}
 
 	return nil
 }
 
-func resourceCloudflareAccessGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	newAccessGroup := cloudflare.AccessGroup{
 		Name: d.Get("name").(string),
 	}
@@ -292,27 +421,27 @@ func resourceCloudflareAccessGroupCreate(d *schema.ResourceData, meta interface{
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var accessGroup cloudflare.AccessGroup
 	if identifier.Type == AccountType {
-		accessGroup, err = client.CreateAccessGroup(context.Background(), identifier.Value, newAccessGroup)
+		accessGroup, err = client.CreateAccessGroup(ctx, identifier.Value, newAccessGroup)
 	} else {
-		accessGroup, err = client.CreateZoneLevelAccessGroup(context.Background(), identifier.Value, newAccessGroup)
+		accessGroup, err = client.CreateZoneLevelAccessGroup(ctx, identifier.Value, newAccessGroup)
 	}
 	if err != nil {
-		return fmt.Errorf("error creating Access Group for ID %q: %s", accessGroup.ID, err)
+		return diag.Errorf("error creating Access Group for ID %q: %s", accessGroup.ID, err)
 	}
 
 	d.SetId(accessGroup.ID)
-	resourceCloudflareAccessGroupRead(d, meta)
+	resourceCloudflareAccessGroupRead(ctx, d, meta)
 
 	return nil
 }
 
-func resourceCloudflareAccessGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	updatedAccessGroup := cloudflare.AccessGroup{
 		Name: d.Get("name").(string),
 		ID:   d.Id(),
@@ -324,51 +453,51 @@ func resourceCloudflareAccessGroupUpdate(d *schema.ResourceData, meta interface{
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var accessGroup cloudflare.AccessGroup
 	if identifier.Type == AccountType {
-		accessGroup, err = client.UpdateAccessGroup(context.Background(), identifier.Value, updatedAccessGroup)
+		accessGroup, err = client.UpdateAccessGroup(ctx, identifier.Value, updatedAccessGroup)
 	} else {
-		accessGroup, err = client.UpdateZoneLevelAccessGroup(context.Background(), identifier.Value, updatedAccessGroup)
+		accessGroup, err = client.UpdateZoneLevelAccessGroup(ctx, identifier.Value, updatedAccessGroup)
 	}
 	if err != nil {
-		return fmt.Errorf("error updating Access Group for ID %q: %s", d.Id(), err)
+		return diag.Errorf("error updating Access Group for ID %q: %s", d.Id(), err)
 	}
 
 	if accessGroup.ID == "" {
-		return fmt.Errorf("failed to find Access Group ID in update response; resource was empty")
+		return diag.Errorf("failed to find Access Group ID in update response; resource was empty")
 	}
 
-	return resourceCloudflareAccessGroupRead(d, meta)
+	return resourceCloudflareAccessGroupRead(ctx, d, meta)
 }
 
-func resourceCloudflareAccessGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	log.Printf("[DEBUG] Deleting Cloudflare Access Group using ID: %s", d.Id())
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if identifier.Type == AccountType {
-		err = client.DeleteAccessGroup(context.Background(), identifier.Value, d.Id())
+		err = client.DeleteAccessGroup(ctx, identifier.Value, d.Id())
 	} else {
-		err = client.DeleteZoneLevelAccessGroup(context.Background(), identifier.Value, d.Id())
+		err = client.DeleteZoneLevelAccessGroup(ctx, identifier.Value, d.Id())
 	}
 	if err != nil {
-		return fmt.Errorf("error deleting Access Group for ID %q: %s", d.Id(), err)
+		return diag.Errorf("error deleting Access Group for ID %q: %s", d.Id(), err)
 	}
 
-	resourceCloudflareAccessGroupRead(d, meta)
+	resourceCloudflareAccessGroupRead(ctx, d, meta)
 
 	return nil
 }
 
-func resourceCloudflareAccessGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareAccessGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 2)
 
 	if len(attributes) != 2 {
@@ -382,7 +511,7 @@ func resourceCloudflareAccessGroupImport(d *schema.ResourceData, meta interface{
 	d.Set("account_id", accountID)
 	d.SetId(accessGroupID)
 
-	resourceCloudflareAccessGroupRead(d, meta)
+	resourceCloudflareAccessGroupRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -515,6 +644,43 @@ func BuildAccessGroupCondition(options map[string]interface{}) []interface{} {
 					IdentityProviderID: samlCfg["identity_provider_id"].(string),
 				}})
 			}
+		} else if accessGroupType == "oidc_claim" {
+			for _, v := range values.([]interface{}) {
+				oidcCfg := v.(map[string]interface{})
+				condition := accessGroupOIDCClaim{}
+				condition.OIDC.ClaimName = oidcCfg["claim_name"].(string)
+				condition.OIDC.ClaimValue = oidcCfg["claim_value"].(string)
+				condition.OIDC.IdentityProviderID = oidcCfg["identity_provider_id"].(string)
+				group = append(group, condition)
+			}
+		} else if accessGroupType == "onelogin" {
+			for _, v := range values.([]interface{}) {
+				oneloginCfg := v.(map[string]interface{})
+				for _, name := range oneloginCfg["name"].([]interface{}) {
+					condition := accessGroupOneLogin{}
+					condition.OneLogin.Name = name.(string)
+					condition.OneLogin.IdentityProviderID = oneloginCfg["identity_provider_id"].(string)
+					group = append(group, condition)
+				}
+			}
+		} else if accessGroupType == "centrify" {
+			for _, v := range values.([]interface{}) {
+				centrifyCfg := v.(map[string]interface{})
+				for _, name := range centrifyCfg["name"].([]interface{}) {
+					condition := accessGroupCentrify{}
+					condition.Centrify.Name = name.(string)
+					condition.Centrify.IdentityProviderID = centrifyCfg["identity_provider_id"].(string)
+					group = append(group, condition)
+				}
+			}
+		} else if accessGroupType == "external_evaluation" {
+			for _, v := range values.([]interface{}) {
+				externalEvaluationCfg := v.(map[string]interface{})
+				condition := accessGroupExternalEvaluation{}
+				condition.ExternalEvaluation.EvaluateURL = externalEvaluationCfg["evaluate_url"].(string)
+				condition.ExternalEvaluation.KeysURL = externalEvaluationCfg["keys_url"].(string)
+				group = append(group, condition)
+			}
 		} else {
 			for _, value := range values.([]interface{}) {
 				switch accessGroupType {
@@ -550,6 +716,10 @@ func BuildAccessGroupCondition(options map[string]interface{}) []interface{} {
 					group = append(group, cloudflare.AccessGroupDevicePosture{DevicePosture: struct {
 						ID string `json:"integration_uid"`
 					}{ID: value.(string)}})
+				case "ip_list":
+					condition := accessGroupIPList{}
+					condition.IPList.ID = value.(string)
+					group = append(group, condition)
 				}
 			}
 		}
@@ -583,6 +753,14 @@ func TransformAccessGroupForSchema(accessGroup []interface{}) []map[string]inter
 	samlAttrName := ""
 	samlAttrValue := ""
 	devicePostureRuleIDs := []string{}
+	ipListIDs := []string{}
+	oidcClaims := []interface{}{}
+	oneloginIDs := []string{}
+	oneloginNames := map[string][]string{}
+	centrifyIDs := []string{}
+	centrifyNames := map[string][]string{}
+	evaluateURL := ""
+	keysURL := ""
 
 	for _, group := range accessGroup {
 		for groupKey, groupValue := range group.(map[string]interface{}) {
@@ -652,6 +830,35 @@ func TransformAccessGroupForSchema(accessGroup []interface{}) []map[string]inter
 				for _, dprID := range groupValue.(map[string]interface{}) {
 					devicePostureRuleIDs = append(devicePostureRuleIDs, dprID.(string))
 				}
+			case "ip_list":
+				for _, ipListID := range groupValue.(map[string]interface{}) {
+					ipListIDs = append(ipListIDs, ipListID.(string))
+				}
+			case "oidc":
+				oidcCfg := groupValue.(map[string]interface{})
+				oidcClaims = append(oidcClaims, map[string]interface{}{
+					"claim_name":           oidcCfg["claim_name"].(string),
+					"claim_value":          oidcCfg["claim_value"].(string),
+					"identity_provider_id": oidcCfg["identity_provider_id"].(string),
+				})
+			case "onelogin":
+				oneloginCfg := groupValue.(map[string]interface{})
+				oneloginID := oneloginCfg["identity_provider_id"].(string)
+				if _, ok := oneloginNames[oneloginID]; !ok {
+					oneloginIDs = append(oneloginIDs, oneloginID)
+				}
+				oneloginNames[oneloginID] = append(oneloginNames[oneloginID], oneloginCfg["name"].(string))
+			case "centrify":
+				centrifyCfg := groupValue.(map[string]interface{})
+				centrifyID := centrifyCfg["identity_provider_id"].(string)
+				if _, ok := centrifyNames[centrifyID]; !ok {
+					centrifyIDs = append(centrifyIDs, centrifyID)
+				}
+				centrifyNames[centrifyID] = append(centrifyNames[centrifyID], centrifyCfg["name"].(string))
+			case "external_evaluation":
+				externalEvaluationCfg := groupValue.(map[string]interface{})
+				evaluateURL = externalEvaluationCfg["evaluate_url"].(string)
+				keysURL = externalEvaluationCfg["keys_url"].(string)
 			default:
 				log.Printf("[DEBUG] Access Group key %q not transformed", groupKey)
 			}
@@ -769,5 +976,55 @@ func TransformAccessGroupForSchema(accessGroup []interface{}) []map[string]inter
 		})
 	}
 
+	if len(ipListIDs) > 0 {
+		data = append(data, map[string]interface{}{
+			"ip_list": ipListIDs,
+		})
+	}
+
+	if len(oidcClaims) > 0 {
+		data = append(data, map[string]interface{}{
+			"oidc_claim": oidcClaims,
+		})
+	}
+
+	// Names are grouped by identity provider, in the order the providers
+	// first appear in.
+	if len(oneloginIDs) > 0 {
+		onelogin := []interface{}{}
+		for _, oneloginID := range oneloginIDs {
+			onelogin = append(onelogin, map[string]interface{}{
+				"identity_provider_id": oneloginID,
+				"name":                 oneloginNames[oneloginID],
+			})
+		}
+		data = append(data, map[string]interface{}{
+			"onelogin": onelogin,
+		})
+	}
+
+	if len(centrifyIDs) > 0 {
+		centrify := []interface{}{}
+		for _, centrifyID := range centrifyIDs {
+			centrify = append(centrify, map[string]interface{}{
+				"identity_provider_id": centrifyID,
+				"name":                 centrifyNames[centrifyID],
+			})
+		}
+		data = append(data, map[string]interface{}{
+			"centrify": centrify,
+		})
+	}
+
+	if evaluateURL != "" && keysURL != "" {
+		data = append(data, map[string]interface{}{
+			"external_evaluation": []interface{}{
+				map[string]interface{}{
+					"evaluate_url": evaluateURL,
+					"keys_url":     keysURL,
+				}},
+		})
+	}
+
 	return data
 }
@@ -2,9 +2,11 @@ package cloudflare
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -402,7 +404,7 @@ func testAccCheckCloudflareAccessGroupExists(n string, accessIdentifier AccessId
 			return fmt.Errorf("No AccessGroup ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		var foundAccessGroup cloudflare.AccessGroup
 		var err error
 
@@ -430,7 +432,7 @@ func testAccCheckCloudflareAccessGroupExists(n string, accessIdentifier AccessId
 }
 
 func testAccCheckCloudflareAccessGroupDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*providerClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_access_group" {
@@ -467,7 +469,7 @@ func testAccManuallyDeleteAccessGroup(name string, initialID *string) resource.T
 			return fmt.Errorf("not found: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		*initialID = rs.Primary.ID
 		err := client.DeleteAccessGroup(context.Background(), rs.Primary.Attributes["account_id"], rs.Primary.ID)
 		if err != nil {
@@ -485,3 +487,103 @@ func testAccCheckCloudflareAccessGroupRecreated(before, after *cloudflare.Access
 		return nil
 	}
 }
+
+func TestAccessGroupConditionRoundTrip(t *testing.T) {
+	testCases := map[string]map[string]interface{}{
+		"ip_list": {
+			"ip_list": []string{"0d7d9aa3-3bc6-4d1e-a9b5-e3aa1ed76f3c", "7c6a8d2f-8b4f-4d6f-bc8e-e9ad8cb1e6c1"},
+		},
+		"oidc_claim": {
+			"oidc_claim": []interface{}{
+				map[string]interface{}{
+					"claim_name":           "groups",
+					"claim_value":          "engineering",
+					"identity_provider_id": "8d0a1a5d-6c42-4dc1-9b04-6a6d1f1c5d3c",
+				},
+				map[string]interface{}{
+					"claim_name":           "department",
+					"claim_value":          "support",
+					"identity_provider_id": "8d0a1a5d-6c42-4dc1-9b04-6a6d1f1c5d3c",
+				},
+			},
+		},
+		"onelogin": {
+			"onelogin": []interface{}{
+				map[string]interface{}{
+					"name":                 []string{"admins", "developers"},
+					"identity_provider_id": "2f2c8e2d-3b1a-4f8b-8f43-0c7e1e8d4a5b",
+				},
+			},
+		},
+		"onelogin with two identity providers": {
+			"onelogin": []interface{}{
+				map[string]interface{}{
+					"name":                 []string{"admins", "developers"},
+					"identity_provider_id": "2f2c8e2d-3b1a-4f8b-8f43-0c7e1e8d4a5b",
+				},
+				map[string]interface{}{
+					"name":                 []string{"contractors"},
+					"identity_provider_id": "9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b",
+				},
+			},
+		},
+		"centrify with two identity providers": {
+			"centrify": []interface{}{
+				map[string]interface{}{
+					"name":                 []string{"operators"},
+					"identity_provider_id": "5a3b2c1d-4e5f-4a6b-9c8d-7e6f5a4b3c2d",
+				},
+				map[string]interface{}{
+					"name":                 []string{"auditors", "operators"},
+					"identity_provider_id": "1b2c3d4e-5f6a-4b7c-8d9e-0f1a2b3c4d5e",
+				},
+			},
+		},
+		"centrify": {
+			"centrify": []interface{}{
+				map[string]interface{}{
+					"name":                 []string{"operators"},
+					"identity_provider_id": "5a3b2c1d-4e5f-4a6b-9c8d-7e6f5a4b3c2d",
+				},
+			},
+		},
+		"external_evaluation": {
+			"external_evaluation": []interface{}{
+				map[string]interface{}{
+					"evaluate_url": "https://access.example.com/evaluate",
+					"keys_url":     "https://access.example.com/keys",
+				},
+			},
+		},
+	}
+
+	for name, expected := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// The Terraform SDK hands lists to BuildAccessGroupCondition as
+			// []interface{} so the fixtures are converted through JSON first.
+			var options map[string]interface{}
+			roundTripJSON(t, expected, &options)
+
+			var conditions []interface{}
+			roundTripJSON(t, BuildAccessGroupCondition(options), &conditions)
+
+			var actual []map[string]interface{}
+			roundTripJSON(t, TransformAccessGroupForSchema(conditions), &actual)
+
+			if len(actual) != 1 || !reflect.DeepEqual(actual[0], options) {
+				t.Errorf("expected %+v after a round trip, got %+v", options, actual)
+			}
+		})
+	}
+}
+
+func roundTripJSON(t *testing.T, in, out interface{}) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("failed to marshal %+v: %s", in, err)
+	}
+
+	if err := json.Unmarshal(b, out); err != nil {
+		t.Fatalf("failed to unmarshal %s: %s", b, err)
+	}
+}
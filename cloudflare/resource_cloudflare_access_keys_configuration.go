@@ -2,21 +2,21 @@ package cloudflare
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceCloudflareAccessKeysConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceCloudflareAccessKeysConfigurationRead,
-		Create: resourceCloudflareAccessKeysConfigurationCreate,
-		Update: resourceCloudflareAccessKeysConfigurationUpdate,
-		Delete: resourceCloudflareKeysConfigDelete,
+		ReadContext:   resourceCloudflareAccessKeysConfigurationRead,
+		CreateContext: resourceCloudflareAccessKeysConfigurationCreate,
+		UpdateContext: resourceCloudflareAccessKeysConfigurationUpdate,
+		DeleteContext: resourceCloudflareKeysConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareKeysConfigImport,
+			StateContext: resourceCloudflareKeysConfigImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -33,18 +33,18 @@ func resourceCloudflareAccessKeysConfiguration() *schema.Resource {
 	}
 }
 
-func resourceCloudflareAccessKeysConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessKeysConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 
-	keysConfig, err := client.AccessKeysConfig(context.Background(), accountID)
+	keysConfig, err := client.AccessKeysConfig(ctx, accountID)
 	if err != nil {
 		if err.(*cloudflare.APIRequestError).InternalErrorCodeIs(12109) {
 			log.Printf("[INFO] Access Keys Configuration not enabled for account %s", accountID)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding Access Keys Configuration %s: %s", accountID, err)
+		return diag.Errorf("error finding Access Keys Configuration %s: %s", accountID, err)
 	}
 
 	d.SetId(accountID)
@@ -53,45 +53,45 @@ func resourceCloudflareAccessKeysConfigurationRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceCloudflareAccessKeysConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCloudflareAccessKeysConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// keys configuration share the same lifetime as an organization, so creating is a no-op, unless
 	// key_rotation_interval_days was explicitly passed, in which case we need to update its value.
 
 	if keyRotationIntervalDays := d.Get("key_rotation_interval_days").(int); keyRotationIntervalDays == 0 {
-		return resourceCloudflareAccessKeysConfigurationRead(d, meta)
+		return resourceCloudflareAccessKeysConfigurationRead(ctx, d, meta)
 	}
 
-	return resourceCloudflareAccessKeysConfigurationUpdate(d, meta)
+	return resourceCloudflareAccessKeysConfigurationUpdate(ctx, d, meta)
 }
 
-func resourceCloudflareAccessKeysConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessKeysConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 
 	keysConfigUpdateReq := cloudflare.AccessKeysConfigUpdateRequest{
 		KeyRotationIntervalDays: d.Get("key_rotation_interval_days").(int),
 	}
 
-	_, err := client.UpdateAccessKeysConfig(context.Background(), accountID, keysConfigUpdateReq)
+	_, err := client.UpdateAccessKeysConfig(ctx, accountID, keysConfigUpdateReq)
 	if err != nil {
-		return fmt.Errorf("error updating Access Keys Configuration for account %s: %s", accountID, err)
+		return diag.Errorf("error updating Access Keys Configuration for account %s: %s", accountID, err)
 	}
 
-	return resourceCloudflareAccessKeysConfigurationRead(d, meta)
+	return resourceCloudflareAccessKeysConfigurationRead(ctx, d, meta)
 }
 
-func resourceCloudflareKeysConfigDelete(_ *schema.ResourceData, _ interface{}) error {
+func resourceCloudflareKeysConfigDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	// keys configuration share the same lifetime as an organization, and can not be
 	// explicitly deleted by the user. so this is a no-op.
 	return nil
 }
 
-func resourceCloudflareKeysConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareKeysConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	accountID := d.Id()
 
 	d.SetId(accountID)
 	d.Set("account_id", accountID)
 
-	err := resourceCloudflareAccessKeysConfigurationRead(d, meta)
+	err := diagnosticsError(resourceCloudflareAccessKeysConfigurationRead(ctx, d, meta))
 	return []*schema.ResourceData{d}, err
 }
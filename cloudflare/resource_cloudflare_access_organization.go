@@ -0,0 +1,283 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareAccessOrganization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareAccessOrganizationCreate,
+		ReadContext:   resourceCloudflareAccessOrganizationRead,
+		UpdateContext: resourceCloudflareAccessOrganizationUpdate,
+		DeleteContext: resourceCloudflareAccessOrganizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAccessOrganizationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"zone_id"},
+			},
+			"zone_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"account_id"},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"auth_domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_ui_read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"user_seat_expiration_inactive_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					_, err := time.ParseDuration(v)
+					if err != nil {
+						errs = append(errs, fmt.Errorf(`%q only supports "ns", "us" (or "µs"), "ms", "s", "m", or "h" as valid units.`, key))
+					}
+					return
+				},
+			},
+			"auto_redirect_to_identity": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"login_design": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"background_color": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"text_color": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"logo_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"header_text": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"footer_text": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"custom_pages": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"forbidden": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"identity_denied": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudflareAccessOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Every account using Zero Trust already has an organization so it is
+	// only created when there isn't one yet and updated otherwise.
+	_, err = fetchAccessOrganization(ctx, client, identifier)
+	if err == nil {
+		d.SetId(identifier.Value)
+		return resourceCloudflareAccessOrganizationUpdate(ctx, d, meta)
+	}
+
+	if !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.Errorf("error finding Access Organization for %s %q: %s", identifier.Type, identifier.Value, err)
+	}
+
+	newAccessOrganization := buildAccessOrganization(d)
+
+	log.Printf("[DEBUG] Creating Cloudflare Access Organization from struct: %+v", newAccessOrganization)
+
+	if err := createAccessOrganization(ctx, client, identifier, newAccessOrganization); err != nil {
+		return diag.Errorf("error creating Access Organization for %s %q: %s", identifier.Type, identifier.Value, err)
+	}
+
+	d.SetId(identifier.Value)
+
+	return resourceCloudflareAccessOrganizationRead(ctx, d, meta)
+}
+
+func resourceCloudflareAccessOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	organization, err := fetchAccessOrganization(ctx, client, identifier)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] Access Organization for %s %q no longer exists", identifier.Type, identifier.Value)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error finding Access Organization for %s %q: %s", identifier.Type, identifier.Value, err)
+	}
+
+	d.Set("name", organization.Name)
+	d.Set("auth_domain", organization.AuthDomain)
+	d.Set("is_ui_read_only", organization.IsUIReadOnly)
+	d.Set("user_seat_expiration_inactive_time", organization.UserSeatExpirationInactiveTime)
+	d.Set("auto_redirect_to_identity", organization.AutoRedirectToIdentity)
+
+	// The blocks are only kept when something is set so that removing them
+	// from the configuration resets the login design and custom pages.
+	loginDesign := []interface{}{}
+	if organization.LoginDesign != (accessOrganizationLoginDesign{}) {
+		loginDesign = append(loginDesign, map[string]interface{}{
+			"background_color": organization.LoginDesign.BackgroundColor,
+			"text_color":       organization.LoginDesign.TextColor,
+			"logo_path":        organization.LoginDesign.LogoPath,
+			"header_text":      organization.LoginDesign.HeaderText,
+			"footer_text":      organization.LoginDesign.FooterText,
+		})
+	}
+	if err := d.Set("login_design", loginDesign); err != nil {
+		return diag.Errorf("error setting Access Organization login design: %s", err)
+	}
+
+	customPages := []interface{}{}
+	if organization.CustomPages != (accessOrganizationCustomPages{}) {
+		customPages = append(customPages, map[string]interface{}{
+			"forbidden":       organization.CustomPages.Forbidden,
+			"identity_denied": organization.CustomPages.IdentityDenied,
+		})
+	}
+	if err := d.Set("custom_pages", customPages); err != nil {
+		return diag.Errorf("error setting Access Organization custom pages: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareAccessOrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedAccessOrganization := buildAccessOrganization(d)
+
+	log.Printf("[DEBUG] Updating Cloudflare Access Organization from struct: %+v", updatedAccessOrganization)
+
+	if err := updateAccessOrganization(ctx, client, identifier, updatedAccessOrganization); err != nil {
+		return diag.Errorf("error updating Access Organization for %s %q: %s", identifier.Type, identifier.Value, err)
+	}
+
+	return resourceCloudflareAccessOrganizationRead(ctx, d, meta)
+}
+
+func resourceCloudflareAccessOrganizationDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The organization shares the lifetime of the Zero Trust account and can't
+	// be deleted by the user so it is only removed from the state.
+	return nil
+}
+
+func resourceCloudflareAccessOrganizationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"account/accountID\" or \"zone/zoneID\"", d.Id())
+	}
+
+	identifierType, identifierID := attributes[0], attributes[1]
+
+	switch AccessIdentifierType(identifierType) {
+	case AccountType:
+		d.Set("account_id", identifierID)
+	case ZoneType:
+		d.Set("zone_id", identifierID)
+	default:
+		return nil, fmt.Errorf("invalid identifier type (\"%s\") specified, should be either \"account\" or \"zone\"", identifierType)
+	}
+
+	log.Printf("[DEBUG] Importing Cloudflare Access Organization for %s %s", identifierType, identifierID)
+
+	d.SetId(identifierID)
+
+	if err := diagnosticsError(resourceCloudflareAccessOrganizationRead(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Access Organization not found for %s %s", identifierType, identifierID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildAccessOrganization(d *schema.ResourceData) accessOrganization {
+	return accessOrganization{
+		Name:                           d.Get("name").(string),
+		AuthDomain:                     d.Get("auth_domain").(string),
+		IsUIReadOnly:                   d.Get("is_ui_read_only").(bool),
+		UserSeatExpirationInactiveTime: d.Get("user_seat_expiration_inactive_time").(string),
+		AutoRedirectToIdentity:         d.Get("auto_redirect_to_identity").(bool),
+		LoginDesign: accessOrganizationLoginDesign{
+			BackgroundColor: d.Get("login_design.0.background_color").(string),
+			TextColor:       d.Get("login_design.0.text_color").(string),
+			LogoPath:        d.Get("login_design.0.logo_path").(string),
+			HeaderText:      d.Get("login_design.0.header_text").(string),
+			FooterText:      d.Get("login_design.0.footer_text").(string),
+		},
+		CustomPages: accessOrganizationCustomPages{
+			Forbidden:      d.Get("custom_pages.0.forbidden").(string),
+			IdentityDenied: d.Get("custom_pages.0.identity_denied").(string),
+		},
+	}
+}
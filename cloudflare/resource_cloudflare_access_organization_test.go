@@ -0,0 +1,124 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareAccessOrganization(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_organization.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	authDomain := os.Getenv("CLOUDFLARE_ACCESS_AUTH_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			if authDomain == "" {
+				t.Skip("CLOUDFLARE_ACCESS_AUTH_DOMAIN must be set to the auth domain of the account for this acceptance test")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessOrganizationConfig(rnd, accountID, authDomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", accountID),
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "auth_domain", authDomain),
+					resource.TestCheckResourceAttr(name, "is_ui_read_only", "false"),
+					resource.TestCheckResourceAttr(name, "user_seat_expiration_inactive_time", "730h"),
+					resource.TestCheckResourceAttr(name, "auto_redirect_to_identity", "true"),
+					resource.TestCheckResourceAttr(name, "login_design.0.background_color", "#000000"),
+					resource.TestCheckResourceAttr(name, "login_design.0.text_color", "#FFFFFF"),
+					resource.TestCheckResourceAttr(name, "login_design.0.header_text", "My header text"),
+					resource.TestCheckResourceAttr(name, "login_design.0.footer_text", "My footer text"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: "account/",
+				ImportStateVerify:   true,
+			},
+			{
+				Config: testAccCloudflareAccessOrganizationConfigWithoutLoginDesign(rnd, accountID, authDomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "auth_domain", authDomain),
+					resource.TestCheckResourceAttr(name, "login_design.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildAccessOrganization(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareAccessOrganization().Schema, map[string]interface{}{
+		"account_id":  "1d5fdc9e88c8a8c4518b068cd94331fe",
+		"auth_domain": "example.cloudflareaccess.com",
+	})
+
+	body, err := json.Marshal(buildAccessOrganization(d))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var organization map[string]interface{}
+	if err := json.Unmarshal(body, &organization); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := organization["name"]; ok {
+		t.Errorf("expected the name to be left out when it isn't set, got %s", body)
+	}
+
+	if organization["auth_domain"] != "example.cloudflareaccess.com" {
+		t.Errorf("expected the auth domain to be sent, got %s", body)
+	}
+}
+
+func testAccCloudflareAccessOrganizationConfig(rnd, accountID, authDomain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_organization" "%[1]s" {
+  account_id                         = "%[2]s"
+  auth_domain                        = "%[3]s"
+  is_ui_read_only                    = false
+  user_seat_expiration_inactive_time = "730h"
+  auto_redirect_to_identity          = true
+
+  login_design {
+    background_color = "#000000"
+    text_color       = "#FFFFFF"
+    logo_path        = "https://example.com/logo.png"
+    header_text      = "My header text"
+    footer_text      = "My footer text"
+  }
+}`, rnd, accountID, authDomain)
+}
+
+func testAccCloudflareAccessOrganizationConfigWithoutLoginDesign(rnd, accountID, authDomain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_organization" "%[1]s" {
+  account_id                         = "%[2]s"
+  auth_domain                        = "%[3]s"
+  is_ui_read_only                    = false
+  user_seat_expiration_inactive_time = "730h"
+  auto_redirect_to_identity          = true
+}`, rnd, accountID, authDomain)
+}
@@ -7,18 +7,20 @@ import (
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCloudflareAccessPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareAccessPolicyCreate,
-		Read:   resourceCloudflareAccessPolicyRead,
-		Update: resourceCloudflareAccessPolicyUpdate,
-		Delete: resourceCloudflareAccessPolicyDelete,
+		CreateContext: resourceCloudflareAccessPolicyCreate,
+		ReadContext:   resourceCloudflareAccessPolicyRead,
+		UpdateContext: resourceCloudflareAccessPolicyUpdate,
+		DeleteContext: resourceCloudflareAccessPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareAccessPolicyImport,
+			StateContext: resourceCloudflareAccessPolicyImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -136,20 +138,20 @@ func schemaAccessPolicyApprovalGroupToAPI(data map[string]interface{}) cloudflar
 	return approvalGroup
 }
 
-func resourceCloudflareAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	appID := d.Get("application_id").(string)
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var accessPolicy cloudflare.AccessPolicy
 	if identifier.Type == AccountType {
-		accessPolicy, err = client.AccessPolicy(context.Background(), identifier.Value, appID, d.Id())
+		accessPolicy, err = client.AccessPolicy(ctx, identifier.Value, appID, d.Id())
 	} else {
-		accessPolicy, err = client.ZoneLevelAccessPolicy(context.Background(), identifier.Value, appID, d.Id())
+		accessPolicy, err = client.ZoneLevelAccessPolicy(ctx, identifier.Value, appID, d.Id())
 	}
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
@@ -157,7 +159,7 @@ func resourceCloudflareAccessPolicyRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding Access Policy %q: %s", d.Id(), err)
+		return diag.Errorf("error finding Access Policy %q: %s", d.Id(), err)
 	}
 
 	d.Set("name", accessPolicy.Name)
@@ -165,15 +167,15 @@ func resourceCloudflareAccessPolicyRead(d *schema.ResourceData, meta interface{}
 	d.Set("precedence", accessPolicy.Precedence)
 
 	if err := d.Set("require", TransformAccessGroupForSchema(accessPolicy.Require)); err != nil {
-		return fmt.Errorf("failed to set require attribute: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("require"), fmt.Errorf("failed to set require attribute: %s", err))
 	}
 
 	if err := d.Set("exclude", TransformAccessGroupForSchema(accessPolicy.Exclude)); err != nil {
-		return fmt.Errorf("failed to set exclude attribute: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("exclude"), fmt.Errorf("failed to set exclude attribute: %s", err))
 	}
 
 	if err := d.Set("include", TransformAccessGroupForSchema(accessPolicy.Include)); err != nil {
-		return fmt.Errorf("failed to set include attribute: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("include"), fmt.Errorf("failed to set include attribute: %s", err))
 	}
 
 	if accessPolicy.PurposeJustificationRequired != nil {
@@ -194,15 +196,15 @@ func resourceCloudflareAccessPolicyRead(d *schema.ResourceData, meta interface{}
 			approvalGroups = append(approvalGroups, apiAccessPolicyApprovalGroupToSchema(apiApprovalGroup))
 		}
 		if err := d.Set("approval_group", approvalGroups); err != nil {
-			return fmt.Errorf("failed to set approval_group attribute: %s", err)
+			return attributeErrorDiagnostic(cty.GetAttrPath("approval_group"), fmt.Errorf("failed to set approval_group attribute: %s", err))
 		}
 	}
 
 	return nil
 }
 
-func resourceCloudflareAccessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	appID := d.Get("application_id").(string)
 	newAccessPolicy := cloudflare.AccessPolicy{
 		Name:       d.Get("name").(string),
@@ -216,17 +218,17 @@ func resourceCloudflareAccessPolicyCreate(d *schema.ResourceData, meta interface
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var accessPolicy cloudflare.AccessPolicy
 	if identifier.Type == AccountType {
-		accessPolicy, err = client.CreateAccessPolicy(context.Background(), identifier.Value, appID, newAccessPolicy)
+		accessPolicy, err = client.CreateAccessPolicy(ctx, identifier.Value, appID, newAccessPolicy)
 	} else {
-		accessPolicy, err = client.CreateZoneLevelAccessPolicy(context.Background(), identifier.Value, appID, newAccessPolicy)
+		accessPolicy, err = client.CreateZoneLevelAccessPolicy(ctx, identifier.Value, appID, newAccessPolicy)
 	}
 	if err != nil {
-		return fmt.Errorf("error creating Access Policy for ID %q: %s", accessPolicy.ID, err)
+		return diag.Errorf("error creating Access Policy for ID %q: %s", accessPolicy.ID, err)
 	}
 
 	d.SetId(accessPolicy.ID)
@@ -234,8 +236,8 @@ func resourceCloudflareAccessPolicyCreate(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceCloudflareAccessPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	appID := d.Get("application_id").(string)
 	updatedAccessPolicy := cloudflare.AccessPolicy{
 		Name:       d.Get("name").(string),
@@ -250,52 +252,52 @@ func resourceCloudflareAccessPolicyUpdate(d *schema.ResourceData, meta interface
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var accessPolicy cloudflare.AccessPolicy
 	if identifier.Type == AccountType {
-		accessPolicy, err = client.UpdateAccessPolicy(context.Background(), identifier.Value, appID, updatedAccessPolicy)
+		accessPolicy, err = client.UpdateAccessPolicy(ctx, identifier.Value, appID, updatedAccessPolicy)
 	} else {
-		accessPolicy, err = client.UpdateZoneLevelAccessPolicy(context.Background(), identifier.Value, appID, updatedAccessPolicy)
+		accessPolicy, err = client.UpdateZoneLevelAccessPolicy(ctx, identifier.Value, appID, updatedAccessPolicy)
 	}
 	if err != nil {
-		return fmt.Errorf("error updating Access Policy for ID %q: %s", d.Id(), err)
+		return diag.Errorf("error updating Access Policy for ID %q: %s", d.Id(), err)
 	}
 
 	if accessPolicy.ID == "" {
-		return fmt.Errorf("failed to find Access Policy ID in update response; resource was empty")
+		return diag.Errorf("failed to find Access Policy ID in update response; resource was empty")
 	}
 
-	return resourceCloudflareAccessPolicyRead(d, meta)
+	return resourceCloudflareAccessPolicyRead(ctx, d, meta)
 }
 
-func resourceCloudflareAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	appID := d.Get("application_id").(string)
 
 	log.Printf("[DEBUG] Deleting Cloudflare Access Policy using ID: %s", d.Id())
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if identifier.Type == AccountType {
-		err = client.DeleteAccessPolicy(context.Background(), identifier.Value, appID, d.Id())
+		err = client.DeleteAccessPolicy(ctx, identifier.Value, appID, d.Id())
 	} else {
-		err = client.DeleteZoneLevelAccessPolicy(context.Background(), identifier.Value, appID, d.Id())
+		err = client.DeleteZoneLevelAccessPolicy(ctx, identifier.Value, appID, d.Id())
 	}
 	if err != nil {
-		return fmt.Errorf("error deleting Access Policy for ID %q: %s", d.Id(), err)
+		return diag.Errorf("error deleting Access Policy for ID %q: %s", d.Id(), err)
 	}
 
-	resourceCloudflareAccessPolicyRead(d, meta)
+	resourceCloudflareAccessPolicyRead(ctx, d, meta)
 
 	return nil
 }
 
-func resourceCloudflareAccessPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareAccessPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 4)
 
 	if len(attributes) != 4 {
@@ -316,7 +318,7 @@ func resourceCloudflareAccessPolicyImport(d *schema.ResourceData, meta interface
 	d.Set("application_id", accessAppID)
 	d.SetId(accessPolicyID)
 
-	resourceCloudflareAccessPolicyRead(d, meta)
+	resourceCloudflareAccessPolicyRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
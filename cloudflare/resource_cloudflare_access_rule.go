@@ -8,18 +8,19 @@ import (
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCloudflareAccessRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareAccessRuleCreate,
-		Read:   resourceCloudflareAccessRuleRead,
-		Update: resourceCloudflareAccessRuleUpdate,
-		Delete: resourceCloudflareAccessRuleDelete,
+		CreateContext: resourceCloudflareAccessRuleCreate,
+		ReadContext:   resourceCloudflareAccessRuleRead,
+		UpdateContext: resourceCloudflareAccessRuleUpdate,
+		DeleteContext: resourceCloudflareAccessRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareAccessRuleImport,
+			StateContext: resourceCloudflareAccessRuleImport,
 		},
 
 		SchemaVersion: 1,
@@ -72,8 +73,8 @@ func resourceCloudflareAccessRule() *schema.Resource {
 	}
 }
 
-func resourceCloudflareAccessRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	newRule := cloudflare.AccessRule{
@@ -95,29 +96,29 @@ func resourceCloudflareAccessRuleCreate(d *schema.ResourceData, meta interface{}
 
 	if zoneID == "" {
 		if client.AccountID != "" {
-			r, err = client.CreateAccountAccessRule(context.Background(), client.AccountID, newRule)
+			r, err = client.CreateAccountAccessRule(ctx, client.AccountID, newRule)
 		} else {
-			r, err = client.CreateUserAccessRule(context.Background(), newRule)
+			r, err = client.CreateUserAccessRule(ctx, newRule)
 		}
 	} else {
-		r, err = client.CreateZoneAccessRule(context.Background(), zoneID, newRule)
+		r, err = client.CreateZoneAccessRule(ctx, zoneID, newRule)
 	}
 
 	if err != nil {
-		return fmt.Errorf("failed to create access rule: %s", err)
+		return diag.Errorf("failed to create access rule: %s", err)
 	}
 
 	if r.Result.ID == "" {
-		return fmt.Errorf("Failed to find access rule in Create response; ID was empty")
+		return diag.Errorf("Failed to find access rule in Create response; ID was empty")
 	}
 
 	d.SetId(r.Result.ID)
 
-	return resourceCloudflareAccessRuleRead(d, meta)
+	return resourceCloudflareAccessRuleRead(ctx, d, meta)
 }
 
-func resourceCloudflareAccessRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	var accessRuleResponse *cloudflare.AccessRuleResponse
@@ -125,12 +126,12 @@ func resourceCloudflareAccessRuleRead(d *schema.ResourceData, meta interface{})
 
 	if zoneID == "" {
 		if client.AccountID != "" {
-			accessRuleResponse, err = client.AccountAccessRule(context.Background(), client.AccountID, d.Id())
+			accessRuleResponse, err = client.AccountAccessRule(ctx, client.AccountID, d.Id())
 		} else {
-			accessRuleResponse, err = client.UserAccessRule(context.Background(), d.Id())
+			accessRuleResponse, err = client.UserAccessRule(ctx, d.Id())
 		}
 	} else {
-		accessRuleResponse, err = client.ZoneAccessRule(context.Background(), zoneID, d.Id())
+		accessRuleResponse, err = client.ZoneAccessRule(ctx, zoneID, d.Id())
 	}
 
 	log.Printf("[DEBUG] accessRuleResponse: %#v", accessRuleResponse)
@@ -142,7 +143,7 @@ func resourceCloudflareAccessRuleRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding access rule %q: %s", d.Id(), err)
+		return diag.Errorf("error finding access rule %q: %s", d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] Cloudflare Access Rule read configuration: %#v", accessRuleResponse)
@@ -163,8 +164,8 @@ func resourceCloudflareAccessRuleRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceCloudflareAccessRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	updatedRule := cloudflare.AccessRule{
@@ -186,23 +187,23 @@ func resourceCloudflareAccessRuleUpdate(d *schema.ResourceData, meta interface{}
 
 	if zoneID == "" {
 		if client.AccountID != "" {
-			_, err = client.UpdateAccountAccessRule(context.Background(), client.AccountID, d.Id(), updatedRule)
+			_, err = client.UpdateAccountAccessRule(ctx, client.AccountID, d.Id(), updatedRule)
 		} else {
-			_, err = client.UpdateUserAccessRule(context.Background(), d.Id(), updatedRule)
+			_, err = client.UpdateUserAccessRule(ctx, d.Id(), updatedRule)
 		}
 	} else {
-		_, err = client.UpdateZoneAccessRule(context.Background(), zoneID, d.Id(), updatedRule)
+		_, err = client.UpdateZoneAccessRule(ctx, zoneID, d.Id(), updatedRule)
 	}
 
 	if err != nil {
-		return fmt.Errorf("failed to update Access Rule: %s", err)
+		return diag.Errorf("failed to update Access Rule: %s", err)
 	}
 
-	return resourceCloudflareAccessRuleRead(d, meta)
+	return resourceCloudflareAccessRuleRead(ctx, d, meta)
 }
 
-func resourceCloudflareAccessRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Access Rule: id %s for zone_id %s", d.Id(), zoneID)
@@ -211,23 +212,23 @@ func resourceCloudflareAccessRuleDelete(d *schema.ResourceData, meta interface{}
 
 	if zoneID == "" {
 		if client.AccountID != "" {
-			_, err = client.DeleteAccountAccessRule(context.Background(), client.AccountID, d.Id())
+			_, err = client.DeleteAccountAccessRule(ctx, client.AccountID, d.Id())
 		} else {
-			_, err = client.DeleteUserAccessRule(context.Background(), d.Id())
+			_, err = client.DeleteUserAccessRule(ctx, d.Id())
 		}
 	} else {
-		_, err = client.DeleteZoneAccessRule(context.Background(), zoneID, d.Id())
+		_, err = client.DeleteZoneAccessRule(ctx, zoneID, d.Id())
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting Cloudflare Access Rule: %s", err)
+		return diag.Errorf("error deleting Cloudflare Access Rule: %s", err)
 	}
 
 	return nil
 }
 
-func resourceCloudflareAccessRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareAccessRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerClient)
 	attributes := strings.Split(d.Id(), "/")
 
 	var (
@@ -251,7 +252,7 @@ func resourceCloudflareAccessRuleImport(d *schema.ResourceData, meta interface{}
 		d.Set("zone_id", accessRuleTypeIdentifier)
 	}
 
-	resourceCloudflareAccessRuleRead(d, meta)
+	resourceCloudflareAccessRuleRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -3,12 +3,12 @@ package cloudflare
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -36,12 +36,12 @@ func resourceCloudflareApiToken() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceCloudflareApiTokenCreate,
-		Read:   resourceCloudflareApiTokenRead,
-		Update: resourceCloudflareApiTokenUpdate,
-		Delete: resourceCloudflareApiTokenDelete,
+		CreateContext: resourceCloudflareApiTokenCreate,
+		ReadContext:   resourceCloudflareApiTokenRead,
+		UpdateContext: resourceCloudflareApiTokenUpdate,
+		DeleteContext: resourceCloudflareApiTokenDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -136,17 +136,17 @@ func buildAPIToken(d *schema.ResourceData) cloudflare.APIToken {
 	return token
 }
 
-func resourceCloudflareApiTokenCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareApiTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	name := d.Get("name").(string)
 
 	log.Printf("[INFO] Creating Cloudflare API Token: name %s", name)
 
 	t := buildAPIToken(d)
-	t, err := client.CreateAPIToken(context.Background(), t)
+	t, err := client.CreateAPIToken(ctx, t)
 	if err != nil {
-		return fmt.Errorf("error creating Cloudflare API Token %q: %s", name, err)
+		return diag.Errorf("error creating Cloudflare API Token %q: %s", name, err)
 	}
 
 	d.SetId(t.ID)
@@ -155,7 +155,7 @@ func resourceCloudflareApiTokenCreate(d *schema.ResourceData, meta interface{})
 	d.Set("modified_on", t.ModifiedOn.Format(time.RFC3339Nano))
 	d.Set("value", t.Value)
 
-	return resourceCloudflareApiTokenRead(d, meta)
+	return resourceCloudflareApiTokenRead(ctx, d, meta)
 }
 
 func resourceDataToApiTokenPolices(d *schema.ResourceData) []cloudflare.APITokenPolicies {
@@ -197,11 +197,11 @@ func resourceDataToApiTokenPolices(d *schema.ResourceData) []cloudflare.APIToken
 	return cfPolicies
 }
 
-func resourceCloudflareApiTokenRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareApiTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	tokenID := d.Id()
 
-	t, err := client.GetAPIToken(context.Background(), tokenID)
+	t, err := client.GetAPIToken(ctx, tokenID)
 
 	log.Printf("[DEBUG] Cloudflare API Token: %+v", t)
 
@@ -211,7 +211,7 @@ func resourceCloudflareApiTokenRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding Cloudflare API Token %q: %s", d.Id(), err)
+		return diag.Errorf("error finding Cloudflare API Token %q: %s", d.Id(), err)
 	}
 
 	policies := []map[string]interface{}{}
@@ -257,8 +257,8 @@ func resourceCloudflareApiTokenRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceCloudflareApiTokenUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareApiTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	name := d.Get("name").(string)
 	tokenID := d.Id()
@@ -267,23 +267,23 @@ func resourceCloudflareApiTokenUpdate(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[INFO] Updating Cloudflare API Token: name %s", name)
 
-	t, err := client.UpdateAPIToken(context.Background(), tokenID, t)
+	t, err := client.UpdateAPIToken(ctx, tokenID, t)
 	if err != nil {
-		return fmt.Errorf("error updating Cloudflare API Token %q: %s", name, err)
+		return diag.Errorf("error updating Cloudflare API Token %q: %s", name, err)
 	}
 
-	return resourceCloudflareApiTokenRead(d, meta)
+	return resourceCloudflareApiTokenRead(ctx, d, meta)
 }
 
-func resourceCloudflareApiTokenDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareApiTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	tokenID := d.Id()
 
 	log.Printf("[INFO] Deleting Cloudflare API Token: id %s", tokenID)
 
-	err := client.DeleteAPIToken(context.Background(), tokenID)
+	err := client.DeleteAPIToken(ctx, tokenID)
 	if err != nil {
-		return fmt.Errorf("error deleting Cloudflare API Token: %s", err)
+		return diag.Errorf("error deleting Cloudflare API Token: %s", err)
 	}
 
 	return nil
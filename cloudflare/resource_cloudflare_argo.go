@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -16,12 +16,12 @@ func resourceCloudflareArgo() *schema.Resource {
 		// Pointing `Create` to the `Update `method is intentional. Argo
 		// settings are always present, it's just whether or not the value
 		// is "on" or "off".
-		Create: resourceCloudflareArgoUpdate,
-		Read:   resourceCloudflareArgoRead,
-		Update: resourceCloudflareArgoUpdate,
-		Delete: resourceCloudflareArgoDelete,
+		CreateContext: resourceCloudflareArgoUpdate,
+		ReadContext:   resourceCloudflareArgoRead,
+		UpdateContext: resourceCloudflareArgoUpdate,
+		DeleteContext: resourceCloudflareArgoDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareArgoImport,
+			StateContext: resourceCloudflareArgoImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,8 +43,8 @@ func resourceCloudflareArgo() *schema.Resource {
 	}
 }
 
-func resourceCloudflareArgoRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareArgoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[DEBUG] zone ID: %s", zoneID)
@@ -53,16 +53,16 @@ func resourceCloudflareArgoRead(d *schema.ResourceData, meta interface{}) error
 	d.SetId(checksum)
 	d.Set("zone_id", zoneID)
 
-	tieredCaching, err := client.ArgoTieredCaching(context.Background(), zoneID)
+	tieredCaching, err := client.ArgoTieredCaching(ctx, zoneID)
 	if err != nil {
-		return errors.Wrap(err, "failed to get tiered caching setting")
+		return diag.FromErr(errors.Wrap(err, "failed to get tiered caching setting"))
 	}
 
 	d.Set("tiered_caching", tieredCaching.Value)
 
-	smartRouting, err := client.ArgoSmartRouting(context.Background(), zoneID)
+	smartRouting, err := client.ArgoSmartRouting(ctx, zoneID)
 	if err != nil {
-		return errors.Wrap(err, "failed to get smart routing setting")
+		return diag.FromErr(errors.Wrap(err, "failed to get smart routing setting"))
 	}
 
 	d.Set("smart_routing", smartRouting.Value)
@@ -70,58 +70,58 @@ func resourceCloudflareArgoRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceCloudflareArgoUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareArgoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	tieredCaching := d.Get("tiered_caching").(string)
 	smartRouting := d.Get("smart_routing").(string)
 
 	if smartRouting != "" {
-		argoSmartRouting, err := client.UpdateArgoSmartRouting(context.Background(), zoneID, smartRouting)
+		argoSmartRouting, err := client.UpdateArgoSmartRouting(ctx, zoneID, smartRouting)
 		if err != nil {
-			return errors.Wrap(err, "failed to update smart routing setting")
+			return diag.FromErr(errors.Wrap(err, "failed to update smart routing setting"))
 		}
 		log.Printf("[DEBUG] Argo Smart Routing set to: %s", argoSmartRouting.Value)
 	}
 
 	if tieredCaching != "" {
-		argoTieredCaching, err := client.UpdateArgoTieredCaching(context.Background(), zoneID, tieredCaching)
+		argoTieredCaching, err := client.UpdateArgoTieredCaching(ctx, zoneID, tieredCaching)
 		if err != nil {
-			return errors.Wrap(err, "failed to update tiered caching setting")
+			return diag.FromErr(errors.Wrap(err, "failed to update tiered caching setting"))
 		}
 		log.Printf("[DEBUG] Argo Tiered Caching set to: %s", argoTieredCaching.Value)
 	}
 
-	return resourceCloudflareArgoRead(d, meta)
+	return resourceCloudflareArgoRead(ctx, d, meta)
 }
 
-func resourceCloudflareArgoDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareArgoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[DEBUG] Resetting Argo values to 'off'")
 
-	_, smartRoutingErr := client.UpdateArgoSmartRouting(context.Background(), zoneID, "off")
+	_, smartRoutingErr := client.UpdateArgoSmartRouting(ctx, zoneID, "off")
 	if smartRoutingErr != nil {
-		return errors.Wrap(smartRoutingErr, "failed to update smart routing setting")
+		return diag.FromErr(errors.Wrap(smartRoutingErr, "failed to update smart routing setting"))
 	}
 
-	_, tieredCachingErr := client.UpdateArgoTieredCaching(context.Background(), zoneID, "off")
+	_, tieredCachingErr := client.UpdateArgoTieredCaching(ctx, zoneID, "off")
 	if tieredCachingErr != nil {
-		return errors.Wrap(tieredCachingErr, "failed to update tiered caching setting")
+		return diag.FromErr(errors.Wrap(tieredCachingErr, "failed to update tiered caching setting"))
 	}
 
 	return nil
 }
 
-func resourceCloudflareArgoImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareArgoImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	zoneID := d.Id()
 
 	id := stringChecksum(fmt.Sprintf("%s/argo", zoneID))
 	d.SetId(id)
 	d.Set("zone_id", zoneID)
 
-	resourceCloudflareArgoRead(d, meta)
+	resourceCloudflareArgoRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -14,11 +14,11 @@ const argoTunnelCNAME = "cfargotunnel.com"
 
 func resourceCloudflareArgoTunnel() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareArgoTunnelCreate,
-		Read:   resourceCloudflareArgoTunnelRead,
-		Delete: resourceCloudflareArgoTunnelDelete,
+		CreateContext: resourceCloudflareArgoTunnelCreate,
+		ReadContext:   resourceCloudflareArgoTunnelRead,
+		DeleteContext: resourceCloudflareArgoTunnelDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareArgoTunnelImport,
+			StateContext: resourceCloudflareArgoTunnelImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -46,29 +46,29 @@ func resourceCloudflareArgoTunnel() *schema.Resource {
 	}
 }
 
-func resourceCloudflareArgoTunnelCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareArgoTunnelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accID := d.Get("account_id").(string)
 	name := d.Get("name").(string)
 	secret := d.Get("secret").(string)
 
-	tunnel, err := client.CreateArgoTunnel(context.Background(), accID, name, secret)
+	tunnel, err := client.CreateArgoTunnel(ctx, accID, name, secret)
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("failed to create Argo Tunnel"))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("failed to create Argo Tunnel")))
 	}
 
 	d.SetId(tunnel.ID)
 
-	return resourceCloudflareArgoTunnelRead(d, meta)
+	return resourceCloudflareArgoTunnelRead(ctx, d, meta)
 }
 
-func resourceCloudflareArgoTunnelRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareArgoTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accID := d.Get("account_id").(string)
 
-	tunnel, err := client.ArgoTunnel(context.Background(), accID, d.Id())
+	tunnel, err := client.ArgoTunnel(ctx, accID, d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to fetch Argo Tunnel: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to fetch Argo Tunnel: %w", err))
 	}
 
 	d.Set("cname", fmt.Sprintf("%s.%s", tunnel.ID, argoTunnelCNAME))
@@ -76,18 +76,18 @@ func resourceCloudflareArgoTunnelRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceCloudflareArgoTunnelDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareArgoTunnelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accID := d.Get("account_id").(string)
 
-	cleanupErr := client.CleanupArgoTunnelConnections(context.Background(), accID, d.Id())
+	cleanupErr := client.CleanupArgoTunnelConnections(ctx, accID, d.Id())
 	if cleanupErr != nil {
-		return errors.Wrap(cleanupErr, fmt.Sprintf("failed to clean up Argo Tunnel connections"))
+		return diag.FromErr(errors.Wrap(cleanupErr, fmt.Sprintf("failed to clean up Argo Tunnel connections")))
 	}
 
-	deleteErr := client.DeleteArgoTunnel(context.Background(), accID, d.Id())
+	deleteErr := client.DeleteArgoTunnel(ctx, accID, d.Id())
 	if deleteErr != nil {
-		return errors.Wrap(deleteErr, fmt.Sprintf("failed to delete Argo Tunnel"))
+		return diag.FromErr(errors.Wrap(deleteErr, fmt.Sprintf("failed to delete Argo Tunnel")))
 	}
 
 	d.SetId("")
@@ -95,8 +95,8 @@ func resourceCloudflareArgoTunnelDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceCloudflareArgoTunnelImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareArgoTunnelImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerClient)
 	attributes := strings.Split(d.Id(), "/")
 
 	if len(attributes) != 2 {
@@ -105,7 +105,7 @@ func resourceCloudflareArgoTunnelImport(d *schema.ResourceData, meta interface{}
 
 	accID, tunnelID := attributes[0], attributes[1]
 
-	tunnel, err := client.ArgoTunnel(context.Background(), accID, tunnelID)
+	tunnel, err := client.ArgoTunnel(ctx, accID, tunnelID)
 	if err != nil {
 		return nil, errors.Wrap(err, fmt.Sprintf("failed to fetch Argo Tunnel %s", tunnelID))
 	}
@@ -113,7 +113,7 @@ func resourceCloudflareArgoTunnelImport(d *schema.ResourceData, meta interface{}
 	d.Set("name", tunnel.Name)
 	d.SetId(tunnel.ID)
 
-	resourceCloudflareArgoTunnelRead(d, meta)
+	resourceCloudflareArgoTunnelRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
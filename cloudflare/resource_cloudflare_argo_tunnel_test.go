@@ -7,7 +7,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -62,7 +61,7 @@ func testAccCheckCloudflareArgoTunnelDestroy(s *terraform.State) error {
 
 		accountID := rs.Primary.Attributes["account_id"]
 		tunnelID := rs.Primary.ID
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		tunnel, err := client.ArgoTunnel(context.Background(), accountID, tunnelID)
 
 		if err != nil {
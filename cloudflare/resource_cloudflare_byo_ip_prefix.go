@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -12,12 +12,12 @@ import (
 
 func resourceCloudflareBYOIPPrefix() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareBYOIPPrefixCreate,
-		Read:   resourceCloudflareBYOIPPrefixRead,
-		Update: resourceCloudflareBYOIPPrefixUpdate,
-		Delete: resourceCloudflareBYOIPPrefixDelete,
+		CreateContext: resourceCloudflareBYOIPPrefixCreate,
+		ReadContext:   resourceCloudflareBYOIPPrefixRead,
+		UpdateContext: resourceCloudflareBYOIPPrefixUpdate,
+		DeleteContext: resourceCloudflareBYOIPPrefixDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareBYOIPPrefixImport,
+			StateContext: resourceCloudflareBYOIPPrefixImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,39 +41,39 @@ func resourceCloudflareBYOIPPrefix() *schema.Resource {
 	}
 }
 
-func resourceCloudflareBYOIPPrefixCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCloudflareBYOIPPrefixCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	prefixID := d.Get("prefix_id")
 	d.SetId(prefixID.(string))
 
-	if err := resourceCloudflareBYOIPPrefixUpdate(d, meta); err != nil {
-		return err
+	if diags := resourceCloudflareBYOIPPrefixUpdate(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 
-	return resourceCloudflareBYOIPPrefixRead(d, meta)
+	return resourceCloudflareBYOIPPrefixRead(ctx, d, meta)
 }
 
-func resourceCloudflareBYOIPPrefixImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareBYOIPPrefixImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	prefixID := d.Id()
 	d.Set("prefix_id", prefixID)
 
-	resourceCloudflareBYOIPPrefixRead(d, meta)
+	resourceCloudflareBYOIPPrefixRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceCloudflareBYOIPPrefixRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareBYOIPPrefixRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
-	prefix, err := client.GetPrefix(context.Background(), d.Id())
+	prefix, err := client.GetPrefix(ctx, d.Id())
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error reading IP prefix information for %q", d.Id()))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading IP prefix information for %q", d.Id())))
 	}
 
 	d.Set("description", prefix.Description)
 
-	advertisementStatus, err := client.GetAdvertisementStatus(context.Background(), d.Id())
+	advertisementStatus, err := client.GetAdvertisementStatus(ctx, d.Id())
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error reading advertisement status of IP prefix for %q", d.Id()))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading advertisement status of IP prefix for %q", d.Id())))
 	}
 
 	d.Set("advertisement", stringFromBool(advertisementStatus.Advertised))
@@ -81,18 +81,18 @@ func resourceCloudflareBYOIPPrefixRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceCloudflareBYOIPPrefixUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareBYOIPPrefixUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	if _, ok := d.GetOk("description"); ok && d.HasChange("description") {
-		if _, err := client.UpdatePrefixDescription(context.Background(), d.Id(), d.Get("description").(string)); err != nil {
-			return errors.Wrap(err, fmt.Sprintf("cannot update prefix description for %q", d.Id()))
+		if _, err := client.UpdatePrefixDescription(ctx, d.Id(), d.Get("description").(string)); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("cannot update prefix description for %q", d.Id())))
 		}
 	}
 
 	if _, ok := d.GetOk("advertisement"); ok && d.HasChange("advertisement") {
-		if _, err := client.UpdateAdvertisementStatus(context.Background(), d.Id(), boolFromString(d.Get("advertisement").(string))); err != nil {
-			return errors.Wrap(err, fmt.Sprintf("cannot update prefix advertisement status for %q", d.Id()))
+		if _, err := client.UpdateAdvertisementStatus(ctx, d.Id(), boolFromString(d.Get("advertisement").(string))); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("cannot update prefix advertisement status for %q", d.Id())))
 		}
 	}
 
@@ -100,6 +100,6 @@ func resourceCloudflareBYOIPPrefixUpdate(d *schema.ResourceData, meta interface{
 }
 
 // Deletion of prefixes is not really supported, so we keep this as a dummy
-func resourceCloudflareBYOIPPrefixDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCloudflareBYOIPPrefixDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -16,11 +17,11 @@ func resourceCloudflareCertificatePack() *schema.Resource {
 	return &schema.Resource{
 		// Intentionally no Update method as certificates require replacement for
 		// any changes made.
-		Create: resourceCloudflareCertificatePackCreate,
-		Read:   resourceCloudflareCertificatePackRead,
-		Delete: resourceCloudflareCertificatePackDelete,
+		CreateContext: resourceCloudflareCertificatePackCreate,
+		ReadContext:   resourceCloudflareCertificatePackRead,
+		DeleteContext: resourceCloudflareCertificatePackDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareCertificatePackImport,
+			StateContext: resourceCloudflareCertificatePackImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -70,8 +71,8 @@ func resourceCloudflareCertificatePack() *schema.Resource {
 	}
 }
 
-func resourceCloudflareCertificatePackCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCertificatePackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	certificatePackType := d.Get("type").(string)
 	certificateHostSet := d.Get("hosts").(*schema.Set)
@@ -91,9 +92,9 @@ func resourceCloudflareCertificatePackCreate(d *schema.ResourceData, meta interf
 			CertificateAuthority: ca,
 			CloudflareBranding:   cloudflareBranding,
 		}
-		certPackResponse, err := client.CreateAdvancedCertificatePack(context.Background(), zoneID, cert)
+		certPackResponse, err := client.CreateAdvancedCertificatePack(ctx, zoneID, cert)
 		if err != nil {
-			return errors.Wrap(err, fmt.Sprintf("failed to create certificate pack: %s", err))
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("failed to create certificate pack: %s", err)))
 		}
 		certificatePackID = certPackResponse.ID
 	} else {
@@ -101,25 +102,25 @@ func resourceCloudflareCertificatePackCreate(d *schema.ResourceData, meta interf
 			Type:  certificatePackType,
 			Hosts: expandInterfaceToStringList(certificateHostSet.List()),
 		}
-		certPackResponse, err := client.CreateCertificatePack(context.Background(), zoneID, cert)
+		certPackResponse, err := client.CreateCertificatePack(ctx, zoneID, cert)
 		if err != nil {
-			return errors.Wrap(err, fmt.Sprintf("failed to create certificate pack: %s", err))
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("failed to create certificate pack: %s", err)))
 		}
 		certificatePackID = certPackResponse.ID
 	}
 
 	d.SetId(certificatePackID)
 
-	return resourceCloudflareCertificatePackRead(d, meta)
+	return resourceCloudflareCertificatePackRead(ctx, d, meta)
 }
 
-func resourceCloudflareCertificatePackRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCertificatePackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
-	certificatePack, err := client.CertificatePack(context.Background(), zoneID, d.Id())
+	certificatePack, err := client.CertificatePack(ctx, zoneID, d.Id())
 	if err != nil {
-		return errors.Wrap(err, "failed to fetch certificate pack")
+		return diag.FromErr(errors.Wrap(err, "failed to fetch certificate pack"))
 	}
 
 	d.Set("type", certificatePack.Type)
@@ -128,21 +129,21 @@ func resourceCloudflareCertificatePackRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceCloudflareCertificatePackDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCertificatePackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
-	err := client.DeleteCertificatePack(context.Background(), zoneID, d.Id())
+	err := client.DeleteCertificatePack(ctx, zoneID, d.Id())
 	if err != nil {
-		return errors.Wrap(err, "failed to delete certificate pack")
+		return diag.FromErr(errors.Wrap(err, "failed to delete certificate pack"))
 	}
 
-	resourceCloudflareCertificatePackRead(d, meta)
+	resourceCloudflareCertificatePackRead(ctx, d, meta)
 
 	return nil
 }
 
-func resourceCloudflareCertificatePackImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareCertificatePackImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 2)
 
 	if len(attributes) != 2 {
@@ -156,7 +157,7 @@ func resourceCloudflareCertificatePackImport(d *schema.ResourceData, meta interf
 	d.Set("zone_id", zoneID)
 	d.SetId(certificatePackID)
 
-	resourceCloudflareCertificatePackRead(d, meta)
+	resourceCloudflareCertificatePackRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
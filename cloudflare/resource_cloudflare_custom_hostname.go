@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -15,12 +17,12 @@ import (
 
 func resourceCloudflareCustomHostname() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareCustomHostnameCreate,
-		Read:   resourceCloudflareCustomHostnameRead,
-		Update: resourceCloudflareCustomHostnameUpdate,
-		Delete: resourceCloudflareCustomHostnameDelete,
+		CreateContext: resourceCloudflareCustomHostnameCreate,
+		ReadContext:   resourceCloudflareCustomHostnameRead,
+		UpdateContext: resourceCloudflareCustomHostnameUpdate,
+		DeleteContext: resourceCloudflareCustomHostnameDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareCustomHostnameImport,
+			StateContext: resourceCloudflareCustomHostnameImport,
 		},
 
 		SchemaVersion: 0,
@@ -150,14 +152,14 @@ func resourceCloudflareCustomHostname() *schema.Resource {
 	}
 }
 
-func resourceCloudflareCustomHostnameRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomHostnameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	hostnameID := d.Id()
 
-	customHostname, err := client.CustomHostname(context.Background(), zoneID, hostnameID)
+	customHostname, err := client.CustomHostname(ctx, zoneID, hostnameID)
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error reading custom hostname %q", hostnameID))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading custom hostname %q", hostnameID)))
 	}
 
 	d.Set("hostname", customHostname.Hostname)
@@ -185,7 +187,7 @@ func resourceCloudflareCustomHostnameRead(d *schema.ResourceData, meta interface
 	}
 
 	if err := d.Set("ssl", sslConfig); err != nil {
-		return fmt.Errorf("failed to see ssl")
+		return attributeErrorDiagnostic(cty.GetAttrPath("ssl"), fmt.Errorf("failed to see ssl"))
 	}
 
 	ownershipVerificationCfg := map[string]interface{}{
@@ -194,7 +196,7 @@ func resourceCloudflareCustomHostnameRead(d *schema.ResourceData, meta interface
 		"name":  customHostname.OwnershipVerification.Name,
 	}
 	if err := d.Set("ownership_verification", ownershipVerificationCfg); err != nil {
-		return fmt.Errorf("failed to set ownership_verification: %v", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("ownership_verification"), fmt.Errorf("failed to set ownership_verification: %v", err))
 	}
 
 	ownershipVerificationHTTPCfg := map[string]interface{}{
@@ -202,56 +204,56 @@ func resourceCloudflareCustomHostnameRead(d *schema.ResourceData, meta interface
 		"http_url":  customHostname.OwnershipVerificationHTTP.HTTPUrl,
 	}
 	if err := d.Set("ownership_verification_http", ownershipVerificationHTTPCfg); err != nil {
-		return fmt.Errorf("failed to set ownership_verification_http: %v", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("ownership_verification_http"), fmt.Errorf("failed to set ownership_verification_http: %v", err))
 	}
 
 	return nil
 }
 
-func resourceCloudflareCustomHostnameDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomHostnameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	hostnameID := d.Id()
 
-	err := client.DeleteCustomHostname(context.Background(), zoneID, hostnameID)
+	err := client.DeleteCustomHostname(ctx, zoneID, hostnameID)
 	if err != nil {
-		return errors.Wrap(err, "failed to delete custom hostname certificate")
+		return diag.FromErr(errors.Wrap(err, "failed to delete custom hostname certificate"))
 	}
 
 	return nil
 }
 
-func resourceCloudflareCustomHostnameCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomHostnameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	certificate := buildCustomHostname(d)
 
-	newCertificate, err := client.CreateCustomHostname(context.Background(), zoneID, certificate)
+	newCertificate, err := client.CreateCustomHostname(ctx, zoneID, certificate)
 	if err != nil {
-		return errors.Wrap(err, "failed to create custom hostname certificate")
+		return diag.FromErr(errors.Wrap(err, "failed to create custom hostname certificate"))
 	}
 
 	d.SetId(newCertificate.Result.ID)
 
-	return resourceCloudflareCustomHostnameRead(d, meta)
+	return resourceCloudflareCustomHostnameRead(ctx, d, meta)
 }
 
-func resourceCloudflareCustomHostnameUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomHostnameUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	hostnameID := d.Id()
 	certificate := buildCustomHostname(d)
 
-	_, err := client.UpdateCustomHostname(context.Background(), zoneID, hostnameID, certificate)
+	_, err := client.UpdateCustomHostname(ctx, zoneID, hostnameID, certificate)
 	if err != nil {
-		return errors.Wrap(err, "failed to update custom hostname certificate")
+		return diag.FromErr(errors.Wrap(err, "failed to update custom hostname certificate"))
 	}
 
-	return resourceCloudflareCustomHostnameRead(d, meta)
+	return resourceCloudflareCustomHostnameRead(ctx, d, meta)
 }
 
-func resourceCloudflareCustomHostnameImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareCustomHostnameImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idAttr := strings.SplitN(d.Id(), "/", 2)
 
 	if len(idAttr) != 2 {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -14,12 +15,12 @@ import (
 
 func resourceCloudflareCustomHostnameFallbackOrigin() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareCustomHostnameFallbackOriginCreate,
-		Read:   resourceCloudflareCustomHostnameFallbackOriginRead,
-		Update: resourceCloudflareCustomHostnameFallbackOriginUpdate,
-		Delete: resourceCloudflareCustomHostnameFallbackOriginDelete,
+		CreateContext: resourceCloudflareCustomHostnameFallbackOriginCreate,
+		ReadContext:   resourceCloudflareCustomHostnameFallbackOriginRead,
+		UpdateContext: resourceCloudflareCustomHostnameFallbackOriginUpdate,
+		DeleteContext: resourceCloudflareCustomHostnameFallbackOriginDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareCustomHostnameFallbackOriginImport,
+			StateContext: resourceCloudflareCustomHostnameFallbackOriginImport,
 		},
 
 		SchemaVersion: 0,
@@ -41,13 +42,13 @@ func resourceCloudflareCustomHostnameFallbackOrigin() *schema.Resource {
 	}
 }
 
-func resourceCloudflareCustomHostnameFallbackOriginRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomHostnameFallbackOriginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
-	customHostnameFallbackOrigin, err := client.CustomHostnameFallbackOrigin(context.Background(), zoneID)
+	customHostnameFallbackOrigin, err := client.CustomHostnameFallbackOrigin(ctx, zoneID)
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error reading custom hostname fallback origin %q", zoneID))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading custom hostname fallback origin %q", zoneID)))
 	}
 
 	d.Set("origin", customHostnameFallbackOrigin.Origin)
@@ -56,20 +57,20 @@ func resourceCloudflareCustomHostnameFallbackOriginRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceCloudflareCustomHostnameFallbackOriginDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomHostnameFallbackOriginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
-	err := client.DeleteCustomHostnameFallbackOrigin(context.Background(), zoneID)
+	err := client.DeleteCustomHostnameFallbackOrigin(ctx, zoneID)
 	if err != nil {
-		return errors.Wrap(err, "failed to delete custom hostname fallback origin")
+		return diag.FromErr(errors.Wrap(err, "failed to delete custom hostname fallback origin"))
 	}
 
 	return nil
 }
 
-func resourceCloudflareCustomHostnameFallbackOriginCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomHostnameFallbackOriginCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	origin := d.Get("origin").(string)
 
@@ -77,8 +78,8 @@ func resourceCloudflareCustomHostnameFallbackOriginCreate(d *schema.ResourceData
 		Origin: origin,
 	}
 
-	return resource.Retry(d.Timeout(schema.TimeoutDefault), func() *resource.RetryError {
-		_, err := client.UpdateCustomHostnameFallbackOrigin(context.Background(), zoneID, fallbackOrigin)
+	return diag.FromErr(resource.RetryContext(ctx, d.Timeout(schema.TimeoutDefault), func() *resource.RetryError {
+		_, err := client.UpdateCustomHostnameFallbackOrigin(ctx, zoneID, fallbackOrigin)
 		if err != nil {
 			if err.(*cloudflare.APIRequestError).InternalErrorCodeIs(1414) {
 				return resource.RetryableError(fmt.Errorf("expected custom hostname resource to be ready for modification but is still pending"))
@@ -86,7 +87,7 @@ func resourceCloudflareCustomHostnameFallbackOriginCreate(d *schema.ResourceData
 			return resource.NonRetryableError(errors.Wrap(err, "failed to create custom hostname fallback origin"))
 		}
 
-		fallbackHostname, err := client.CustomHostnameFallbackOrigin(context.Background(), zoneID)
+		fallbackHostname, err := client.CustomHostnameFallbackOrigin(ctx, zoneID)
 
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("failed to fetch custom hostname: %s", err))
@@ -102,14 +103,14 @@ func resourceCloudflareCustomHostnameFallbackOriginCreate(d *schema.ResourceData
 		id := stringChecksum(fmt.Sprintf("%s/custom_hostnames_fallback_origin", zoneID))
 		d.SetId(id)
 
-		resourceCloudflareCustomHostnameFallbackOriginRead(d, meta)
+		resourceCloudflareCustomHostnameFallbackOriginRead(ctx, d, meta)
 		return nil
-	})
+	}))
 
 }
 
-func resourceCloudflareCustomHostnameFallbackOriginUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomHostnameFallbackOriginUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	origin := d.Get("origin").(string)
 
@@ -117,8 +118,8 @@ func resourceCloudflareCustomHostnameFallbackOriginUpdate(d *schema.ResourceData
 		Origin: origin,
 	}
 
-	return resource.Retry(d.Timeout(schema.TimeoutDefault), func() *resource.RetryError {
-		_, err := client.UpdateCustomHostnameFallbackOrigin(context.Background(), zoneID, fallbackOrigin)
+	return diag.FromErr(resource.RetryContext(ctx, d.Timeout(schema.TimeoutDefault), func() *resource.RetryError {
+		_, err := client.UpdateCustomHostnameFallbackOrigin(ctx, zoneID, fallbackOrigin)
 		if err != nil {
 			if err.(*cloudflare.APIRequestError).InternalErrorCodeIs(1414) {
 				return resource.RetryableError(fmt.Errorf("expected custom hostname resource to be ready for modification but is still pending"))
@@ -126,12 +127,12 @@ func resourceCloudflareCustomHostnameFallbackOriginUpdate(d *schema.ResourceData
 			return resource.NonRetryableError(errors.Wrap(err, "failed to update custom hostname fallback origin"))
 		}
 
-		resourceCloudflareCustomHostnameFallbackOriginRead(d, meta)
+		resourceCloudflareCustomHostnameFallbackOriginRead(ctx, d, meta)
 		return nil
-	})
+	}))
 }
 
-func resourceCloudflareCustomHostnameFallbackOriginImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareCustomHostnameFallbackOriginImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idAttr := strings.SplitN(d.Id(), "/", 2)
 
 	if len(idAttr) != 2 {
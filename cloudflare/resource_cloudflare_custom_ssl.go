@@ -8,6 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -18,12 +20,12 @@ import (
 
 func resourceCloudflareCustomSsl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareCustomSslCreate,
-		Read:   resourceCloudflareCustomSslRead,
-		Update: resourceCloudflareCustomSslUpdate,
-		Delete: resourceCloudflareCustomSslDelete,
+		CreateContext: resourceCloudflareCustomSslCreate,
+		ReadContext:   resourceCloudflareCustomSslRead,
+		UpdateContext: resourceCloudflareCustomSslUpdate,
+		DeleteContext: resourceCloudflareCustomSslDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareCustomSslImport,
+			StateContext: resourceCloudflareCustomSslImport,
 		},
 
 		SchemaVersion: 1,
@@ -130,26 +132,26 @@ func resourceCloudflareCustomSsl() *schema.Resource {
 	}
 }
 
-func resourceCloudflareCustomSslCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomSslCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	log.Printf("[DEBUG] zone ID: %s", zoneID)
 	zcso, err := expandToZoneCustomSSLOptions(d)
 	if err != nil {
-		return fmt.Errorf("failed to create custom ssl cert: %s", err)
+		return diag.Errorf("failed to create custom ssl cert: %s", err)
 	}
 
-	res, err := client.CreateSSL(context.Background(), zoneID, zcso)
+	res, err := client.CreateSSL(ctx, zoneID, zcso)
 	if err != nil {
-		return fmt.Errorf("failed to create custom ssl cert: %s", err)
+		return diag.Errorf("failed to create custom ssl cert: %s", err)
 	}
 
 	if res.ID == "" {
-		return fmt.Errorf("failed to find custom ssl in Create response: id was empty")
+		return diag.Errorf("failed to find custom ssl in Create response: id was empty")
 	}
 
-	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		cert, err := client.SSLDetails(context.Background(), zoneID, res.ID)
+	return diag.FromErr(resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		cert, err := client.SSLDetails(ctx, zoneID, res.ID)
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("failed to fetch custom ssl cert: %s", err))
 		}
@@ -160,13 +162,13 @@ func resourceCloudflareCustomSslCreate(d *schema.ResourceData, meta interface{})
 
 		d.SetId(res.ID)
 
-		resourceCloudflareCustomSslRead(d, meta)
+		resourceCloudflareCustomSslRead(ctx, d, meta)
 		return nil
-	})
+	}))
 }
 
-func resourceCloudflareCustomSslUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomSslUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	certID := d.Id()
 	var uErr error
@@ -178,10 +180,10 @@ func resourceCloudflareCustomSslUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("custom_ssl_options") {
 		zcso, err := expandToZoneCustomSSLOptions(d)
 		if err != nil {
-			return fmt.Errorf("failed to update custom ssl cert: %s", err)
+			return diag.Errorf("failed to update custom ssl cert: %s", err)
 		}
 
-		res, uErr := client.UpdateSSL(context.Background(), zoneID, certID, zcso)
+		res, uErr := client.UpdateSSL(ctx, zoneID, certID, zcso)
 		if uErr != nil {
 			log.Printf("[DEBUG] Failed to update custom ssl cert: %s", uErr)
 			updateErr = true
@@ -197,7 +199,7 @@ func resourceCloudflareCustomSslUpdate(d *schema.ResourceData, meta interface{})
 			log.Printf("Failed to update custom ssl cert: %s", err)
 		}
 
-		resList, reErr := client.ReprioritizeSSL(context.Background(), zoneID, zcsp)
+		resList, reErr := client.ReprioritizeSSL(ctx, zoneID, zcsp)
 		if err != nil {
 			log.Printf("Failed to update / reprioritize custom ssl cert: %s", reErr)
 			reprioritizeErr = true
@@ -207,19 +209,19 @@ func resourceCloudflareCustomSslUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if updateErr && reprioritizeErr {
-		return fmt.Errorf("failed to update and reprioritize custom ssl cert: %s, %s", uErr, reErr)
+		return diag.Errorf("failed to update and reprioritize custom ssl cert: %s, %s", uErr, reErr)
 	}
 
-	return resourceCloudflareCustomSslRead(d, meta)
+	return resourceCloudflareCustomSslRead(ctx, d, meta)
 }
 
-func resourceCloudflareCustomSslRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomSslRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	certID := d.Id()
 
 	// update all possible schema attributes with fields from api response
-	record, err := client.SSLDetails(context.Background(), zoneID, certID)
+	record, err := client.SSLDetails(ctx, zoneID, certID)
 	if err != nil {
 		log.Printf("[WARN] Removing record from state because it's not found in API")
 		d.SetId("")
@@ -237,7 +239,7 @@ func resourceCloudflareCustomSslRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("issuer", record.Issuer)
 	d.Set("signature", record.Signature)
 	if err := d.Set("custom_ssl_options", []interface{}{customSslOpts}); err != nil {
-		return fmt.Errorf("[WARN] Error reading custom ssl opts %q: %s", d.Id(), err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("custom_ssl_options"), fmt.Errorf("[WARN] Error reading custom ssl opts %q: %s", d.Id(), err))
 	}
 	d.Set("status", record.Status)
 	d.Set("uploaded_on", record.UploadedOn.Format(time.RFC3339Nano))
@@ -247,21 +249,21 @@ func resourceCloudflareCustomSslRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceCloudflareCustomSslDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareCustomSslDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	certID := d.Id()
 
 	log.Printf("[DEBUG] Deleting SSL cert %s for zone %s", certID, zoneID)
 
-	err := client.DeleteSSL(context.Background(), zoneID, certID)
+	err := client.DeleteSSL(ctx, zoneID, certID)
 	if err != nil {
 		errors.Wrap(err, "failed to delete custom ssl cert setting")
 	}
 	return nil
 }
 
-func resourceCloudflareCustomSslImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareCustomSslImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
 	if len(idAttr) != 2 {
@@ -275,7 +277,7 @@ func resourceCloudflareCustomSslImport(d *schema.ResourceData, meta interface{})
 	d.Set("zone_id", zoneID)
 	d.SetId(certID)
 
-	resourceCloudflareCustomSslRead(d, meta)
+	resourceCloudflareCustomSslRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
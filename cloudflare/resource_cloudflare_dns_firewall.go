@@ -0,0 +1,220 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+func resourceCloudflareDNSFirewall() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareDNSFirewallCreate,
+		ReadContext:   resourceCloudflareDNSFirewallRead,
+		UpdateContext: resourceCloudflareDNSFirewallUpdate,
+		DeleteContext: resourceCloudflareDNSFirewallDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareDNSFirewallImport,
+		},
+		CustomizeDiff: resourceCloudflareDNSFirewallDiff,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"upstream_ips": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+			"minimum_cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(30, 36000),
+			},
+			"maximum_cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      900,
+				ValidateFunc: validation.IntBetween(30, 36000),
+			},
+			"deprecate_any_requests": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"ecs_fallback": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"ratelimit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(100, 1000000000),
+			},
+			"origin_direct": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"dns_firewall_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"modified_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareDNSFirewallCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	log.Printf("[INFO] Creating DNS Firewall cluster %s", d.Get("name").(string))
+
+	cluster, err := createDNSFirewallCluster(ctx, client, accountID, dnsFirewallClusterFromResource(d))
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating DNS Firewall cluster %q", d.Get("name").(string))))
+	}
+
+	d.SetId(cluster.ID)
+
+	return resourceCloudflareDNSFirewallRead(ctx, d, meta)
+}
+
+func resourceCloudflareDNSFirewallRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	cluster, err := fetchDNSFirewallCluster(ctx, client, accountID, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] DNS Firewall cluster %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading DNS Firewall cluster %q", d.Id())))
+	}
+
+	d.Set("name", cluster.Name)
+	d.Set("minimum_cache_ttl", cluster.MinimumCacheTTL)
+	d.Set("maximum_cache_ttl", cluster.MaximumCacheTTL)
+	d.Set("deprecate_any_requests", cluster.DeprecateAnyRequests)
+	d.Set("ecs_fallback", cluster.ECSFallback)
+	d.Set("origin_direct", cluster.OriginDirect)
+	d.Set("modified_on", cluster.ModifiedOn)
+
+	if cluster.Ratelimit != nil {
+		d.Set("ratelimit", *cluster.Ratelimit)
+	} else {
+		d.Set("ratelimit", nil)
+	}
+
+	if err := d.Set("upstream_ips", cluster.UpstreamIPs); err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("upstream_ips"), fmt.Errorf("error setting upstream_ips: %s", err))
+	}
+
+	if err := d.Set("dns_firewall_ips", cluster.DNSFirewallIPs); err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("dns_firewall_ips"), fmt.Errorf("error setting dns_firewall_ips: %s", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareDNSFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	cluster := dnsFirewallClusterFromResource(d)
+	cluster.ID = d.Id()
+
+	if err := updateDNSFirewallCluster(ctx, client, accountID, cluster); err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating DNS Firewall cluster %q", d.Id())))
+	}
+
+	return resourceCloudflareDNSFirewallRead(ctx, d, meta)
+}
+
+func resourceCloudflareDNSFirewallDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	log.Printf("[INFO] Deleting DNS Firewall cluster %s", d.Id())
+
+	if err := deleteDNSFirewallCluster(ctx, client, accountID, d.Id()); err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error deleting DNS Firewall cluster %q", d.Id())))
+	}
+
+	return nil
+}
+
+func resourceCloudflareDNSFirewallImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/clusterID\"", d.Id())
+	}
+
+	accountID, clusterID := attributes[0], attributes[1]
+	d.SetId(clusterID)
+	d.Set("account_id", accountID)
+
+	if err := diagnosticsError(resourceCloudflareDNSFirewallRead(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareDNSFirewallDiff rejects a minimum cache TTL greater than
+// the maximum one at plan time rather than when the cluster is created.
+func resourceCloudflareDNSFirewallDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("minimum_cache_ttl") || !d.NewValueKnown("maximum_cache_ttl") {
+		return nil
+	}
+
+	if d.Get("minimum_cache_ttl").(int) > d.Get("maximum_cache_ttl").(int) {
+		return fmt.Errorf("minimum_cache_ttl must not be greater than maximum_cache_ttl")
+	}
+
+	return nil
+}
+
+func dnsFirewallClusterFromResource(d *schema.ResourceData) dnsFirewallCluster {
+	cluster := dnsFirewallCluster{
+		Name:                 d.Get("name").(string),
+		UpstreamIPs:          expandInterfaceToStringList(d.Get("upstream_ips").(*schema.Set).List()),
+		MinimumCacheTTL:      d.Get("minimum_cache_ttl").(int),
+		MaximumCacheTTL:      d.Get("maximum_cache_ttl").(int),
+		DeprecateAnyRequests: d.Get("deprecate_any_requests").(bool),
+		ECSFallback:          d.Get("ecs_fallback").(bool),
+		OriginDirect:         d.Get("origin_direct").(bool),
+	}
+
+	if ratelimit, ok := d.GetOk("ratelimit"); ok {
+		r := ratelimit.(int)
+		cluster.Ratelimit = &r
+	}
+
+	return cluster
+}
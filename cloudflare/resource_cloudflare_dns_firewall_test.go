@@ -0,0 +1,163 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareDNSFirewallExists(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_dns_firewall.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	var cluster dnsFirewallCluster
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareDNSFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareDNSFirewallSimple(rnd, rnd, accountID, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareDNSFirewallExists(name, &cluster),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "upstream_ips.#", "2"),
+					resource.TestCheckResourceAttr(name, "minimum_cache_ttl", "60"),
+					resource.TestCheckResourceAttr(name, "maximum_cache_ttl", "900"),
+					resource.TestCheckResourceAttr(name, "deprecate_any_requests", "true"),
+					resource.TestCheckResourceAttr(name, "ecs_fallback", "false"),
+					resource.TestCheckResourceAttr(name, "ratelimit", "600"),
+					resource.TestCheckResourceAttrSet(name, "dns_firewall_ips.0"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareDNSFirewallUpdate(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_dns_firewall.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	var cluster dnsFirewallCluster
+	var initialID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareDNSFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareDNSFirewallSimple(rnd, rnd, accountID, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareDNSFirewallExists(name, &cluster),
+					resource.TestCheckResourceAttr(name, "minimum_cache_ttl", "60"),
+				),
+			},
+			{
+				PreConfig: func() {
+					initialID = cluster.ID
+				},
+				Config: testAccCheckCloudflareDNSFirewallSimple(rnd, rnd+"-updated", accountID, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareDNSFirewallExists(name, &cluster),
+					func(state *terraform.State) error {
+						if initialID != cluster.ID {
+							return fmt.Errorf("wanted update but DNS Firewall cluster got recreated (id changed %q -> %q)", initialID, cluster.ID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(name, "name", rnd+"-updated"),
+					resource.TestCheckResourceAttr(name, "minimum_cache_ttl", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudflareDNSFirewallCacheTTLDiff(t *testing.T) {
+	config := func(minimumCacheTTL int) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"account_id":        "f037e56e89293a057740de681ac9abbe",
+			"name":              "example",
+			"upstream_ips":      []interface{}{"192.0.2.1"},
+			"minimum_cache_ttl": minimumCacheTTL,
+			"maximum_cache_ttl": 900,
+		})
+	}
+
+	r := resourceCloudflareDNSFirewall()
+
+	if _, err := r.Diff(context.Background(), nil, config(60), nil); err != nil {
+		t.Errorf("expected a minimum cache TTL below the maximum to be accepted, got %s", err)
+	}
+
+	if _, err := r.Diff(context.Background(), nil, config(3600), nil); err == nil {
+		t.Error("expected a minimum cache TTL above the maximum to be rejected")
+	}
+}
+
+func testAccCheckCloudflareDNSFirewallSimple(ID, name, accountID string, minimumCacheTTL int) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_dns_firewall" "%[1]s" {
+	account_id = "%[3]s"
+	name = "%[2]s"
+	upstream_ips = ["192.0.2.1", "198.51.100.1"]
+	minimum_cache_ttl = %[4]d
+	maximum_cache_ttl = 900
+	deprecate_any_requests = true
+	ratelimit = 600
+  }`, ID, name, accountID, minimumCacheTTL)
+}
+
+func testAccCheckCloudflareDNSFirewallExists(n string, cluster *dnsFirewallCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DNS Firewall cluster is set")
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+		foundCluster, err := fetchDNSFirewallCluster(context.Background(), client, rs.Primary.Attributes["account_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*cluster = foundCluster
+
+		return nil
+	}
+}
+
+func testAccCheckCloudflareDNSFirewallDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_dns_firewall" {
+			continue
+		}
+
+		_, err := fetchDNSFirewallCluster(context.Background(), client, rs.Primary.Attributes["account_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("DNS Firewall cluster %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -8,18 +8,19 @@ import (
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCloudflareFirewallRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareFirewallRuleCreate,
-		Read:   resourceCloudflareFirewallRuleRead,
-		Update: resourceCloudflareFirewallRuleUpdate,
-		Delete: resourceCloudflareFirewallRuleDelete,
+		CreateContext: resourceCloudflareFirewallRuleCreate,
+		ReadContext:   resourceCloudflareFirewallRuleRead,
+		UpdateContext: resourceCloudflareFirewallRuleUpdate,
+		DeleteContext: resourceCloudflareFirewallRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareFirewallRuleImport,
+			StateContext: resourceCloudflareFirewallRuleImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -69,8 +70,8 @@ func resourceCloudflareFirewallRule() *schema.Resource {
 	}
 }
 
-func resourceCloudflareFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	var err error
@@ -107,28 +108,28 @@ func resourceCloudflareFirewallRuleCreate(d *schema.ResourceData, meta interface
 
 	var r []cloudflare.FirewallRule
 
-	r, err = client.CreateFirewallRules(context.Background(), zoneID, []cloudflare.FirewallRule{newFirewallRule})
+	r, err = client.CreateFirewallRules(ctx, zoneID, []cloudflare.FirewallRule{newFirewallRule})
 
 	if err != nil {
-		return fmt.Errorf("error creating Firewall Rule for zone %q: %s", zoneID, err)
+		return diag.Errorf("error creating Firewall Rule for zone %q: %s", zoneID, err)
 	}
 
 	if len(r) == 0 {
-		return fmt.Errorf("failed to find id in Create response; resource was empty")
+		return diag.Errorf("failed to find id in Create response; resource was empty")
 	}
 
 	d.SetId(r[0].ID)
 
 	log.Printf("[INFO] Cloudflare Firewall Rule ID: %s", d.Id())
 
-	return resourceCloudflareFirewallRuleRead(d, meta)
+	return resourceCloudflareFirewallRuleRead(ctx, d, meta)
 }
 
-func resourceCloudflareFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
-	firewallRule, err := client.FirewallRule(context.Background(), zoneID, d.Id())
+	firewallRule, err := client.FirewallRule(ctx, zoneID, d.Id())
 
 	log.Printf("[DEBUG] firewallRule: %#v", firewallRule)
 	log.Printf("[DEBUG] firewallRule error: %#v", err)
@@ -139,7 +140,7 @@ func resourceCloudflareFirewallRuleRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding Firewall Rule %q: %s", d.Id(), err)
+		return diag.Errorf("error finding Firewall Rule %q: %s", d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] Cloudflare Firewall Rule read configuration: %#v", firewallRule)
@@ -155,8 +156,8 @@ func resourceCloudflareFirewallRuleRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceCloudflareFirewallRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	var newFirewallRule cloudflare.FirewallRule
@@ -190,35 +191,35 @@ func resourceCloudflareFirewallRuleUpdate(d *schema.ResourceData, meta interface
 
 	log.Printf("[DEBUG] Updating Cloudflare Firewall Rule from struct: %+v", newFirewallRule)
 
-	r, err := client.UpdateFirewallRule(context.Background(), zoneID, newFirewallRule)
+	r, err := client.UpdateFirewallRule(ctx, zoneID, newFirewallRule)
 
 	if err != nil {
-		return fmt.Errorf("error updating Firewall Rule for zone %q: %s", zoneID, err)
+		return diag.Errorf("error updating Firewall Rule for zone %q: %s", zoneID, err)
 	}
 
 	if r.ID == "" {
-		return fmt.Errorf("failed to find id in Update response; resource was empty")
+		return diag.Errorf("failed to find id in Update response; resource was empty")
 	}
 
-	return resourceCloudflareFirewallRuleRead(d, meta)
+	return resourceCloudflareFirewallRuleRead(ctx, d, meta)
 }
 
-func resourceCloudflareFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Firewall Rule: id %s for zone %s", d.Id(), zoneID)
 
-	err := client.DeleteFirewallRule(context.Background(), zoneID, d.Id())
+	err := client.DeleteFirewallRule(ctx, zoneID, d.Id())
 
 	if err != nil {
-		return fmt.Errorf("error deleting Cloudflare Firewall Rule: %s", err)
+		return diag.Errorf("error deleting Cloudflare Firewall Rule: %s", err)
 	}
 
 	return nil
 }
 
-func resourceCloudflareFirewallRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareFirewallRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
 
@@ -233,7 +234,7 @@ func resourceCloudflareFirewallRuleImport(d *schema.ResourceData, meta interface
 	d.Set("zone_id", zoneID)
 	d.SetId(ruleID)
 
-	resourceCloudflareFirewallRuleRead(d, meta)
+	resourceCloudflareFirewallRuleRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
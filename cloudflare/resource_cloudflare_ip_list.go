@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -15,12 +16,12 @@ import (
 
 func resourceCloudflareIPList() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareIPListCreate,
-		Read:   resourceCloudflareIPListRead,
-		Update: resourceCloudflareIPListUpdate,
-		Delete: resourceCloudflareIPListDelete,
+		CreateContext: resourceCloudflareIPListCreate,
+		ReadContext:   resourceCloudflareIPListRead,
+		UpdateContext: resourceCloudflareIPListUpdate,
+		DeleteContext: resourceCloudflareIPListDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareIPListImport,
+			StateContext: resourceCloudflareIPListImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,29 +66,29 @@ var listItemElem = &schema.Resource{
 	},
 }
 
-func resourceCloudflareIPListCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareIPListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	client.AccountID = d.Get("account_id").(string)
 
-	list, err := client.CreateIPList(context.Background(), d.Get("name").(string), d.Get("description").(string), d.Get("kind").(string))
+	list, err := client.CreateIPList(ctx, d.Get("name").(string), d.Get("description").(string), d.Get("kind").(string))
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error creating IP List %s", d.Get("name").(string)))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating IP List %s", d.Get("name").(string))))
 	}
 
 	d.SetId(list.ID)
 
 	if items, ok := d.GetOk("item"); ok {
 		IPListItems := buildIPListItemsCreateRequest(items.(*schema.Set).List())
-		_, err = client.CreateIPListItems(context.Background(), d.Id(), IPListItems)
+		_, err = client.CreateIPListItems(ctx, d.Id(), IPListItems)
 		if err != nil {
-			return errors.Wrap(err, fmt.Sprintf("error creating IP List Items"))
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating IP List Items")))
 		}
 	}
 
-	return resourceCloudflareIPListRead(d, meta)
+	return resourceCloudflareIPListRead(ctx, d, meta)
 }
 
-func resourceCloudflareIPListImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareIPListImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 2)
 
 	if len(attributes) != 2 {
@@ -98,32 +99,32 @@ func resourceCloudflareIPListImport(d *schema.ResourceData, meta interface{}) ([
 	d.SetId(listID)
 	d.Set("account_id", accountID)
 
-	resourceCloudflareIPListRead(d, meta)
+	resourceCloudflareIPListRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceCloudflareIPListRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareIPListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	client.AccountID = d.Get("account_id").(string)
 
-	list, err := client.GetIPList(context.Background(), d.Id())
+	list, err := client.GetIPList(ctx, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "could not find list") {
 			log.Printf("[INFO] IP List %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return errors.Wrap(err, fmt.Sprintf("error reading IP List with ID %q", d.Id()))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading IP List with ID %q", d.Id())))
 	}
 
 	d.Set("name", list.Name)
 	d.Set("description", list.Description)
 	d.Set("kind", list.Kind)
 
-	items, err := client.ListIPListItems(context.Background(), d.Id())
+	items, err := client.ListIPListItems(ctx, d.Id())
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error reading IP List Items"))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading IP List Items")))
 	}
 
 	var itemData []map[string]interface{}
@@ -142,33 +143,33 @@ func resourceCloudflareIPListRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceCloudflareIPListUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareIPListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	client.AccountID = d.Get("account_id").(string)
 
-	_, err := client.UpdateIPList(context.Background(), d.Id(), d.Get("description").(string))
+	_, err := client.UpdateIPList(ctx, d.Id(), d.Get("description").(string))
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error updating IP List description"))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating IP List description")))
 	}
 
 	if items, ok := d.GetOk("item"); ok {
 		IPListItems := buildIPListItemsCreateRequest(items.(*schema.Set).List())
-		_, err = client.ReplaceIPListItems(context.Background(), d.Id(), IPListItems)
+		_, err = client.ReplaceIPListItems(ctx, d.Id(), IPListItems)
 		if err != nil {
-			return errors.Wrap(err, fmt.Sprintf("error creating IP List Items"))
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating IP List Items")))
 		}
 	}
 
-	return resourceCloudflareIPListRead(d, meta)
+	return resourceCloudflareIPListRead(ctx, d, meta)
 }
 
-func resourceCloudflareIPListDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareIPListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	client.AccountID = d.Get("account_id").(string)
 
-	_, err := client.DeleteIPList(context.Background(), d.Id())
+	_, err := client.DeleteIPList(ctx, d.Id())
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error deleting IP List with ID %q", d.Id()))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error deleting IP List with ID %q", d.Id())))
 	}
 
 	return nil
@@ -152,7 +152,7 @@ func testAccCheckCloudflareIPListExists(n string, list *cloudflare.IPList) resou
 			return fmt.Errorf("No IP List ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		foundIPList, err := client.GetIPList(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
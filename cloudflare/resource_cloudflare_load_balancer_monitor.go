@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -16,12 +17,12 @@ import (
 
 func resourceCloudflareLoadBalancerMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareLoadBalancerPoolMonitorCreate,
-		Read:   resourceCloudflareLoadBalancerPoolMonitorRead,
-		Update: resourceCloudflareLoadBalancerPoolMonitorUpdate,
-		Delete: resourceCloudflareLoadBalancerPoolMonitorDelete,
+		CreateContext: resourceCloudflareLoadBalancerPoolMonitorCreate,
+		ReadContext:   resourceCloudflareLoadBalancerPoolMonitorRead,
+		UpdateContext: resourceCloudflareLoadBalancerPoolMonitorUpdate,
+		DeleteContext: resourceCloudflareLoadBalancerPoolMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 0,
@@ -143,8 +144,8 @@ func resourceCloudflareLoadBalancerMonitor() *schema.Resource {
 	}
 }
 
-func resourceCloudflareLoadBalancerPoolMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLoadBalancerPoolMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	loadBalancerMonitor := cloudflare.LoadBalancerMonitor{
 		Timeout:  d.Get("timeout").(int),
@@ -179,7 +180,7 @@ func resourceCloudflareLoadBalancerPoolMonitorCreate(d *schema.ResourceData, met
 		if expectedCodes, ok := d.GetOk("expected_codes"); ok {
 			loadBalancerMonitor.ExpectedCodes = expectedCodes.(string)
 		} else {
-			return fmt.Errorf("expected_codes must be set")
+			return diag.Errorf("expected_codes must be set")
 		}
 
 		if followRedirects, ok := d.GetOk("follow_redirects"); ok {
@@ -211,24 +212,24 @@ func resourceCloudflareLoadBalancerPoolMonitorCreate(d *schema.ResourceData, met
 
 	log.Printf("[DEBUG] Creating Cloudflare Load Balancer Monitor from struct: %+v", loadBalancerMonitor)
 
-	r, err := client.CreateLoadBalancerMonitor(context.Background(), loadBalancerMonitor)
+	r, err := client.CreateLoadBalancerMonitor(ctx, loadBalancerMonitor)
 	if err != nil {
-		return errors.Wrap(err, "error creating load balancer monitor")
+		return diag.FromErr(errors.Wrap(err, "error creating load balancer monitor"))
 	}
 
 	if r.ID == "" {
-		return fmt.Errorf("failed to find id in create response; resource was empty")
+		return diag.Errorf("failed to find id in create response; resource was empty")
 	}
 
 	d.SetId(r.ID)
 
 	log.Printf("[INFO] New Cloudflare Load Balancer Monitor created with  ID: %s", d.Id())
 
-	return resourceCloudflareLoadBalancerPoolMonitorRead(d, meta)
+	return resourceCloudflareLoadBalancerPoolMonitorRead(ctx, d, meta)
 }
 
-func resourceCloudflareLoadBalancerPoolMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLoadBalancerPoolMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	loadBalancerMonitor := cloudflare.LoadBalancerMonitor{
 		ID:       d.Id(),
@@ -267,7 +268,7 @@ func resourceCloudflareLoadBalancerPoolMonitorUpdate(d *schema.ResourceData, met
 		if expectedCodes, ok := d.GetOk("expected_codes"); ok {
 			loadBalancerMonitor.ExpectedCodes = expectedCodes.(string)
 		} else {
-			return fmt.Errorf("expected_codes must be set")
+			return diag.Errorf("expected_codes must be set")
 		}
 
 		if header, ok := d.GetOk("header"); ok {
@@ -299,14 +300,14 @@ func resourceCloudflareLoadBalancerPoolMonitorUpdate(d *schema.ResourceData, met
 
 	log.Printf("[DEBUG] Update Cloudflare Load Balancer Monitor from struct: %+v", loadBalancerMonitor)
 
-	_, err := client.ModifyLoadBalancerMonitor(context.Background(), loadBalancerMonitor)
+	_, err := client.ModifyLoadBalancerMonitor(ctx, loadBalancerMonitor)
 	if err != nil {
-		return errors.Wrap(err, "error modifying load balancer monitor")
+		return diag.FromErr(errors.Wrap(err, "error modifying load balancer monitor"))
 	}
 
 	log.Printf("[INFO] Cloudflare Load Balancer Monitor %q was modified", d.Id())
 
-	return resourceCloudflareLoadBalancerPoolMonitorRead(d, meta)
+	return resourceCloudflareLoadBalancerPoolMonitorRead(ctx, d, meta)
 }
 
 func expandLoadBalancerMonitorHeader(cfgSet interface{}) map[string][]string {
@@ -319,18 +320,18 @@ func expandLoadBalancerMonitorHeader(cfgSet interface{}) map[string][]string {
 	return header
 }
 
-func resourceCloudflareLoadBalancerPoolMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLoadBalancerPoolMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
-	loadBalancerMonitor, err := client.LoadBalancerMonitorDetails(context.Background(), d.Id())
+	loadBalancerMonitor, err := client.LoadBalancerMonitorDetails(ctx, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
 			log.Printf("[INFO] Load balancer monitor %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
 		} else {
-			return errors.Wrap(err,
-				fmt.Sprintf("Error reading load balancer monitor from API for resource %s ", d.Id()))
+			return diag.FromErr(errors.Wrap(err,
+				fmt.Sprintf("Error reading load balancer monitor from API for resource %s ", d.Id())))
 		}
 	}
 	log.Printf("[DEBUG] Read Cloudflare Load Balancer Monitor from API as struct: %+v", loadBalancerMonitor)
@@ -373,18 +374,18 @@ func flattenLoadBalancerMonitorHeader(header map[string][]string) *schema.Set {
 	return schema.NewSet(HashByMapKey("header"), flattened)
 }
 
-func resourceCloudflareLoadBalancerPoolMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLoadBalancerPoolMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	log.Printf("[INFO] Deleting Cloudflare Load Balancer Monitor: %s ", d.Id())
 
-	err := client.DeleteLoadBalancerMonitor(context.Background(), d.Id())
+	err := client.DeleteLoadBalancerMonitor(ctx, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
 			log.Printf("[INFO] Load balancer monitor %s no longer exists", d.Id())
 			return nil
 		} else {
-			return errors.Wrap(err, "error deleting cloudflare load balancer monitor")
+			return diag.FromErr(errors.Wrap(err, "error deleting cloudflare load balancer monitor"))
 		}
 	}
 
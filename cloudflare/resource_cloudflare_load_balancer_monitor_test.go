@@ -203,7 +203,7 @@ func TestAccCloudflareLoadBalancerMonitor_CreateAfterManualDestroy(t *testing.T)
 }
 
 func testAccCheckCloudflareLoadBalancerMonitorDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*providerClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_load_balancer_monitor" {
@@ -230,7 +230,7 @@ func testAccCheckCloudflareLoadBalancerMonitorExists(n string, load *cloudflare.
 			return fmt.Errorf("No Load Balancer Monitor ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		foundLoadBalancerMonitor, err := client.LoadBalancerMonitorDetails(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
@@ -270,7 +270,7 @@ func testAccCheckCloudflareLoadBalancerMonitorDates(n string, loadBalancerMonito
 
 func testAccManuallyDeleteLoadBalancerMonitor(name string, loadBalancerMonitor *cloudflare.LoadBalancerMonitor, initialId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		*initialId = loadBalancerMonitor.ID
 		err := client.DeleteLoadBalancerMonitor(context.Background(), loadBalancerMonitor.ID)
 		if err != nil {
@@ -12,6 +12,8 @@ import (
 	"regexp"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -19,12 +21,12 @@ import (
 
 func resourceCloudflareLoadBalancerPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareLoadBalancerPoolCreate,
-		Update: resourceCloudflareLoadBalancerPoolUpdate,
-		Read:   resourceCloudflareLoadBalancerPoolRead,
-		Delete: resourceCloudflareLoadBalancerPoolDelete,
+		CreateContext: resourceCloudflareLoadBalancerPoolCreate,
+		UpdateContext: resourceCloudflareLoadBalancerPoolUpdate,
+		ReadContext:   resourceCloudflareLoadBalancerPoolRead,
+		DeleteContext: resourceCloudflareLoadBalancerPoolDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 0,
@@ -103,6 +105,22 @@ func resourceCloudflareLoadBalancerPool() *schema.Resource {
 				Elem:     originSteeringElem,
 			},
 
+			"wait_for_healthy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "10m",
+							ValidateFunc: validateDuration,
+						},
+					},
+				},
+			},
+
 			"created_on": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -211,8 +229,12 @@ var originSteeringElem = &schema.Resource{
 	},
 }
 
-func resourceCloudflareLoadBalancerPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	if err := validateLoadBalancerPoolWaitForHealthy(d); err != nil {
+		return diag.FromErr(err)
+	}
 
 	loadBalancerPool := cloudflare.LoadBalancerPool{
 		Name:           d.Get("name").(string),
@@ -256,24 +278,32 @@ func resourceCloudflareLoadBalancerPoolCreate(d *schema.ResourceData, meta inter
 
 	log.Printf("[DEBUG] Creating Cloudflare Load Balancer Pool from struct: %+v", loadBalancerPool)
 
-	r, err := client.CreateLoadBalancerPool(context.Background(), loadBalancerPool)
+	r, err := client.CreateLoadBalancerPool(ctx, loadBalancerPool)
 	if err != nil {
-		return errors.Wrap(err, "error creating load balancer pool")
+		return diag.FromErr(errors.Wrap(err, "error creating load balancer pool"))
 	}
 
 	if r.ID == "" {
-		return fmt.Errorf("cailed to find id in create response; resource was empty")
+		return diag.Errorf("cailed to find id in create response; resource was empty")
 	}
 
 	d.SetId(r.ID)
 
 	log.Printf("[INFO] New Cloudflare Load Balancer Pool created with  ID: %s", d.Id())
 
-	return resourceCloudflareLoadBalancerPoolRead(d, meta)
+	if err := waitForLoadBalancerPoolHealthy(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareLoadBalancerPoolRead(ctx, d, meta)
 }
 
-func resourceCloudflareLoadBalancerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLoadBalancerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	if err := validateLoadBalancerPoolWaitForHealthy(d); err != nil {
+		return diag.FromErr(err)
+	}
 
 	loadBalancerPool := cloudflare.LoadBalancerPool{
 		ID:             d.Id(),
@@ -318,12 +348,70 @@ func resourceCloudflareLoadBalancerPoolUpdate(d *schema.ResourceData, meta inter
 
 	log.Printf("[DEBUG] Updating Cloudflare Load Balancer Pool from struct: %+v", loadBalancerPool)
 
-	_, err := client.ModifyLoadBalancerPool(context.Background(), loadBalancerPool)
+	_, err := client.ModifyLoadBalancerPool(ctx, loadBalancerPool)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error updating load balancer pool"))
+	}
+
+	if err := waitForLoadBalancerPoolHealthy(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareLoadBalancerPoolRead(ctx, d, meta)
+}
+
+// validateLoadBalancerPoolWaitForHealthy fails before the pool is changed
+// when waiting for healthy origins can never succeed.
+func validateLoadBalancerPoolWaitForHealthy(d *schema.ResourceData) error {
+	if d.Get("wait_for_healthy.#").(int) > 0 && d.Get("monitor").(string) == "" {
+		return fmt.Errorf("wait_for_healthy requires a monitor to check the health of the origins")
+	}
+	return nil
+}
+
+// waitForLoadBalancerPoolHealthy blocks until at least `minimum_origins` of
+// the enabled origins are healthy when `wait_for_healthy` is set.
+func waitForLoadBalancerPoolHealthy(ctx context.Context, client *providerClient, d *schema.ResourceData) error {
+	if d.Get("wait_for_healthy.#").(int) == 0 {
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(d.Get("wait_for_healthy.0.timeout").(string))
 	if err != nil {
-		return errors.Wrap(err, "error updating load balancer pool")
+		return fmt.Errorf("error parsing wait_for_healthy timeout: %s", err)
 	}
 
-	return resourceCloudflareLoadBalancerPoolRead(d, meta)
+	minimumOrigins := d.Get("minimum_origins").(int)
+	origins := expandLoadBalancerOrigins(d.Get("origins").(*schema.Set))
+
+	log.Printf("[INFO] Waiting up to %s for %d origins of Cloudflare Load Balancer Pool %s to be healthy", timeout, minimumOrigins, d.Id())
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		health, err := client.PoolHealthDetails(ctx, d.Id())
+		if err != nil {
+			// The health isn't available until the first checks have run.
+			return resource.RetryableError(errors.Wrap(err, fmt.Sprintf("error reading health of load balancer pool %q", d.Id())))
+		}
+
+		healthy := countHealthyLoadBalancerOrigins(origins, loadBalancerOriginHealth(health))
+		if healthy < minimumOrigins {
+			return resource.RetryableError(fmt.Errorf("%d of the required %d origins of load balancer pool %q are healthy", healthy, minimumOrigins, d.Id()))
+		}
+
+		log.Printf("[INFO] %d origins of Cloudflare Load Balancer Pool %s are healthy", healthy, d.Id())
+
+		return nil
+	})
+}
+
+func countHealthyLoadBalancerOrigins(origins []cloudflare.LoadBalancerOrigin, health map[string]bool) int {
+	healthy := 0
+	for _, origin := range origins {
+		if origin.Enabled && health[origin.Address] {
+			healthy++
+		}
+	}
+	return healthy
 }
 
 func expandLoadBalancerPoolHeader(cfgSet interface{}) map[string][]string {
@@ -396,18 +484,18 @@ func expandLoadBalancerOrigins(originSet *schema.Set) (origins []cloudflare.Load
 	return
 }
 
-func resourceCloudflareLoadBalancerPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLoadBalancerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
-	loadBalancerPool, err := client.LoadBalancerPoolDetails(context.Background(), d.Id())
+	loadBalancerPool, err := client.LoadBalancerPoolDetails(ctx, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
 			log.Printf("[INFO] Load balancer pool %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
 		} else {
-			return errors.Wrap(err,
-				fmt.Sprintf("Error reading load balancer pool from API for resource %s ", d.Id()))
+			return diag.FromErr(errors.Wrap(err,
+				fmt.Sprintf("Error reading load balancer pool from API for resource %s ", d.Id())))
 		}
 	}
 	log.Printf("[DEBUG] Read Cloudflare Load Balancer Pool from API as struct: %+v", loadBalancerPool)
@@ -486,14 +574,14 @@ func flattenLoadBalancerOrigins(d *schema.ResourceData, origins []cloudflare.Loa
 	return schema.NewSet(schema.HashResource(originsElem), flattened)
 }
 
-func resourceCloudflareLoadBalancerPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLoadBalancerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	log.Printf("[INFO] Deleting Cloudflare Load Balancer Pool: %s ", d.Id())
 
-	err := client.DeleteLoadBalancerPool(context.Background(), d.Id())
+	err := client.DeleteLoadBalancerPool(ctx, d.Id())
 	if err != nil {
-		return errors.Wrap(err, "error deleting Cloudflare Load Balancer Pool")
+		return diag.FromErr(errors.Wrap(err, "error deleting Cloudflare Load Balancer Pool"))
 	}
 
 	return nil
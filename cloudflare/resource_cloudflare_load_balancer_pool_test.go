@@ -133,8 +133,24 @@ func TestAccCloudflareLoadBalancerPool_CreateAfterManualDestroy(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareLoadBalancerPool_WaitForHealthyRequiresMonitor(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareLoadBalancerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareLoadBalancerPoolConfigWaitForHealthy(rnd),
+				ExpectError: regexp.MustCompile("wait_for_healthy requires a monitor"),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudflareLoadBalancerPoolDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*providerClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_load_balancer_pool" {
@@ -161,7 +177,7 @@ func testAccCheckCloudflareLoadBalancerPoolExists(n string, loadBalancerPool *cl
 			return fmt.Errorf("No Load Balancer ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		foundLoadBalancerPool, err := client.LoadBalancerPoolDetails(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
@@ -201,7 +217,7 @@ func testAccCheckCloudflareLoadBalancerPoolDates(n string, loadBalancerPool *clo
 
 func testAccManuallyDeleteLoadBalancerPool(name string, loadBalancerPool *cloudflare.LoadBalancerPool, initialId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		*initialId = loadBalancerPool.ID
 		err := client.DeleteLoadBalancerPool(context.Background(), loadBalancerPool.ID)
 		if err != nil {
@@ -275,3 +291,17 @@ resource "cloudflare_load_balancer_pool" "%[1]s" {
 }`, id, headerValue)
 	// TODO add field to config after creating monitor resource
 }
+
+func testAccCheckCloudflareLoadBalancerPoolConfigWaitForHealthy(id string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_load_balancer_pool" "%[1]s" {
+  name = "my-tf-pool-basic-%[1]s"
+  origins {
+    name = "example-1"
+    address = "192.0.2.1"
+  }
+  wait_for_healthy {
+    timeout = "1m"
+  }
+}`, id)
+}
@@ -2,21 +2,20 @@ package cloudflare
 
 import (
 	"context"
-	"fmt"
 	"log"
 
-	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceCloudflareLogpullRetention() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareLogpullRetentionSet,
-		Read:   resourceCloudflareLogpullRetentionRead,
-		Update: resourceCloudflareLogpullRetentionSet,
-		Delete: resourceCloudflareLogpullRetentionDelete,
+		CreateContext: resourceCloudflareLogpullRetentionSet,
+		ReadContext:   resourceCloudflareLogpullRetentionRead,
+		UpdateContext: resourceCloudflareLogpullRetentionSet,
+		DeleteContext: resourceCloudflareLogpullRetentionDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareLogpullRetentionImport,
+			StateContext: resourceCloudflareLogpullRetentionImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -33,28 +32,28 @@ func resourceCloudflareLogpullRetention() *schema.Resource {
 	}
 }
 
-func resourceCloudflareLogpullRetentionSet(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLogpullRetentionSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	status := d.Get("enabled").(bool)
 
-	_, err := client.SetLogpullRetentionFlag(context.Background(), zoneID, status)
+	_, err := client.SetLogpullRetentionFlag(ctx, zoneID, status)
 	if err != nil {
-		return fmt.Errorf("error setting Logpull Retention for zone ID %q: %s", zoneID, err)
+		return diag.Errorf("error setting Logpull Retention for zone ID %q: %s", zoneID, err)
 	}
 
 	d.SetId(stringChecksum("logpull-retention/" + zoneID))
 
-	return resourceCloudflareLogpullRetentionRead(d, meta)
+	return resourceCloudflareLogpullRetentionRead(ctx, d, meta)
 }
 
-func resourceCloudflareLogpullRetentionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLogpullRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
-	logpullConf, err := client.GetLogpullRetentionFlag(context.Background(), zoneID)
+	logpullConf, err := client.GetLogpullRetentionFlag(ctx, zoneID)
 	if err != nil {
-		return fmt.Errorf("error getting Logpull Retention for zone ID %q: %s", zoneID, err)
+		return diag.Errorf("error getting Logpull Retention for zone ID %q: %s", zoneID, err)
 	}
 
 	d.Set("enabled", logpullConf.Flag)
@@ -62,13 +61,13 @@ func resourceCloudflareLogpullRetentionRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceCloudflareLogpullRetentionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLogpullRetentionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
-	_, err := client.SetLogpullRetentionFlag(context.Background(), zoneID, false)
+	_, err := client.SetLogpullRetentionFlag(ctx, zoneID, false)
 	if err != nil {
-		return fmt.Errorf("error setting Logpull Retention for zone ID %q: %s", zoneID, err)
+		return diag.Errorf("error setting Logpull Retention for zone ID %q: %s", zoneID, err)
 	}
 
 	d.SetId("")
@@ -76,7 +75,7 @@ func resourceCloudflareLogpullRetentionDelete(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceCloudflareLogpullRetentionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareLogpullRetentionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	zoneID := d.Id()
 
 	log.Printf("[DEBUG] Importing Cloudflare Logpull Retention option for zone ID: %s", zoneID)
@@ -84,7 +83,7 @@ func resourceCloudflareLogpullRetentionImport(d *schema.ResourceData, meta inter
 	d.Set("zone_id", zoneID)
 	d.SetId(stringChecksum("logpull-retention/" + zoneID))
 
-	resourceCloudflareLogpullRetentionRead(d, meta)
+	resourceCloudflareLogpullRetentionRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
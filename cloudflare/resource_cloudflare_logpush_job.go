@@ -9,18 +9,19 @@ import (
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCloudflareLogpushJob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareLogpushJobCreate,
-		Read:   resourceCloudflareLogpushJobRead,
-		Update: resourceCloudflareLogpushJobUpdate,
-		Delete: resourceCloudflareLogpushJobDelete,
+		CreateContext: resourceCloudflareLogpushJobCreate,
+		ReadContext:   resourceCloudflareLogpushJobRead,
+		UpdateContext: resourceCloudflareLogpushJobUpdate,
+		DeleteContext: resourceCloudflareLogpushJobDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareLogpushJobImport,
+			StateContext: resourceCloudflareLogpushJobImport,
 		},
 
 		SchemaVersion: 0,
@@ -89,21 +90,21 @@ func getJobFromResource(d *schema.ResourceData) (cloudflare.LogpushJob, error) {
 	return job, nil
 }
 
-func resourceCloudflareLogpushJobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	jobID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("could not extract Logpush job from resource - invalid identifier (%s): %v", d.Id(), err)
+		return diag.Errorf("could not extract Logpush job from resource - invalid identifier (%s): %v", d.Id(), err)
 	}
 
-	job, err := client.LogpushJob(context.Background(), d.Get("zone_id").(string), jobID)
+	job, err := client.LogpushJob(ctx, d.Get("zone_id").(string), jobID)
 	if err != nil {
 		if strings.Contains(err.Error(), "404") {
 			log.Printf("[INFO] Could not find LogpushJob with id: %q", jobID)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding logpush job %q: %s", jobID, err)
+		return diag.Errorf("error finding logpush job %q: %s", jobID, err)
 	}
 
 	if job.ID == 0 {
@@ -120,66 +121,66 @@ func resourceCloudflareLogpushJobRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceCloudflareLogpushJobCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLogpushJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	job, err := getJobFromResource(d)
 	if err != nil {
-		return fmt.Errorf("error finding logpush job: %v", err)
+		return diag.Errorf("error finding logpush job: %v", err)
 	}
 
 	log.Printf("[DEBUG] Creating Cloudflare Logpush Job from struct: %+v", job)
 
-	j, err := client.CreateLogpushJob(context.Background(), d.Get("zone_id").(string), job)
+	j, err := client.CreateLogpushJob(ctx, d.Get("zone_id").(string), job)
 	if err != nil {
-		return fmt.Errorf("error creating logpush job: %v", err)
+		return diag.Errorf("error creating logpush job: %v", err)
 	}
 	if j.ID == 0 {
-		return fmt.Errorf("failed to find ID in Create response; resource was empty")
+		return diag.Errorf("failed to find ID in Create response; resource was empty")
 	}
 
 	d.SetId(strconv.Itoa(j.ID))
 
 	log.Printf("[INFO] Created Cloudflare Logpush Job ID: %s", d.Id())
 
-	return resourceCloudflareLogpushJobRead(d, meta)
+	return resourceCloudflareLogpushJobRead(ctx, d, meta)
 }
 
-func resourceCloudflareLogpushJobUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLogpushJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	job, err := getJobFromResource(d)
 	if err != nil {
-		return fmt.Errorf("error finding logpush job: %v", err)
+		return diag.Errorf("error finding logpush job: %v", err)
 	}
 
 	log.Printf("[INFO] Updating Cloudflare Logpush Job from struct: %+v", job)
 
-	updateErr := client.UpdateLogpushJob(context.Background(), d.Get("zone_id").(string), job.ID, job)
+	updateErr := client.UpdateLogpushJob(ctx, d.Get("zone_id").(string), job.ID, job)
 
 	if updateErr != nil {
-		return fmt.Errorf("error updating logpush job: %+v", job.ID)
+		return diag.Errorf("error updating logpush job: %+v", job.ID)
 	}
 
-	return resourceCloudflareLogpushJobRead(d, meta)
+	return resourceCloudflareLogpushJobRead(ctx, d, meta)
 }
 
-func resourceCloudflareLogpushJobDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLogpushJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	job, err := getJobFromResource(d)
 	if err != nil {
-		return fmt.Errorf("error finding logpush job: %v", err)
+		return diag.Errorf("error finding logpush job: %v", err)
 	}
 
 	log.Printf("[DEBUG] Deleting Cloudflare Logpush job from zone :%+v with id: %+v", d.Get("zone_id"), job.ID)
 
-	deleteErr := client.DeleteLogpushJob(context.Background(), d.Get("zone_id").(string), job.ID)
+	deleteErr := client.DeleteLogpushJob(ctx, d.Get("zone_id").(string), job.ID)
 	if deleteErr != nil {
 		if strings.Contains(err.Error(), "job not found") {
 			log.Printf("[INFO] Could not find logpush job with id: %q", job.ID)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error deleting logpush job: %+v", job.ID)
+		return diag.Errorf("error deleting logpush job: %+v", job.ID)
 	}
 
 	d.SetId("")
@@ -187,7 +188,7 @@ func resourceCloudflareLogpushJobDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceCloudflareLogpushJobImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareLogpushJobImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
 
@@ -202,7 +203,7 @@ func resourceCloudflareLogpushJobImport(d *schema.ResourceData, meta interface{}
 	d.Set("zone_id", zoneID)
 	d.SetId(logpushJobID)
 
-	resourceCloudflareLogpushJobRead(d, meta)
+	resourceCloudflareLogpushJobRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -2,19 +2,18 @@ package cloudflare
 
 import (
 	"context"
-	"fmt"
 	"log"
 
-	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceCloudflareLogpushOwnershipChallenge() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareLogpushOwnershipChallengeCreate,
-		Update: resourceCloudflareLogpushOwnershipChallengeCreate,
-		Read:   resourceCloudflareLogpushOwnershipChallengeNoop,
-		Delete: resourceCloudflareLogpushOwnershipChallengeNoop,
+		CreateContext: resourceCloudflareLogpushOwnershipChallengeCreate,
+		UpdateContext: resourceCloudflareLogpushOwnershipChallengeCreate,
+		ReadContext:   resourceCloudflareLogpushOwnershipChallengeNoop,
+		DeleteContext: resourceCloudflareLogpushOwnershipChallengeNoop,
 
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
@@ -35,18 +34,18 @@ func resourceCloudflareLogpushOwnershipChallenge() *schema.Resource {
 	}
 }
 
-func resourceCloudflareLogpushOwnershipChallengeNoop(d *schema.ResourceData, meta interface{}) error {
+func resourceCloudflareLogpushOwnershipChallengeNoop(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceCloudflareLogpushOwnershipChallengeCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareLogpushOwnershipChallengeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	destinationConf := d.Get("destination_conf").(string)
 
-	challenge, err := client.GetLogpushOwnershipChallenge(context.Background(), zoneID, destinationConf)
+	challenge, err := client.GetLogpushOwnershipChallenge(ctx, zoneID, destinationConf)
 	if err != nil {
-		return fmt.Errorf("error requesting ownership challenge: %v", err)
+		return diag.Errorf("error requesting ownership challenge: %v", err)
 	}
 
 	// The ownership challenge doesn't have a unique identifier so we generate it
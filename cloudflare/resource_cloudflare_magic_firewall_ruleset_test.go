@@ -210,7 +210,7 @@ func testAccCheckCloudflareMagicFirewallRulesetExists(n string, ruleset *cloudfl
 			return fmt.Errorf("No Magic Firewall Ruleset is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		foundRuleset, err := client.GetMagicFirewallRuleset(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
@@ -8,17 +8,18 @@ import (
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceCloudflareNotificationPolicyWebhooks() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareNotificationPolicyWebhooksCreate,
-		Read:   resourceCloudflareNotificationPolicyWebhooksRead,
-		Update: resourceCloudflareNotificationPolicyWebhooksUpdate,
-		Delete: resourceCloudflareNotificationPolicyWebhooksDelete,
+		CreateContext: resourceCloudflareNotificationPolicyWebhooksCreate,
+		ReadContext:   resourceCloudflareNotificationPolicyWebhooksRead,
+		UpdateContext: resourceCloudflareNotificationPolicyWebhooksUpdate,
+		DeleteContext: resourceCloudflareNotificationPolicyWebhooksDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareNotificationPolicyWebhooksImport,
+			StateContext: resourceCloudflareNotificationPolicyWebhooksImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,37 +59,37 @@ func resourceCloudflareNotificationPolicyWebhooks() *schema.Resource {
 	}
 }
 
-func resourceCloudflareNotificationPolicyWebhooksCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareNotificationPolicyWebhooksCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 
 	notificationWebhooks := buildNotificationPolicyWebhooks(d)
 
-	webhooksDestination, err := client.CreateNotificationWebhooks(context.Background(), accountID, &notificationWebhooks)
+	webhooksDestination, err := client.CreateNotificationWebhooks(ctx, accountID, &notificationWebhooks)
 
 	if err != nil {
-		return fmt.Errorf("error connecting webhooks destination %s: %s", notificationWebhooks.Name, err)
+		return diag.Errorf("error connecting webhooks destination %s: %s", notificationWebhooks.Name, err)
 	}
 	formattedWebhookID, err := uuid.Parse(webhooksDestination.Result.ID)
 	if err != nil {
-		return fmt.Errorf("error setting notification webhooks: %s", err)
+		return diag.Errorf("error setting notification webhooks: %s", err)
 	}
 
 	d.SetId(formattedWebhookID.String())
 
-	return resourceCloudflareNotificationPolicyWebhooksRead(d, meta)
+	return resourceCloudflareNotificationPolicyWebhooksRead(ctx, d, meta)
 }
 
-func resourceCloudflareNotificationPolicyWebhooksRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareNotificationPolicyWebhooksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	webhooksDestinationID := d.Id()
 	accountID := d.Get("account_id").(string)
 
-	notificationWebhooks, err := client.GetNotificationWebhooks(context.Background(), accountID, webhooksDestinationID)
+	notificationWebhooks, err := client.GetNotificationWebhooks(ctx, accountID, webhooksDestinationID)
 
 	name := d.Get("name").(string)
 	if err != nil {
-		return fmt.Errorf("error retrieving notification webhooks %s: %s", name, err)
+		return diag.Errorf("error retrieving notification webhooks %s: %s", name, err)
 	}
 
 	d.Set("name", notificationWebhooks.Result.Name)
@@ -106,36 +107,36 @@ func resourceCloudflareNotificationPolicyWebhooksRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceCloudflareNotificationPolicyWebhooksUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareNotificationPolicyWebhooksUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	webhooksID := d.Id()
 	accountID := d.Get("account_id").(string)
 
 	notificationWebhooks := buildNotificationPolicyWebhooks(d)
 
-	_, err := client.UpdateNotificationWebhooks(context.Background(), accountID, webhooksID, &notificationWebhooks)
+	_, err := client.UpdateNotificationWebhooks(ctx, accountID, webhooksID, &notificationWebhooks)
 
 	if err != nil {
-		return fmt.Errorf("error updating notification webhooks destination %s: %s", webhooksID, err)
+		return diag.Errorf("error updating notification webhooks destination %s: %s", webhooksID, err)
 	}
 
-	return resourceCloudflareNotificationPolicyWebhooksRead(d, meta)
+	return resourceCloudflareNotificationPolicyWebhooksRead(ctx, d, meta)
 }
 
-func resourceCloudflareNotificationPolicyWebhooksDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareNotificationPolicyWebhooksDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	webhooksID := d.Id()
 	accountID := d.Get("account_id").(string)
 
-	_, err := client.DeleteNotificationWebhooks(context.Background(), accountID, webhooksID)
+	_, err := client.DeleteNotificationWebhooks(ctx, accountID, webhooksID)
 
 	if err != nil {
-		return fmt.Errorf("error deleting notification webhooks destination %s: %s", webhooksID, err)
+		return diag.Errorf("error deleting notification webhooks destination %s: %s", webhooksID, err)
 	}
 	return nil
 }
 
-func resourceCloudflareNotificationPolicyWebhooksImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareNotificationPolicyWebhooksImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 2)
 
 	if len(attributes) != 2 {
@@ -146,7 +147,7 @@ func resourceCloudflareNotificationPolicyWebhooksImport(d *schema.ResourceData,
 	d.SetId(webhooksID)
 	d.Set("account_id", accountID)
 
-	resourceCloudflareNotificationPolicyWebhooksRead(d, meta)
+	resourceCloudflareNotificationPolicyWebhooksRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 
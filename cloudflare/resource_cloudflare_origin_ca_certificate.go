@@ -11,18 +11,19 @@ import (
 	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCloudflareOriginCACertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareOriginCACertificateCreate,
-		Update: resourceCloudflareOriginCACertificateCreate,
-		Read:   resourceCloudflareOriginCACertificateRead,
-		Delete: resourceCloudflareOriginCACertificateDelete,
+		CreateContext: resourceCloudflareOriginCACertificateCreate,
+		UpdateContext: resourceCloudflareOriginCACertificateCreate,
+		ReadContext:   resourceCloudflareOriginCACertificateRead,
+		DeleteContext: resourceCloudflareOriginCACertificateDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,8 +67,8 @@ func resourceCloudflareOriginCACertificate() *schema.Resource {
 	}
 }
 
-func resourceCloudflareOriginCACertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareOriginCACertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	hostnames := []string{}
 	hostnamesRaw := d.Get("hostnames").(*schema.Set)
@@ -89,21 +90,21 @@ func resourceCloudflareOriginCACertificateCreate(d *schema.ResourceData, meta in
 	}
 
 	log.Printf("[INFO] Creating Cloudflare OriginCACertificate: %#v", certInput)
-	cert, err := client.CreateOriginCertificate(context.Background(), certInput)
+	cert, err := client.CreateOriginCertificate(ctx, certInput)
 
 	if err != nil {
-		return fmt.Errorf("error creating origin certificate: %s", err)
+		return diag.Errorf("error creating origin certificate: %s", err)
 	}
 
 	d.SetId(cert.ID)
 
-	return resourceCloudflareOriginCACertificateRead(d, meta)
+	return resourceCloudflareOriginCACertificateRead(ctx, d, meta)
 }
 
-func resourceCloudflareOriginCACertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareOriginCACertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	certID := d.Id()
-	cert, err := client.OriginCertificate(context.Background(), certID)
+	cert, err := client.OriginCertificate(ctx, certID)
 
 	log.Printf("[DEBUG] OriginCACertificate: %#v", cert)
 
@@ -113,7 +114,7 @@ func resourceCloudflareOriginCACertificateRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding OriginCACertificate %q: %s", certID, err)
+		return diag.Errorf("error finding OriginCACertificate %q: %s", certID, err)
 	}
 
 	if cert.RevokedAt != (time.Time{}) {
@@ -148,16 +149,16 @@ func resourceCloudflareOriginCACertificateRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceCloudflareOriginCACertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareOriginCACertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	certID := d.Id()
 
 	log.Printf("[INFO] Revoking Cloudflare OriginCACertificate: id %s", certID)
 
-	_, err := client.RevokeOriginCertificate(context.Background(), certID)
+	_, err := client.RevokeOriginCertificate(ctx, certID)
 
 	if err != nil {
-		return fmt.Errorf("error revoking Cloudflare OriginCACertificate: %s", err)
+		return diag.Errorf("error revoking Cloudflare OriginCACertificate: %s", err)
 	}
 
 	d.SetId("")
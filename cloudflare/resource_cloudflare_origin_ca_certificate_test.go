@@ -59,7 +59,7 @@ func TestAccCloudflareOriginCACertificate_Basic(t *testing.T) {
 }
 
 func testAccCheckCloudflareOriginCACertificateDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*cloudflare.API)
+	client := testAccProvider.Meta().(*providerClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "cloudflare_origin_ca_certificate" {
@@ -86,7 +86,7 @@ func testAccCheckCloudflareOriginCACertificateExists(name string, cert *cloudfla
 			return fmt.Errorf("No Origin CA Certificate ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		foundOriginCACertificate, err := client.OriginCertificate(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
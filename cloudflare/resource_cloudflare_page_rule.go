@@ -9,18 +9,19 @@ import (
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCloudflarePageRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflarePageRuleCreate,
-		Read:   resourceCloudflarePageRuleRead,
-		Update: resourceCloudflarePageRuleUpdate,
-		Delete: resourceCloudflarePageRuleDelete,
+		CreateContext: resourceCloudflarePageRuleCreate,
+		ReadContext:   resourceCloudflarePageRuleRead,
+		UpdateContext: resourceCloudflarePageRuleUpdate,
+		DeleteContext: resourceCloudflarePageRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflarePageRuleImport,
+			StateContext: resourceCloudflarePageRuleImport,
 		},
 
 		SchemaVersion: 0,
@@ -484,8 +485,8 @@ func suppressEquivalentURLs(k, old, new string, d *schema.ResourceData) bool {
 	return false
 }
 
-func resourceCloudflarePageRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflarePageRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	newPageRuleTargets := []cloudflare.PageRuleTarget{
@@ -510,7 +511,7 @@ func resourceCloudflarePageRuleCreate(d *schema.ResourceData, meta interface{})
 
 			newPageRuleAction, err := transformToCloudflarePageRuleAction(id, value, d)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			} else if newPageRuleAction.Value == nil || newPageRuleAction.Value == "" {
 				continue
 			}
@@ -519,7 +520,7 @@ func resourceCloudflarePageRuleCreate(d *schema.ResourceData, meta interface{})
 	}
 	pageRulesActionMap := pageRuleActionsToMap(newPageRuleActions)
 	if _, ok := pageRulesActionMap["forwarding_url"]; ok && len(pageRulesActionMap) > 1 {
-		return fmt.Errorf("\"forwarding_url\" cannot be set with any other actions")
+		return diag.Errorf("\"forwarding_url\" cannot be set with any other actions")
 	}
 
 	newPageRule := cloudflare.PageRule{
@@ -531,18 +532,18 @@ func resourceCloudflarePageRuleCreate(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Cloudflare Page Rule create configuration: %#v", newPageRule)
 
-	r, err := client.CreatePageRule(context.Background(), zoneID, newPageRule)
+	r, err := client.CreatePageRule(ctx, zoneID, newPageRule)
 	if err != nil {
-		return fmt.Errorf("failed to create page rule: %s", err)
+		return diag.Errorf("failed to create page rule: %s", err)
 	}
 
 	if r.ID == "" {
-		return fmt.Errorf("Failed to find page rule in Create response; ID was empty")
+		return diag.Errorf("Failed to find page rule in Create response; ID was empty")
 	}
 
 	d.SetId(r.ID)
 
-	return resourceCloudflarePageRuleRead(d, meta)
+	return resourceCloudflarePageRuleRead(ctx, d, meta)
 }
 
 func pageRuleActionsToMap(vs []cloudflare.PageRuleAction) map[string]interface{} {
@@ -553,11 +554,11 @@ func pageRuleActionsToMap(vs []cloudflare.PageRuleAction) map[string]interface{}
 	return vsm
 }
 
-func resourceCloudflarePageRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflarePageRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
-	pageRule, err := client.PageRule(context.Background(), zoneID, d.Id())
+	pageRule, err := client.PageRule(ctx, zoneID, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "Invalid Page Rule identifier") || // api bug - this indicates non-existing resource
 			strings.Contains(err.Error(), "HTTP status 404") {
@@ -565,7 +566,7 @@ func resourceCloudflarePageRuleRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		} else {
-			return fmt.Errorf("error finding page rule %q: %s", d.Id(), err)
+			return diag.Errorf("error finding page rule %q: %s", d.Id(), err)
 		}
 	}
 	log.Printf("[DEBUG] Cloudflare Page Rule read configuration: %#v", pageRule)
@@ -581,7 +582,7 @@ func resourceCloudflarePageRuleRead(d *schema.ResourceData, meta interface{}) er
 	for _, pageRuleAction := range pageRule.Actions {
 		key, value, err := transformFromCloudflarePageRuleAction(&pageRuleAction)
 		if err != nil {
-			return fmt.Errorf("failed to parse page rule action: %s", err)
+			return diag.Errorf("failed to parse page rule action: %s", err)
 		}
 		actions[key] = value
 	}
@@ -594,8 +595,8 @@ func resourceCloudflarePageRuleRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceCloudflarePageRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflarePageRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	updatePageRule := cloudflare.PageRule{}
@@ -623,7 +624,7 @@ func resourceCloudflarePageRuleUpdate(d *schema.ResourceData, meta interface{})
 			for id, value := range action.(map[string]interface{}) {
 				newPageRuleAction, err := transformToCloudflarePageRuleAction(id, value, d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				} else if newPageRuleAction.Value == nil {
 					continue
 				}
@@ -644,21 +645,21 @@ func resourceCloudflarePageRuleUpdate(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Cloudflare Page Rule update configuration: %#v", updatePageRule)
 
-	if err := client.UpdatePageRule(context.Background(), zoneID, d.Id(), updatePageRule); err != nil {
-		return fmt.Errorf("failed to update Cloudflare Page Rule: %s", err)
+	if err := client.UpdatePageRule(ctx, zoneID, d.Id(), updatePageRule); err != nil {
+		return diag.Errorf("failed to update Cloudflare Page Rule: %s", err)
 	}
 
-	return resourceCloudflarePageRuleRead(d, meta)
+	return resourceCloudflarePageRuleRead(ctx, d, meta)
 }
 
-func resourceCloudflarePageRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflarePageRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Page Rule: %s, %s", zoneID, d.Id())
 
-	if err := client.DeletePageRule(context.Background(), zoneID, d.Id()); err != nil {
-		return fmt.Errorf("error deleting Cloudflare Page Rule: %s", err)
+	if err := client.DeletePageRule(ctx, zoneID, d.Id()); err != nil {
+		return diag.Errorf("error deleting Cloudflare Page Rule: %s", err)
 	}
 
 	return nil
@@ -946,7 +947,7 @@ func transformToCloudflarePageRuleAction(id string, value interface{}, d *schema
 	return
 }
 
-func resourceCloudflarePageRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflarePageRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
 	var zoneID string
@@ -960,7 +961,7 @@ func resourceCloudflarePageRuleImport(d *schema.ResourceData, meta interface{})
 		return nil, fmt.Errorf("invalid id (%q) specified, should be in format \"zoneID/pageRuleID\"", d.Id())
 	}
 
-	resourceCloudflarePageRuleRead(d, meta)
+	resourceCloudflarePageRuleRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,12 +16,12 @@ import (
 
 func resourceCloudflareRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareRecordCreate,
-		Read:   resourceCloudflareRecordRead,
-		Update: resourceCloudflareRecordUpdate,
-		Delete: resourceCloudflareRecordDelete,
+		CreateContext: resourceCloudflareRecordCreate,
+		ReadContext:   resourceCloudflareRecordRead,
+		UpdateContext: resourceCloudflareRecordUpdate,
+		DeleteContext: resourceCloudflareRecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareRecordImport,
+			StateContext: resourceCloudflareRecordImport,
 		},
 
 		SchemaVersion: 2,
@@ -300,8 +301,8 @@ func resourceCloudflareRecord() *schema.Resource {
 	}
 }
 
-func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	newRecord := cloudflare.DNSRecord{
 		Type:   d.Get("type").(string),
@@ -329,7 +330,7 @@ func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) er
 		for id, value := range dataMap.(map[string]interface{}) {
 			newData, err := transformToCloudflareDNSData(newRecord.Type, id, value)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			} else if newData == nil {
 				continue
 			}
@@ -340,9 +341,9 @@ func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if valueOk == dataOk {
-		return fmt.Errorf(
+		return diag.FromErr(fmt.Errorf(
 			"either 'value' (present: %t) or 'data' (present: %t) must be provided",
-			valueOk, dataOk)
+			valueOk, dataOk))
 	}
 
 	if priority, ok := d.GetOkExists("priority"); ok {
@@ -352,7 +353,7 @@ func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) er
 
 	if ttl, ok := d.GetOk("ttl"); ok {
 		if ttl.(int) != 1 && proxiedOk && *newRecord.Proxied {
-			return fmt.Errorf("error validating record %s: ttl must be set to 1 when `proxied` is true", newRecord.Name)
+			return diag.Errorf("error validating record %s: ttl must be set to 1 when `proxied` is true", newRecord.Name)
 		}
 
 		newRecord.TTL = ttl.(int)
@@ -360,7 +361,7 @@ func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) er
 
 	// Validate value based on type
 	if err := validateRecordName(newRecord.Type, newRecord.Content); err != nil {
-		return fmt.Errorf("error validating record name %q: %s", newRecord.Name, err)
+		return diag.Errorf("error validating record name %q: %s", newRecord.Name, err)
 	}
 
 	var proxiedVal *bool
@@ -372,19 +373,19 @@ func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) er
 
 	// Validate type
 	if err := validateRecordType(newRecord.Type, *proxiedVal); err != nil {
-		return fmt.Errorf("error validating record type %q: %s", newRecord.Type, err)
+		return diag.Errorf("error validating record type %q: %s", newRecord.Type, err)
 	}
 
 	log.Printf("[DEBUG] Cloudflare Record create configuration: %#v", newRecord)
 
-	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		r, err := client.CreateDNSRecord(context.Background(), newRecord.ZoneID, newRecord)
+	return diag.FromErr(resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		r, err := client.CreateDNSRecord(ctx, newRecord.ZoneID, newRecord)
 		if err != nil {
 			if strings.Contains(err.Error(), "already exist") {
 				if d.Get("allow_overwrite").(bool) {
 					var r cloudflare.DNSRecord
 					log.Printf("[DEBUG] Cloudflare Record already exists however we are overwriting it")
-					zone, _ := client.ZoneDetails(context.Background(), d.Get("zone_id").(string))
+					zone, _ := cachedZoneDetails(ctx, client, d.Get("zone_id").(string))
 					if d.Get("name").(string) == "@" || d.Get("name").(string) == zone.Name {
 						r = cloudflare.DNSRecord{
 							Name: zone.Name,
@@ -396,7 +397,7 @@ func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) er
 							Type: d.Get("type").(string),
 						}
 					}
-					rs, _ := client.DNSRecords(context.Background(), d.Get("zone_id").(string), r)
+					rs, _ := client.DNSRecords(ctx, d.Get("zone_id").(string), r)
 
 					if len(rs) != 1 {
 						return resource.RetryableError(fmt.Errorf("attempted to override existing record however didn't find an exact match"))
@@ -406,7 +407,7 @@ func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) er
 					// for Terraform to operate on it, we need an anchor.
 					d.SetId(rs[0].ID)
 
-					return resource.NonRetryableError(resourceCloudflareRecordUpdate(d, meta))
+					return resource.NonRetryableError(diagnosticsError(resourceCloudflareRecordUpdate(ctx, d, meta)))
 				}
 
 				return resource.RetryableError(fmt.Errorf("expected DNS record to not already be present but already exists"))
@@ -423,17 +424,17 @@ func resourceCloudflareRecordCreate(d *schema.ResourceData, meta interface{}) er
 
 		d.SetId(r.Result.ID)
 
-		resourceCloudflareRecordRead(d, meta)
+		resourceCloudflareRecordRead(ctx, d, meta)
 
 		return nil
-	})
+	}))
 }
 
-func resourceCloudflareRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
-	record, err := client.DNSRecord(context.Background(), zoneID, d.Id())
+	record, err := client.DNSRecord(ctx, zoneID, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "Invalid dns record identifier") ||
 			strings.Contains(err.Error(), "HTTP status 404") {
@@ -441,7 +442,7 @@ func resourceCloudflareRecordRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	data, dataOk := d.GetOk("data")
@@ -455,7 +456,7 @@ func resourceCloudflareRecordRead(d *schema.ResourceData, meta interface{}) erro
 			for id, value := range dataMap.(map[string]interface{}) {
 				newData, err := transformToCloudflareDNSData(record.Type, id, value)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				} else if newData == nil {
 					continue
 				}
@@ -489,8 +490,8 @@ func resourceCloudflareRecordRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceCloudflareRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	updateRecord := cloudflare.DNSRecord{
@@ -511,7 +512,7 @@ func resourceCloudflareRecordUpdate(d *schema.ResourceData, meta interface{}) er
 		for id, value := range dataMap.(map[string]interface{}) {
 			newData, err := transformToCloudflareDNSData(updateRecord.Type, id, value)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			} else if newData == nil {
 				continue
 			}
@@ -533,7 +534,7 @@ func resourceCloudflareRecordUpdate(d *schema.ResourceData, meta interface{}) er
 
 	if ttl, ok := d.GetOk("ttl"); ok {
 		if ttl.(int) != 1 && proxiedOk && *updateRecord.Proxied {
-			return fmt.Errorf("error validating record %s: ttl must be set to 1 when `proxied` is true", updateRecord.Name)
+			return diag.Errorf("error validating record %s: ttl must be set to 1 when `proxied` is true", updateRecord.Name)
 		}
 
 		updateRecord.TTL = ttl.(int)
@@ -541,8 +542,8 @@ func resourceCloudflareRecordUpdate(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] Cloudflare Record update configuration: %#v", updateRecord)
 
-	return resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		err := client.UpdateDNSRecord(context.Background(), zoneID, d.Id(), updateRecord)
+	return diag.FromErr(resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err := client.UpdateDNSRecord(ctx, zoneID, d.Id(), updateRecord)
 		if err != nil {
 			if strings.Contains(err.Error(), "already exist") {
 				return resource.RetryableError(fmt.Errorf("expected DNS record to not already be present but already exists"))
@@ -551,20 +552,20 @@ func resourceCloudflareRecordUpdate(d *schema.ResourceData, meta interface{}) er
 			return resource.NonRetryableError(fmt.Errorf("failed to create DNS record: %s", err))
 		}
 
-		resourceCloudflareRecordRead(d, meta)
+		resourceCloudflareRecordRead(ctx, d, meta)
 		return nil
-	})
+	}))
 }
 
-func resourceCloudflareRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Record: %s, %s", zoneID, d.Id())
 
-	err := client.DeleteDNSRecord(context.Background(), zoneID, d.Id())
+	err := client.DeleteDNSRecord(ctx, zoneID, d.Id())
 	if err != nil {
-		return fmt.Errorf("error deleting Cloudflare Record: %s", err)
+		return diag.Errorf("error deleting Cloudflare Record: %s", err)
 	}
 
 	return nil
@@ -584,8 +585,8 @@ func expandStringMap(inVal interface{}) map[string]string {
 	return outVal
 }
 
-func resourceCloudflareRecordImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareRecordImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -598,7 +599,7 @@ func resourceCloudflareRecordImport(d *schema.ResourceData, meta interface{}) ([
 		return nil, fmt.Errorf("invalid id %q specified, should be in format \"zoneID/recordID\" for import", d.Id())
 	}
 
-	record, err := client.DNSRecord(context.Background(), zoneID, recordID)
+	record, err := client.DNSRecord(ctx, zoneID, recordID)
 	if err != nil {
 		return nil, fmt.Errorf("Unable to find record with ID %q: %q", d.Id(), err)
 	}
@@ -610,7 +611,7 @@ func resourceCloudflareRecordImport(d *schema.ResourceData, meta interface{}) ([
 	d.Set("zone_id", zoneID)
 	d.SetId(recordID)
 
-	resourceCloudflareRecordRead(d, meta)
+	resourceCloudflareRecordRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,695 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// recordSetPlaceholderZone is used to qualify relative names when the zone
+// name isn't known yet, e.g. during the plan.
+const recordSetPlaceholderZone = "zone.invalid"
+
+func resourceCloudflareRecordSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareRecordSetCreate,
+		ReadContext:   resourceCloudflareRecordSetRead,
+		UpdateContext: resourceCloudflareRecordSetUpdate,
+		DeleteContext: resourceCloudflareRecordSetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareRecordSetImport,
+		},
+		CustomizeDiff: resourceCloudflareRecordSetDiff,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"zone_file": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"zone_file", "record"},
+				DiffSuppressFunc: suppressEquivalentZoneFile,
+			},
+
+			"record": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"zone_file", "record"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(recordSetTypes, false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"proxied": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"proxied": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudflareRecordSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	zoneID := d.Get("zone_id").(string)
+
+	zone, err := cachedZoneDetails(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding zone %q: %s", zoneID, err))
+	}
+
+	desired, err := expandDNSRecordSet(d, zone.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existing, err := fetchDNSRecordSetRecords(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	plan := planDNSRecordSet(nil, existing, desired)
+	records, err := applyDNSRecordSetPlan(ctx, client, zoneID, plan)
+
+	d.SetId(zoneID)
+	if setErr := d.Set("records", flattenDNSRecordSetRecords(records)); setErr != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("records"), setErr)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareRecordSetRead(ctx, d, meta)
+}
+
+func resourceCloudflareRecordSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	zoneID := d.Get("zone_id").(string)
+
+	zone, err := cachedZoneDetails(ctx, client, zoneID)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") || strings.Contains(err.Error(), "Invalid zone identifier") {
+			log.Printf("[WARN] Removing record set from state because zone %q is not found in API", zoneID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding zone %q: %s", zoneID, err))
+	}
+
+	existing, err := fetchDNSRecordSetRecords(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tracked := trackedDNSRecordSetIDs(d.Get("records").([]interface{}))
+	var records []dnsRecordSetRecord
+	for _, record := range existing {
+		if tracked[record.ID] {
+			records = append(records, record)
+		}
+	}
+	sortDNSRecordSetRecords(records)
+
+	if err := d.Set("records", flattenDNSRecordSetRecords(records)); err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("records"), err)
+	}
+
+	// Reflect any changes made outside of Terraform in the configured
+	// attribute so they show up in the plan.
+	desired, err := expandDNSRecordSet(d, zone.Name)
+	if err == nil && dnsRecordSetsEqual(desired, records) {
+		return nil
+	}
+
+	if _, ok := d.GetOk("record"); ok {
+		if err := d.Set("record", flattenDNSRecordSetConfig(d.Get("record").(*schema.Set), records, zone.Name)); err != nil {
+			return attributeErrorDiagnostic(cty.GetAttrPath("record"), err)
+		}
+		return nil
+	}
+
+	if err := d.Set("zone_file", renderZoneFile(records, zone.Name)); err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("zone_file"), err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareRecordSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	zoneID := d.Get("zone_id").(string)
+
+	zone, err := cachedZoneDetails(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding zone %q: %s", zoneID, err))
+	}
+
+	desired, err := expandDNSRecordSet(d, zone.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existing, err := fetchDNSRecordSetRecords(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// `records` is recomputed whenever the configuration changes so the
+	// records which are currently managed come from the prior state.
+	oldRecords, _ := d.GetChange("records")
+	tracked := trackedDNSRecordSetIDs(oldRecords.([]interface{}))
+
+	var current, untracked []dnsRecordSetRecord
+	for _, record := range existing {
+		if tracked[record.ID] {
+			current = append(current, record)
+		} else {
+			untracked = append(untracked, record)
+		}
+	}
+
+	plan := planDNSRecordSet(current, untracked, desired)
+	records, err := applyDNSRecordSetPlan(ctx, client, zoneID, plan)
+
+	if setErr := d.Set("records", flattenDNSRecordSetRecords(records)); setErr != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("records"), setErr)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareRecordSetRead(ctx, d, meta)
+}
+
+func resourceCloudflareRecordSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	zoneID := d.Get("zone_id").(string)
+
+	for id := range trackedDNSRecordSetIDs(d.Get("records").([]interface{})) {
+		log.Printf("[INFO] Deleting Cloudflare Record: %s, %s", zoneID, id)
+
+		err := client.DeleteDNSRecord(ctx, zoneID, id)
+		if err != nil && !isDNSRecordNotFound(err) {
+			return diag.Errorf("error deleting Cloudflare Record %q: %s", id, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCloudflareRecordSetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerClient)
+
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) < 2 || attributes[0] == "" || attributes[1] == "" {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/name\" or \"zoneID/name/type\"", d.Id())
+	}
+
+	zoneID, name, recordType := attributes[0], attributes[1], ""
+	if len(attributes) == 3 {
+		recordType = strings.ToUpper(attributes[2])
+	}
+
+	zone, err := cachedZoneDetails(ctx, client, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding zone %q: %s", zoneID, err)
+	}
+
+	existing, err := fetchDNSRecordSetRecords(ctx, client, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	name = qualifyDNSName(name, zone.Name)
+	records := filterDNSRecordSetRecords(existing, name, recordType)
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no records named %q found in zone %q", name, zoneID)
+	}
+
+	log.Printf("[DEBUG] Importing %d Cloudflare Records named %q into a record set for zone %s", len(records), name, zoneID)
+
+	d.SetId(zoneID)
+	d.Set("zone_id", zoneID)
+	if err := d.Set("records", flattenDNSRecordSetRecords(records)); err != nil {
+		return nil, fmt.Errorf("failed to set records: %s", err)
+	}
+
+	if err := diagnosticsError(resourceCloudflareRecordSetRead(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// filterDNSRecordSetRecords returns the records with the fully qualified name
+// and, if set, the type.
+func filterDNSRecordSetRecords(records []dnsRecordSetRecord, name, recordType string) []dnsRecordSetRecord {
+	var result []dnsRecordSetRecord
+	for _, record := range records {
+		if record.Name != name || (recordType != "" && record.Type != recordType) {
+			continue
+		}
+		result = append(result, record)
+	}
+
+	return result
+}
+
+// resourceCloudflareRecordSetDiff validates the records at plan time and
+// marks the tracked records as changing whenever the configuration does.
+func resourceCloudflareRecordSetDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("zone_file") || !d.NewValueKnown("record") {
+		return nil
+	}
+
+	if zoneFile := d.Get("zone_file").(string); zoneFile != "" {
+		if _, err := parseZoneFile(zoneFile, recordSetPlaceholderZone); err != nil {
+			return fmt.Errorf("invalid zone_file: %s", err)
+		}
+	}
+
+	if _, err := expandDNSRecordSetConfig(d.Get("record").(*schema.Set), recordSetPlaceholderZone); err != nil {
+		return err
+	}
+
+	if d.HasChange("zone_file") || d.HasChange("record") {
+		return d.SetNewComputed("records")
+	}
+
+	return nil
+}
+
+// suppressEquivalentZoneFile ignores changes to the zone file which don't
+// change any records such as comments, formatting and ordering.
+func suppressEquivalentZoneFile(k, old, new string, d *schema.ResourceData) bool {
+	oldRecords, err := parseZoneFile(old, recordSetPlaceholderZone)
+	if err != nil {
+		return false
+	}
+
+	newRecords, err := parseZoneFile(new, recordSetPlaceholderZone)
+	if err != nil {
+		return false
+	}
+
+	return dnsRecordSetsEqual(oldRecords, newRecords)
+}
+
+// expandDNSRecordSet returns the records from either `zone_file` or `record`.
+func expandDNSRecordSet(d *schema.ResourceData, zoneName string) ([]dnsRecordSetRecord, error) {
+	if zoneFile, ok := d.GetOk("zone_file"); ok {
+		records, err := parseZoneFile(zoneFile.(string), zoneName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid zone_file: %s", err)
+		}
+		return records, nil
+	}
+
+	return expandDNSRecordSetConfig(d.Get("record").(*schema.Set), zoneName)
+}
+
+func expandDNSRecordSetConfig(set *schema.Set, zoneName string) ([]dnsRecordSetRecord, error) {
+	var records []dnsRecordSetRecord
+	for _, item := range set.List() {
+		config := item.(map[string]interface{})
+
+		record, err := newDNSRecordSetRecord(
+			zoneName,
+			config["name"].(string),
+			config["type"].(string),
+			config["value"].(string),
+			config["ttl"].(int),
+			config["priority"].(int),
+			config["proxied"].(bool),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// flattenDNSRecordSetConfig converts the records into `record` blocks,
+// reusing the configured name and value when they are equivalent so that
+// only the records that have actually changed show up in the plan.
+func flattenDNSRecordSetConfig(set *schema.Set, records []dnsRecordSetRecord, zoneName string) []interface{} {
+	configured := make(map[string]map[string]interface{})
+	for _, item := range set.List() {
+		config := item.(map[string]interface{})
+		record, err := newDNSRecordSetRecord(zoneName, config["name"].(string), config["type"].(string), config["value"].(string), 0, 0, false)
+		if err == nil {
+			configured[record.key()] = config
+		}
+	}
+
+	var result []interface{}
+	for _, record := range records {
+		name, value := relativeDNSName(record.Name, zoneName), record.Content
+		if config, ok := configured[record.key()]; ok {
+			name, value = config["name"].(string), config["value"].(string)
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":     name,
+			"type":     record.Type,
+			"value":    value,
+			"ttl":      record.TTL,
+			"priority": record.Priority,
+			"proxied":  record.Proxied,
+		})
+	}
+
+	return result
+}
+
+func flattenDNSRecordSetRecords(records []dnsRecordSetRecord) []interface{} {
+	var result []interface{}
+	for _, record := range records {
+		result = append(result, map[string]interface{}{
+			"id":       record.ID,
+			"name":     record.Name,
+			"type":     record.Type,
+			"value":    record.Content,
+			"ttl":      record.TTL,
+			"priority": record.Priority,
+			"proxied":  record.Proxied,
+		})
+	}
+
+	return result
+}
+
+func trackedDNSRecordSetIDs(records []interface{}) map[string]bool {
+	ids := make(map[string]bool)
+	for _, item := range records {
+		if record, ok := item.(map[string]interface{}); ok && record["id"].(string) != "" {
+			ids[record["id"].(string)] = true
+		}
+	}
+
+	return ids
+}
+
+// fetchDNSRecordSetRecords returns all the records of the zone with a type
+// which can be managed by cloudflare_record_set.
+func fetchDNSRecordSetRecords(ctx context.Context, client *providerClient, zoneID string) ([]dnsRecordSetRecord, error) {
+	dnsRecords, err := client.DNSRecords(ctx, zoneID, cloudflare.DNSRecord{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing DNS records for zone %q: %s", zoneID, err)
+	}
+
+	var records []dnsRecordSetRecord
+	for _, dnsRecord := range dnsRecords {
+		if !contains(recordSetTypes, dnsRecord.Type) {
+			continue
+		}
+		records = append(records, dnsRecordSetRecordFromAPI(dnsRecord))
+	}
+
+	return records, nil
+}
+
+// dnsRecordSetRecordFromAPI normalises a record returned by the API. SRV and
+// CAA records are rebuilt from their data as the content is only informative.
+func dnsRecordSetRecordFromAPI(r cloudflare.DNSRecord) dnsRecordSetRecord {
+	record := dnsRecordSetRecord{
+		ID:      r.ID,
+		Name:    strings.ToLower(r.Name),
+		Type:    r.Type,
+		Content: r.Content,
+		TTL:     r.TTL,
+	}
+
+	if r.Proxied != nil {
+		record.Proxied = *r.Proxied
+	}
+
+	data, _ := r.Data.(map[string]interface{})
+	value := func(key string) string {
+		if v, ok := data[key]; ok {
+			return fmt.Sprint(v)
+		}
+		return ""
+	}
+
+	switch r.Type {
+	case "CNAME", "NS", "PTR":
+		record.Content = normalizeDNSTarget(r.Content)
+	case "MX":
+		record.Content = normalizeDNSTarget(r.Content)
+		if r.Priority != nil {
+			record.Priority = int(*r.Priority)
+		}
+	case "SRV":
+		fields := strings.Fields(r.Content)
+		if data != nil {
+			fields = []string{value("weight"), value("port"), value("target")}
+		}
+		if len(fields) == 3 {
+			fields[2] = normalizeDNSTarget(fields[2])
+		}
+		record.Content = strings.Join(fields, " ")
+		if r.Priority != nil {
+			record.Priority = int(*r.Priority)
+		} else if p, err := strconv.Atoi(value("priority")); err == nil {
+			record.Priority = p
+		}
+	case "CAA":
+		content := r.Content
+		if data != nil {
+			content = fmt.Sprintf("%s %s %s", value("flags"), value("tag"), strconv.Quote(value("value")))
+		}
+		if flags, tag, v, err := splitCAAContent(content); err == nil {
+			record.Content = fmt.Sprintf("%d %s %s", flags, tag, strconv.Quote(v))
+		}
+	}
+
+	return record
+}
+
+// dnsRecordSetRecordToAPI builds the API request for a record.
+func dnsRecordSetRecordToAPI(record dnsRecordSetRecord) cloudflare.DNSRecord {
+	r := cloudflare.DNSRecord{
+		Name:    record.Name,
+		Type:    record.Type,
+		Content: record.Content,
+		TTL:     record.TTL,
+	}
+
+	switch record.Type {
+	case "A", "AAAA", "CNAME":
+		r.Proxied = &[]bool{record.Proxied}[0]
+	case "MX":
+		p := uint16(record.Priority)
+		r.Priority = &p
+	case "SRV":
+		service, proto, name, _ := splitSRVName(record.Name)
+		fields := strings.Fields(record.Content)
+		weight, _ := strconv.Atoi(fields[0])
+		port, _ := strconv.Atoi(fields[1])
+		r.Content = ""
+		r.Data = map[string]interface{}{
+			"service":  service,
+			"proto":    proto,
+			"name":     name,
+			"priority": record.Priority,
+			"weight":   weight,
+			"port":     port,
+			"target":   fields[2],
+		}
+	case "CAA":
+		flags, tag, value, _ := splitCAAContent(record.Content)
+		r.Content = ""
+		r.Data = map[string]interface{}{
+			"flags": flags,
+			"tag":   tag,
+			"value": value,
+		}
+	}
+
+	return r
+}
+
+// dnsRecordSetPlan holds the changes needed to go from the current records
+// to the desired ones. Records in Update carry the ID of the record they
+// replace.
+type dnsRecordSetPlan struct {
+	Unchanged []dnsRecordSetRecord
+	Create    []dnsRecordSetRecord
+	Update    []dnsRecordSetRecord
+	Delete    []dnsRecordSetRecord
+}
+
+// planDNSRecordSet matches the desired records against the records currently
+// managed by the resource, preferring exact matches, then records with the
+// same content and finally records with the same name and type so that as
+// few records as possible are recreated. Records which already exist in the
+// zone but aren't managed yet are adopted when they match exactly.
+func planDNSRecordSet(current, untracked, desired []dnsRecordSetRecord) dnsRecordSetPlan {
+	var plan dnsRecordSetPlan
+
+	remaining := append([]dnsRecordSetRecord(nil), current...)
+	matched := make([]bool, len(desired))
+
+	match := func(candidates *[]dnsRecordSetRecord, same func(a, b dnsRecordSetRecord) bool, result *[]dnsRecordSetRecord) {
+		for i, want := range desired {
+			if matched[i] {
+				continue
+			}
+			for j, have := range *candidates {
+				if !same(have, want) {
+					continue
+				}
+				want.ID = have.ID
+				*result = append(*result, want)
+				*candidates = append((*candidates)[:j], (*candidates)[j+1:]...)
+				matched[i] = true
+				break
+			}
+		}
+	}
+
+	match(&remaining, dnsRecordSetRecord.equal, &plan.Unchanged)
+	match(&remaining, func(a, b dnsRecordSetRecord) bool { return a.key() == b.key() }, &plan.Update)
+	match(&remaining, func(a, b dnsRecordSetRecord) bool { return a.Name == b.Name && a.Type == b.Type }, &plan.Update)
+
+	adoptable := append([]dnsRecordSetRecord(nil), untracked...)
+	match(&adoptable, dnsRecordSetRecord.equal, &plan.Unchanged)
+
+	for i, want := range desired {
+		if !matched[i] {
+			plan.Create = append(plan.Create, want)
+		}
+	}
+
+	plan.Delete = remaining
+
+	return plan
+}
+
+// applyDNSRecordSetPlan deletes, updates and then creates the records. The
+// records that exist once it finishes are returned even on error so they
+// remain tracked.
+func applyDNSRecordSetPlan(ctx context.Context, client *providerClient, zoneID string, plan dnsRecordSetPlan) ([]dnsRecordSetRecord, error) {
+	records := append([]dnsRecordSetRecord(nil), plan.Unchanged...)
+
+	for i, record := range plan.Delete {
+		log.Printf("[INFO] Deleting Cloudflare Record: %s, %s", zoneID, record.ID)
+
+		if err := client.DeleteDNSRecord(ctx, zoneID, record.ID); err != nil && !isDNSRecordNotFound(err) {
+			records = append(records, plan.Delete[i:]...)
+			records = append(records, plan.Update...)
+			return records, fmt.Errorf("error deleting DNS record %s %s: %s", record.Type, record.Name, err)
+		}
+	}
+
+	for i, record := range plan.Update {
+		log.Printf("[DEBUG] Cloudflare Record update configuration: %#v", record)
+
+		if err := client.UpdateDNSRecord(ctx, zoneID, record.ID, dnsRecordSetRecordToAPI(record)); err != nil {
+			records = append(records, plan.Update[i:]...)
+			return records, fmt.Errorf("error updating DNS record %s %s: %s", record.Type, record.Name, err)
+		}
+		records = append(records, record)
+	}
+
+	for _, record := range plan.Create {
+		log.Printf("[DEBUG] Cloudflare Record create configuration: %#v", record)
+
+		r, err := client.CreateDNSRecord(ctx, zoneID, dnsRecordSetRecordToAPI(record))
+		if err != nil {
+			return records, fmt.Errorf("error creating DNS record %s %s: %s", record.Type, record.Name, err)
+		}
+		if r.Result.ID == "" {
+			return records, fmt.Errorf("failed to find record in create response for %s %s; record was empty", record.Type, record.Name)
+		}
+
+		record.ID = r.Result.ID
+		records = append(records, record)
+	}
+
+	sortDNSRecordSetRecords(records)
+
+	return records, nil
+}
+
+func isDNSRecordNotFound(err error) bool {
+	return strings.Contains(err.Error(), "Invalid dns record identifier") ||
+		strings.Contains(err.Error(), "HTTP status 404")
+}
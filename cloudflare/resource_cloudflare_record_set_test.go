@@ -0,0 +1,266 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareRecordSet_ZoneFile(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	resourceName := fmt.Sprintf("cloudflare_record_set.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordSetConfigZoneFile(zoneID, rnd, "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+					testAccCheckCloudflareRecordSetRecord(resourceName, fmt.Sprintf("%s.%s", rnd, zoneName), "A", "192.0.2.1"),
+					testAccCheckCloudflareRecordSetRecord(resourceName, fmt.Sprintf("%s.%s", rnd, zoneName), "MX", fmt.Sprintf("mail.%s", zoneName)),
+					testAccCheckCloudflareRecordSetRecord(resourceName, fmt.Sprintf("www.%s.%s", rnd, zoneName), "CNAME", fmt.Sprintf("%s.%s", rnd, zoneName)),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareRecordSetConfigZoneFile(zoneID, rnd, "192.0.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+					testAccCheckCloudflareRecordSetRecord(resourceName, fmt.Sprintf("%s.%s", rnd, zoneName), "A", "192.0.2.2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecordSet_Records(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	resourceName := fmt.Sprintf("cloudflare_record_set.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordSetConfigRecords(zoneID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "2"),
+					testAccCheckCloudflareRecordSetRecord(resourceName, fmt.Sprintf("%s.%s", rnd, zoneName), "TXT", "v=spf1 -all"),
+					testAccCheckCloudflareRecordSetRecord(resourceName, fmt.Sprintf("%s.%s", rnd, zoneName), "AAAA", "2001:db8::1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareRecordSetRecord(n, name, recordType, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+		records, err := client.DNSRecords(context.Background(), rs.Primary.ID, cloudflare.DNSRecord{Name: name, Type: recordType})
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			if record.Content == content {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s record %s with content %q not found", recordType, name, content)
+	}
+}
+
+func testAccCheckCloudflareRecordSetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_record_set" {
+			continue
+		}
+
+		for key, id := range rs.Primary.Attributes {
+			if !strings.HasSuffix(key, ".id") {
+				continue
+			}
+
+			_, err := client.DNSRecord(context.Background(), rs.Primary.ID, id)
+			if err == nil {
+				return fmt.Errorf("record %s still exists", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudflareRecordSetConfigZoneFile(zoneID, rnd, ip string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record_set" "%[2]s" {
+	zone_id   = "%[1]s"
+	zone_file = <<EOT
+; managed by terraform
+%[2]s      300 IN A     %[3]s
+%[2]s          IN MX    10 mail
+www.%[2]s      IN CNAME %[2]s ; cf_tags=cf-proxied:true
+EOT
+}`, zoneID, rnd, ip)
+}
+
+func testAccCheckCloudflareRecordSetConfigRecords(zoneID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record_set" "%[2]s" {
+	zone_id = "%[1]s"
+
+	record {
+		name  = "%[2]s"
+		type  = "TXT"
+		value = "v=spf1 -all"
+	}
+
+	record {
+		name  = "%[2]s"
+		type  = "AAAA"
+		value = "2001:db8::1"
+		ttl   = 3600
+	}
+}`, zoneID, rnd)
+}
+
+func TestPlanDNSRecordSet(t *testing.T) {
+	current := []dnsRecordSetRecord{
+		{ID: "1", Name: "example.com", Type: "A", Content: "192.0.2.1", TTL: 1},
+		{ID: "2", Name: "example.com", Type: "A", Content: "192.0.2.2", TTL: 1},
+		{ID: "3", Name: "www.example.com", Type: "CNAME", Content: "example.com", TTL: 1},
+		{ID: "4", Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 1, Priority: 10},
+		{ID: "5", Name: "old.example.com", Type: "TXT", Content: "old", TTL: 1},
+	}
+	untracked := []dnsRecordSetRecord{
+		{ID: "6", Name: "adopt.example.com", Type: "A", Content: "192.0.2.6", TTL: 1},
+		{ID: "7", Name: "other.example.com", Type: "A", Content: "192.0.2.7", TTL: 1},
+	}
+	desired := []dnsRecordSetRecord{
+		{Name: "example.com", Type: "A", Content: "192.0.2.3", TTL: 1},
+		{Name: "example.com", Type: "A", Content: "192.0.2.1", TTL: 1},
+		{Name: "www.example.com", Type: "CNAME", Content: "example.com", TTL: 1, Proxied: true},
+		{Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 1, Priority: 10},
+		{Name: "adopt.example.com", Type: "A", Content: "192.0.2.6", TTL: 1},
+		{Name: "new.example.com", Type: "TXT", Content: "new", TTL: 1},
+	}
+
+	plan := planDNSRecordSet(current, untracked, desired)
+
+	expected := dnsRecordSetPlan{
+		Unchanged: []dnsRecordSetRecord{
+			{ID: "1", Name: "example.com", Type: "A", Content: "192.0.2.1", TTL: 1},
+			{ID: "4", Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 1, Priority: 10},
+			{ID: "6", Name: "adopt.example.com", Type: "A", Content: "192.0.2.6", TTL: 1},
+		},
+		Update: []dnsRecordSetRecord{
+			{ID: "3", Name: "www.example.com", Type: "CNAME", Content: "example.com", TTL: 1, Proxied: true},
+			{ID: "2", Name: "example.com", Type: "A", Content: "192.0.2.3", TTL: 1},
+		},
+		Create: []dnsRecordSetRecord{
+			{Name: "new.example.com", Type: "TXT", Content: "new", TTL: 1},
+		},
+		Delete: []dnsRecordSetRecord{
+			{ID: "5", Name: "old.example.com", Type: "TXT", Content: "old", TTL: 1},
+		},
+	}
+
+	if !reflect.DeepEqual(plan, expected) {
+		t.Errorf("unexpected plan:\n got: %+v\nwant: %+v", plan, expected)
+	}
+}
+
+func TestDNSRecordSetRecordAPI(t *testing.T) {
+	priority := uint16(10)
+	proxied := true
+
+	records := map[string]cloudflare.DNSRecord{
+		`5 5060 sip.example.com`: {
+			Type:     "SRV",
+			Name:     "_sip._tcp.example.com",
+			Content:  "5\t5060\tsip.example.com",
+			Priority: &priority,
+			Data: map[string]interface{}{
+				"service":  "_sip",
+				"proto":    "_tcp",
+				"name":     "example.com",
+				"priority": float64(10),
+				"weight":   float64(5),
+				"port":     float64(5060),
+				"target":   "sip.example.com",
+			},
+		},
+		`0 issue "letsencrypt.org"`: {
+			Type:    "CAA",
+			Name:    "example.com",
+			Content: `0 issue "letsencrypt.org"`,
+			Data: map[string]interface{}{
+				"flags": float64(0),
+				"tag":   "issue",
+				"value": "letsencrypt.org",
+			},
+		},
+		`target.example.com`: {
+			Type:    "CNAME",
+			Name:    "WWW.example.com",
+			Content: "Target.example.com",
+			Proxied: &proxied,
+		},
+	}
+
+	for content, apiRecord := range records {
+		record := dnsRecordSetRecordFromAPI(apiRecord)
+		if record.Content != content {
+			t.Errorf("%s record content should be %q but got %q", apiRecord.Type, content, record.Content)
+		}
+
+		request := dnsRecordSetRecordToAPI(record)
+		if apiRecord.Data != nil && !reflect.DeepEqual(fmt.Sprint(request.Data), fmt.Sprint(apiRecord.Data)) {
+			t.Errorf("%s record data should be %v but got %v", apiRecord.Type, apiRecord.Data, request.Data)
+		}
+	}
+}
+
+func TestFilterDNSRecordSetRecords(t *testing.T) {
+	records := []dnsRecordSetRecord{
+		{ID: "1", Name: "example.com", Type: "A", Content: "192.0.2.1"},
+		{ID: "2", Name: "example.com", Type: "MX", Content: "mail.example.com", Priority: 10},
+		{ID: "3", Name: "www.example.com", Type: "CNAME", Content: "example.com"},
+	}
+
+	if got := filterDNSRecordSetRecords(records, "example.com", ""); len(got) != 2 || got[0].ID != "1" || got[1].ID != "2" {
+		t.Errorf("expected the records named example.com, got %+v", got)
+	}
+
+	if got := filterDNSRecordSetRecords(records, "example.com", "MX"); len(got) != 1 || got[0].ID != "2" {
+		t.Errorf("expected the MX record of example.com, got %+v", got)
+	}
+
+	if got := filterDNSRecordSetRecords(records, "mail.example.com", ""); len(got) != 0 {
+		t.Errorf("expected no records, got %+v", got)
+	}
+}
@@ -7,9 +7,12 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -23,12 +26,12 @@ const (
 
 func resourceCloudflareRuleset() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareRulesetCreate,
-		Read:   resourceCloudflareRulesetRead,
-		Update: resourceCloudflareRulesetUpdate,
-		Delete: resourceCloudflareRulesetDelete,
+		CreateContext: resourceCloudflareRulesetCreate,
+		ReadContext:   resourceCloudflareRulesetRead,
+		UpdateContext: resourceCloudflareRulesetUpdate,
+		DeleteContext: resourceCloudflareRulesetDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareRulesetImport,
+			StateContext: resourceCloudflareRulesetImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,282 +71,241 @@ func resourceCloudflareRuleset() *schema.Resource {
 			"rules": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"version": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"ref": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"enabled": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-						"action": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-						},
-						"expression": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"description": {
-							Type:     schema.TypeString,
-							Required: true,
+				Elem:     RulesetRuleSchemaElement,
+			},
+		},
+	}
+}
+
+// RulesetRuleSchemaElement is used by the `rules` of both `cloudflare_ruleset`
+// and `cloudflare_ruleset_phase_entrypoint` resources.
+var RulesetRuleSchemaElement = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ref": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"action": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
+		},
+		"expression": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateWirefilterExpression(wirefilterRulesScheme),
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"action_parameters": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"products": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
 						},
-						"action_parameters": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"id": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"products": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-									"uri": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"path": {
-													Type:     schema.TypeList,
-													Optional: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"value": {
-																Type:     schema.TypeString,
-																Optional: true,
-															},
-															"expression": {
-																Type:     schema.TypeString,
-																Optional: true,
-															},
-														},
-													},
-												},
-												"query": {
-													Type:     schema.TypeList,
-													Optional: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"value": {
-																Type:     schema.TypeString,
-																Optional: true,
-															},
-															"expression": {
-																Type:     schema.TypeString,
-																Optional: true,
-															},
-														},
-													},
-												},
-												"origin": {
-													Type:     schema.TypeBool,
-													Optional: true,
-												},
+					},
+					"uri": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"path": {
+									Type:     schema.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"value": {
+												Type:     schema.TypeString,
+												Optional: true,
 											},
-										},
-									},
-									"headers": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"name": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"value": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"expression": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"operation": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
+											"expression": {
+												Type:         schema.TypeString,
+												Optional:     true,
+												ValidateFunc: validateWirefilterExpressionType(wirefilterRulesScheme, wirefilterBytes),
 											},
 										},
 									},
-									"increment": {
-										Type:     schema.TypeInt,
-										Optional: true,
-									},
-									"version": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Computed: true,
-									},
-									"ruleset": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"rulesets": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-									"rules": {
-										Type:     schema.TypeMap,
-										Optional: true,
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-									"overrides": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"enabled": {
-													Type:     schema.TypeBool,
-													Optional: true,
-												},
-												"action": {
-													Type:         schema.TypeString,
-													Optional:     true,
-													ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-												},
-												"categories": {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"category": {
-																Type:     schema.TypeString,
-																Optional: true,
-															},
-															"action": {
-																Type:         schema.TypeString,
-																Optional:     true,
-																ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-															},
-															"enabled": {
-																Type:     schema.TypeBool,
-																Optional: true,
-															},
-														},
-													},
-												},
-												"rules": {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"id": {
-																Type:     schema.TypeString,
-																Optional: true,
-															},
-															"action": {
-																Type:         schema.TypeString,
-																Optional:     true,
-																ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-															},
-															"enabled": {
-																Type:     schema.TypeBool,
-																Optional: true,
-															},
-															"score_threshold": {
-																Type:     schema.TypeInt,
-																Optional: true,
-															},
-															"sensitivity_level": {
-																Type:     schema.TypeString,
-																Optional: true,
-															},
-														},
-													},
-												},
+								},
+								"query": {
+									Type:     schema.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"value": {
+												Type:     schema.TypeString,
+												Optional: true,
 											},
-										},
-									},
-									"matched_data": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"public_key": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
+											"expression": {
+												Type:         schema.TypeString,
+												Optional:     true,
+												ValidateFunc: validateWirefilterExpressionType(wirefilterRulesScheme, wirefilterBytes),
 											},
 										},
 									},
 								},
+								"origin": {
+									Type:     schema.TypeBool,
+									Optional: true,
+								},
 							},
 						},
-						"ratelimit": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"characteristics": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
+					},
+					"headers": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"value": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"expression": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validateWirefilterExpressionType(wirefilterRulesScheme, wirefilterBytes),
+								},
+								"operation": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+					"increment": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"version": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"ruleset": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"rulesets": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"rules": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"overrides": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"enabled": {
+									Type:     schema.TypeBool,
+									Optional: true,
+								},
+								"action": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
+								},
+								"categories": {
+									Type:     schema.TypeList,
+									Optional: true,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"category": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"action": {
+												Type:         schema.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
+											},
+											"enabled": {
+												Type:     schema.TypeBool,
+												Optional: true,
+											},
 										},
 									},
-									"period": {
-										Type:     schema.TypeInt,
-										Optional: true,
-									},
-									"requests_per_period": {
-										Type:     schema.TypeInt,
-										Optional: true,
-									},
-									"mitigation_timeout": {
-										Type:     schema.TypeInt,
-										Optional: true,
-									},
-									"mitigation_expression": {
-										Type:     schema.TypeString,
-										Optional: true,
+								},
+								"rules": {
+									Type:     schema.TypeList,
+									Optional: true,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"id": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"action": {
+												Type:         schema.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
+											},
+											"enabled": {
+												Type:     schema.TypeBool,
+												Optional: true,
+											},
+											"score_threshold": {
+												Type:     schema.TypeInt,
+												Optional: true,
+											},
+											"sensitivity_level": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+										},
 									},
 								},
 							},
 						},
-						"exposed_credential_check": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"username_expression": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"password_expression": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
+					},
+					"matched_data": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"public_key": {
+									Type:     schema.TypeString,
+									Optional: true,
 								},
 							},
 						},
@@ -351,11 +313,62 @@ func resourceCloudflareRuleset() *schema.Resource {
 				},
 			},
 		},
-	}
+		"ratelimit": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"characteristics": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"period": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"requests_per_period": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"mitigation_timeout": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"mitigation_expression": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"exposed_credential_check": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"username_expression": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateWirefilterExpressionType(wirefilterRulesScheme, wirefilterBytes),
+					},
+					"password_expression": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateWirefilterExpressionType(wirefilterRulesScheme, wirefilterBytes),
+					},
+				},
+			},
+		},
+	},
 }
 
-func resourceCloudflareRulesetCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
 
@@ -369,9 +382,9 @@ func resourceCloudflareRulesetCreate(d *schema.ResourceData, meta interface{}) e
 		Phase:       d.Get("phase").(string),
 	}
 
-	rules, err := buildRulesetRulesFromResource(d)
-	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error building ruleset from resource"))
+	rules, diags := buildRulesetRulesFromResource(d)
+	if diags.HasError() {
+		return diags
 	}
 
 	if len(rules) > 0 {
@@ -379,10 +392,11 @@ func resourceCloudflareRulesetCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	var ruleset cloudflare.Ruleset
+	var err error
 	if accountID != "" {
-		ruleset, err = client.CreateAccountRuleset(context.Background(), accountID, rs)
+		ruleset, err = client.CreateAccountRuleset(ctx, accountID, rs)
 	} else {
-		ruleset, err = client.CreateZoneRuleset(context.Background(), zoneID, rs)
+		ruleset, err = client.CreateZoneRuleset(ctx, zoneID, rs)
 	}
 
 	if err != nil {
@@ -391,10 +405,10 @@ func resourceCloudflareRulesetCreate(d *schema.ResourceData, meta interface{}) e
 			if accountID == "" {
 				deleteRulesetURL = zoneLevelRulesetDeleteURL
 			}
-			return fmt.Errorf(duplicateRulesetError, rulesetName, deleteRulesetURL)
+			return diag.Errorf(duplicateRulesetError, rulesetName, deleteRulesetURL)
 		}
 
-		return errors.Wrap(err, fmt.Sprintf("error creating ruleset %s", rulesetName))
+		return rulesetErrorDiagnostics(err, fmt.Sprintf("error creating ruleset %s", rulesetName))
 	}
 
 	rulesetEntryPoint := cloudflare.Ruleset{
@@ -406,27 +420,111 @@ func resourceCloudflareRulesetCreate(d *schema.ResourceData, meta interface{}) e
 	// endpoint.
 	if rulesetKind != string(cloudflare.RulesetKindCustom) {
 		if accountID != "" {
-			_, err = client.UpdateAccountRulesetPhase(context.Background(), accountID, rs.Phase, rulesetEntryPoint)
+			_, err = client.UpdateAccountRulesetPhase(ctx, accountID, rs.Phase, rulesetEntryPoint)
 		} else {
-			_, err = client.UpdateZoneRulesetPhase(context.Background(), zoneID, rs.Phase, rulesetEntryPoint)
+			_, err = client.UpdateZoneRulesetPhase(ctx, zoneID, rs.Phase, rulesetEntryPoint)
 		}
 
 		if err != nil {
-			return errors.Wrap(err, fmt.Sprintf("error updating ruleset phase entrypoint %s", rulesetName))
+			return rulesetErrorDiagnostics(err, fmt.Sprintf("error updating ruleset phase entrypoint %s", rulesetName))
 		}
 	}
 
 	d.SetId(ruleset.ID)
 
-	return resourceCloudflareRulesetRead(d, meta)
+	return resourceCloudflareRulesetRead(ctx, d, meta)
+}
+
+// rulesetErrorRulePattern matches the rule an error of the Rulesets API refers
+// to, either as "rules[N]" or as the JSON pointer "/rules/N".
+var rulesetErrorRulePattern = regexp.MustCompile(`\brules(?:\[(\d+)\]|/(\d+))`)
+
+// rulesetErrorDiagnostics converts an error from writing a ruleset into
+// diagnostics. Errors about a specific rule are attached to that rule so they
+// point at the offending block of the configuration.
+func rulesetErrorDiagnostics(err error, summary string) diag.Diagnostics {
+	var apiErr *cloudflare.APIRequestError
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) == 0 {
+		return diag.FromErr(errors.Wrap(err, summary))
+	}
+
+	var diags diag.Diagnostics
+	for _, e := range apiErr.Errors {
+		detail := e.Message
+		if e.Code != 0 {
+			detail = fmt.Sprintf("%s (%d)", detail, e.Code)
+		}
+
+		diagnostic := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s: HTTP status %d", summary, apiErr.StatusCode),
+			Detail:   detail,
+		}
+		if index, ok := rulesetErrorRuleIndex(e.Message); ok {
+			diagnostic.AttributePath = cty.GetAttrPath("rules").IndexInt(index)
+		}
+
+		diags = append(diags, diagnostic)
+	}
+
+	return diags
+}
+
+// rulesetErrorRuleIndex returns the index of the rule the error message refers
+// to, if any.
+func rulesetErrorRuleIndex(message string) (int, bool) {
+	match := rulesetErrorRulePattern.FindStringSubmatch(message)
+	if match == nil {
+		return 0, false
+	}
+
+	index := match[1]
+	if index == "" {
+		index = match[2]
+	}
+
+	i, err := strconv.Atoi(index)
+	if err != nil {
+		return 0, false
+	}
+
+	return i, true
 }
 
-func resourceCloudflareRulesetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	return nil, errors.New("Import is not yet supported for Rulesets")
+func resourceCloudflareRulesetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zone/zoneID/rulesetID\" or \"account/accountID/rulesetID\"", d.Id())
+	}
+
+	identifierType, identifierID, rulesetID := attributes[0], attributes[1], attributes[2]
+
+	switch AccessIdentifierType(identifierType) {
+	case AccountType:
+		d.Set("account_id", identifierID)
+	case ZoneType:
+		d.Set("zone_id", identifierID)
+	default:
+		return nil, fmt.Errorf("invalid identifier type (\"%s\") specified, should be either \"zone\" or \"account\"", identifierType)
+	}
+
+	log.Printf("[DEBUG] Importing Cloudflare Ruleset: id %s for %s %s", rulesetID, identifierType, identifierID)
+
+	d.SetId(rulesetID)
+
+	if err := diagnosticsError(resourceCloudflareRulesetRead(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("ruleset %q not found for %s %s", rulesetID, identifierType, identifierID)
+	}
+
+	return []*schema.ResourceData{d}, nil
 }
 
-func resourceCloudflareRulesetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareRulesetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
 
@@ -434,9 +532,9 @@ func resourceCloudflareRulesetRead(d *schema.ResourceData, meta interface{}) err
 	var err error
 
 	if accountID != "" {
-		ruleset, err = client.GetAccountRuleset(context.Background(), accountID, d.Id())
+		ruleset, err = client.GetAccountRuleset(ctx, accountID, d.Id())
 	} else {
-		ruleset, err = client.GetZoneRuleset(context.Background(), zoneID, d.Id())
+		ruleset, err = client.GetZoneRuleset(ctx, zoneID, d.Id())
 	}
 
 	if err != nil {
@@ -445,57 +543,64 @@ func resourceCloudflareRulesetRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return errors.Wrap(err, fmt.Sprintf("error reading ruleset ID: %s", d.Id()))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading ruleset ID: %s", d.Id())))
 	}
 
 	d.Set("name", ruleset.Name)
 	d.Set("description", ruleset.Description)
+	d.Set("kind", ruleset.Kind)
+	d.Set("phase", ruleset.Phase)
+
+	if ruleset.ShareableEntitlementName != "" {
+		d.Set("shareable_entitlement_name", ruleset.ShareableEntitlementName)
+	}
 
 	if err := d.Set("rules", buildStateFromRulesetRules(ruleset.Rules)); err != nil {
-		log.Fatalf("failed to set rules: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("rules"), fmt.Errorf("failed to set rules: %s", err))
 	}
 
 	return nil
 }
 
-func resourceCloudflareRulesetUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareRulesetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
 
-	rules, err := buildRulesetRulesFromResource(d)
-	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error building ruleset from resource"))
+	rules, diags := buildRulesetRulesFromResource(d)
+	if diags.HasError() {
+		return diags
 	}
 
+	var err error
 	description := d.Get("description").(string)
 	if accountID != "" {
-		_, err = client.UpdateAccountRuleset(context.Background(), accountID, d.Id(), description, rules)
+		_, err = client.UpdateAccountRuleset(ctx, accountID, d.Id(), description, rules)
 	} else {
-		_, err = client.UpdateZoneRuleset(context.Background(), zoneID, d.Id(), description, rules)
+		_, err = client.UpdateZoneRuleset(ctx, zoneID, d.Id(), description, rules)
 	}
 
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error updating ruleset with ID %q", d.Id()))
+		return rulesetErrorDiagnostics(err, fmt.Sprintf("error updating ruleset with ID %q", d.Id()))
 	}
 
-	return resourceCloudflareRulesetRead(d, meta)
+	return resourceCloudflareRulesetRead(ctx, d, meta)
 }
 
-func resourceCloudflareRulesetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareRulesetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
 	var err error
 
 	if accountID != "" {
-		err = client.DeleteAccountRuleset(context.Background(), accountID, d.Id())
+		err = client.DeleteAccountRuleset(ctx, accountID, d.Id())
 	} else {
-		err = client.DeleteZoneRuleset(context.Background(), zoneID, d.Id())
+		err = client.DeleteZoneRuleset(ctx, zoneID, d.Id())
 	}
 
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error deleting ruleset with ID %q", d.Id()))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error deleting ruleset with ID %q", d.Id())))
 	}
 
 	return nil
@@ -633,10 +738,11 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 			var rateLimit []map[string]interface{}
 
 			rateLimit = append(rateLimit, map[string]interface{}{
-				"characteristics":     r.RateLimit.Characteristics,
-				"period":              r.RateLimit.Period,
-				"requests_per_period": r.RateLimit.RequestsPerPeriod,
-				"mitigation_timeout":  r.RateLimit.MitigationTimeout,
+				"characteristics":       r.RateLimit.Characteristics,
+				"period":                r.RateLimit.Period,
+				"requests_per_period":   r.RateLimit.RequestsPerPeriod,
+				"mitigation_timeout":    r.RateLimit.MitigationTimeout,
+				"mitigation_expression": r.RateLimit.MitigationExpression,
 			})
 
 			rule["ratelimit"] = rateLimit
@@ -659,13 +765,14 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 	return rulesData
 }
 
-// receives the resource config and builds a ruleset rule array
-func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.RulesetRule, error) {
+// receives the resource config and builds a ruleset rule array. Any
+// diagnostics point at the offending rule.
+func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.RulesetRule, diag.Diagnostics) {
 	var rulesetRules []cloudflare.RulesetRule
 
 	rules, ok := d.Get("rules").([]interface{})
 	if !ok {
-		return nil, errors.New("unable to create interface array type assertion")
+		return nil, attributeErrorDiagnostic(cty.GetAttrPath("rules"), errors.New("error building ruleset from resource: unable to create interface array type assertion"))
 	}
 
 	for rulesCounter, v := range rules {
@@ -673,7 +780,7 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 
 		resourceRule, ok := v.(map[string]interface{})
 		if !ok {
-			return nil, errors.New("unable to create interface map type assertion for rule")
+			return nil, attributeErrorDiagnostic(cty.GetAttrPath("rules").IndexInt(rulesCounter), errors.New("error building ruleset from resource: unable to create interface map type assertion for rule"))
 		}
 
 		if len(resourceRule["action_parameters"].([]interface{})) > 0 {
@@ -0,0 +1,296 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+const (
+	rulesetPhaseEntrypointOnDestroyEmpty   = "empty"
+	rulesetPhaseEntrypointOnDestroyRestore = "restore"
+)
+
+func resourceCloudflareRulesetPhaseEntrypoint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareRulesetPhaseEntrypointCreate,
+		ReadContext:   resourceCloudflareRulesetPhaseEntrypointRead,
+		UpdateContext: resourceCloudflareRulesetPhaseEntrypointUpdate,
+		DeleteContext: resourceCloudflareRulesetPhaseEntrypointDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareRulesetPhaseEntrypointImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"zone_id", "account_id"},
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"zone_id", "account_id"},
+			},
+			"phase": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cloudflare.RulesetPhaseValues(), false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"on_destroy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      rulesetPhaseEntrypointOnDestroyEmpty,
+				ValidateFunc: validation.StringInSlice([]string{rulesetPhaseEntrypointOnDestroyEmpty, rulesetPhaseEntrypointOnDestroyRestore}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"initial_ruleset": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     RulesetRuleSchemaElement,
+			},
+		},
+	}
+}
+
+func resourceCloudflareRulesetPhaseEntrypointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	phase := d.Get("phase").(string)
+
+	// Adopt the existing entrypoint (if there is one) and take a snapshot of it
+	// so that it can be restored when this resource is destroyed.
+	current, err := getRulesetPhaseEntrypoint(ctx, d, client, phase)
+	if err != nil && !isRulesetPhaseEntrypointNotFound(err) {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading ruleset phase entrypoint %q", phase)))
+	}
+
+	initialRuleset, err := json.Marshal(rulesetPhaseEntrypointSnapshot(current))
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error building snapshot of ruleset phase entrypoint %q", phase)))
+	}
+	d.Set("initial_ruleset", string(initialRuleset))
+
+	rules, diags := buildRulesetRulesFromResource(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	ruleset, err := updateRulesetPhaseEntrypoint(ctx, d, client, phase, rules)
+	if err != nil {
+		return rulesetErrorDiagnostics(err, fmt.Sprintf("error updating ruleset phase entrypoint %q", phase))
+	}
+
+	d.SetId(ruleset.ID)
+
+	return resourceCloudflareRulesetPhaseEntrypointRead(ctx, d, meta)
+}
+
+func resourceCloudflareRulesetPhaseEntrypointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	phase := d.Get("phase").(string)
+
+	ruleset, err := getRulesetPhaseEntrypoint(ctx, d, client, phase)
+	if err != nil {
+		if isRulesetPhaseEntrypointNotFound(err) {
+			log.Printf("[INFO] Ruleset phase entrypoint %q no longer exists", phase)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading ruleset phase entrypoint %q", phase)))
+	}
+
+	return setRulesetPhaseEntrypointState(d, ruleset)
+}
+
+// setRulesetPhaseEntrypointState sets the state from the entrypoint ruleset.
+func setRulesetPhaseEntrypointState(d *schema.ResourceData, ruleset cloudflare.Ruleset) diag.Diagnostics {
+	// The entrypoint may have been recreated outside of Terraform in which case
+	// we want to track the new ruleset ID.
+	d.SetId(ruleset.ID)
+	d.Set("name", ruleset.Name)
+	d.Set("kind", ruleset.Kind)
+	d.Set("description", ruleset.Description)
+
+	if err := d.Set("rules", buildStateFromRulesetRules(ruleset.Rules)); err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("rules"), fmt.Errorf("failed to set rules: %s", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareRulesetPhaseEntrypointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	phase := d.Get("phase").(string)
+
+	rules, diags := buildRulesetRulesFromResource(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := updateRulesetPhaseEntrypoint(ctx, d, client, phase, rules); err != nil {
+		return rulesetErrorDiagnostics(err, fmt.Sprintf("error updating ruleset phase entrypoint %q", phase))
+	}
+
+	return resourceCloudflareRulesetPhaseEntrypointRead(ctx, d, meta)
+}
+
+func resourceCloudflareRulesetPhaseEntrypointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	phase := d.Get("phase").(string)
+
+	// The entrypoint itself can't be removed by a phase, only the rules within
+	// it so we either empty it or put back what was there before.
+	ruleset := cloudflare.Ruleset{
+		Description: d.Get("description").(string),
+		Rules:       []cloudflare.RulesetRule{},
+	}
+
+	if d.Get("on_destroy").(string) == rulesetPhaseEntrypointOnDestroyRestore {
+		if initialRuleset := d.Get("initial_ruleset").(string); initialRuleset != "" {
+			if err := json.Unmarshal([]byte(initialRuleset), &ruleset); err != nil {
+				return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error decoding snapshot of ruleset phase entrypoint %q", phase)))
+			}
+		}
+
+		if ruleset.Rules == nil {
+			ruleset.Rules = []cloudflare.RulesetRule{}
+		}
+	}
+
+	log.Printf("[DEBUG] Resetting ruleset phase entrypoint %q using %q with %d rules", phase, d.Get("on_destroy").(string), len(ruleset.Rules))
+
+	var err error
+	if accountID := d.Get("account_id").(string); accountID != "" {
+		_, err = client.UpdateAccountRulesetPhase(ctx, accountID, phase, ruleset)
+	} else {
+		_, err = client.UpdateZoneRulesetPhase(ctx, d.Get("zone_id").(string), phase, ruleset)
+	}
+
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error resetting ruleset phase entrypoint %q", phase)))
+	}
+
+	return nil
+}
+
+func resourceCloudflareRulesetPhaseEntrypointImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zone/zoneID/phase\" or \"account/accountID/phase\"", d.Id())
+	}
+
+	identifierType, identifierID, phase := attributes[0], attributes[1], attributes[2]
+
+	switch AccessIdentifierType(identifierType) {
+	case AccountType:
+		d.Set("account_id", identifierID)
+	case ZoneType:
+		d.Set("zone_id", identifierID)
+	default:
+		return nil, fmt.Errorf("invalid identifier type (\"%s\") specified, should be either \"zone\" or \"account\"", identifierType)
+	}
+
+	log.Printf("[DEBUG] Importing Cloudflare Ruleset phase entrypoint: phase %s for %s %s", phase, identifierType, identifierID)
+
+	d.Set("phase", phase)
+	d.Set("on_destroy", rulesetPhaseEntrypointOnDestroyEmpty)
+
+	current, err := getRulesetPhaseEntrypoint(ctx, d, meta.(*providerClient), phase)
+	if err != nil {
+		if isRulesetPhaseEntrypointNotFound(err) {
+			return nil, fmt.Errorf("ruleset phase entrypoint %q not found for %s %s", phase, identifierType, identifierID)
+		}
+		return nil, errors.Wrap(err, fmt.Sprintf("error reading ruleset phase entrypoint %q", phase))
+	}
+
+	if err := diagnosticsError(setRulesetPhaseEntrypointState(d, current)); err != nil {
+		return nil, err
+	}
+
+	// Whatever is in the entrypoint at import time is considered to be the
+	// initial state.
+	initialRuleset, err := json.Marshal(rulesetPhaseEntrypointSnapshot(current))
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("error building snapshot of ruleset phase entrypoint %q", phase))
+	}
+	d.Set("initial_ruleset", string(initialRuleset))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getRulesetPhaseEntrypoint fetches the entrypoint ruleset for the phase at
+// either the account or zone level depending on the resource configuration.
+func getRulesetPhaseEntrypoint(ctx context.Context, d *schema.ResourceData, client *providerClient, phase string) (cloudflare.Ruleset, error) {
+	if accountID := d.Get("account_id").(string); accountID != "" {
+		return client.GetAccountRulesetPhase(ctx, accountID, phase)
+	}
+
+	return client.GetZoneRulesetPhase(ctx, d.Get("zone_id").(string), phase)
+}
+
+// updateRulesetPhaseEntrypoint replaces the rules of the phase entrypoint with
+// the ones built from the resource configuration.
+func updateRulesetPhaseEntrypoint(ctx context.Context, d *schema.ResourceData, client *providerClient, phase string, rules []cloudflare.RulesetRule) (cloudflare.Ruleset, error) {
+	if rules == nil {
+		rules = []cloudflare.RulesetRule{}
+	}
+
+	ruleset := cloudflare.Ruleset{
+		Description: d.Get("description").(string),
+		Rules:       rules,
+	}
+
+	if accountID := d.Get("account_id").(string); accountID != "" {
+		return client.UpdateAccountRulesetPhase(ctx, accountID, phase, ruleset)
+	}
+
+	return client.UpdateZoneRulesetPhase(ctx, d.Get("zone_id").(string), phase, ruleset)
+}
+
+// rulesetPhaseEntrypointSnapshot strips the server generated values from the
+// entrypoint so that the rules can be sent back as new rules later on.
+func rulesetPhaseEntrypointSnapshot(ruleset cloudflare.Ruleset) cloudflare.Ruleset {
+	rules := make([]cloudflare.RulesetRule, 0, len(ruleset.Rules))
+	for _, rule := range ruleset.Rules {
+		rule.ID = ""
+		rule.Version = ""
+		rule.LastUpdated = nil
+		rules = append(rules, rule)
+	}
+
+	return cloudflare.Ruleset{
+		Description: ruleset.Description,
+		Rules:       rules,
+	}
+}
+
+func isRulesetPhaseEntrypointNotFound(err error) bool {
+	return strings.Contains(err.Error(), "could not find entrypoint ruleset")
+}
@@ -0,0 +1,206 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRulesetPhaseEntrypoint_TransformationRuleHeaders(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset_phase_entrypoint." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetPhaseEntrypointTransformationRuleHeaders(rnd, zoneID, "my-http-header-value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_request_late_transform"),
+					resource.TestCheckResourceAttr(resourceName, "description", rnd+" phase entrypoint description"),
+					resource.TestCheckResourceAttr(resourceName, "on_destroy", "restore"),
+					resource.TestCheckResourceAttrSet(resourceName, "initial_ruleset"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "rewrite"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.0.name", "example1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.0.value", "my-http-header-value1"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareRulesetPhaseEntrypointTransformationRuleHeaders(rnd, zoneID, "my-http-header-value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.0.value", "my-http-header-value2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           fmt.Sprintf("zone/%s/http_request_late_transform", zoneID),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"initial_ruleset", "on_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRulesetPhaseEntrypoint_MagicTransit(t *testing.T) {
+	skipMagicTransitTestForNonConfiguredDefaultZone(t)
+
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	resourceName := "cloudflare_ruleset_phase_entrypoint." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckAccount(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetPhaseEntrypointMagicTransit(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "magic_transit"),
+					resource.TestCheckResourceAttr(resourceName, "kind", "root"),
+					resource.TestCheckResourceAttr(resourceName, "on_destroy", "empty"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "allow"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.expression", "tcp.dstport in { 32768..65535 }"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.description", "Allow TCP Ephemeral Ports"),
+				),
+			},
+		},
+	})
+}
+
+func TestRulesetPhaseEntrypointSnapshot(t *testing.T) {
+	now := time.Now()
+	ruleset := cloudflare.Ruleset{
+		ID:          "2f2feab2026849078ba485f918791bdc",
+		Name:        "default",
+		Description: "entrypoint",
+		Kind:        "zone",
+		Phase:       "http_request_late_transform",
+		Rules: []cloudflare.RulesetRule{
+			{
+				ID:          "62449e2e0de149619edb35e59c10d801",
+				Version:     "1",
+				LastUpdated: &now,
+				Action:      "rewrite",
+				Expression:  "true",
+				Description: "example",
+				Enabled:     true,
+			},
+		},
+	}
+
+	snapshot := rulesetPhaseEntrypointSnapshot(ruleset)
+
+	if snapshot.ID != "" || snapshot.Name != "" || snapshot.Kind != "" || snapshot.Phase != "" {
+		t.Errorf("expected ruleset metadata to be stripped, got %+v", snapshot)
+	}
+
+	if snapshot.Description != "entrypoint" {
+		t.Errorf("expected description %q, got %q", "entrypoint", snapshot.Description)
+	}
+
+	if len(snapshot.Rules) != 1 {
+		t.Fatalf("expected 1 rule, got %d", len(snapshot.Rules))
+	}
+
+	rule := snapshot.Rules[0]
+	if rule.ID != "" || rule.Version != "" || rule.LastUpdated != nil {
+		t.Errorf("expected server generated rule values to be stripped, got %+v", rule)
+	}
+
+	if rule.Action != "rewrite" || rule.Expression != "true" || rule.Description != "example" || !rule.Enabled {
+		t.Errorf("expected rule configuration to be kept, got %+v", rule)
+	}
+
+	if ruleset.Rules[0].ID == "" {
+		t.Error("expected the original ruleset to be left untouched")
+	}
+}
+
+func TestRulesetPhaseEntrypointImport(t *testing.T) {
+	var requests int
+	client := testProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/zones/0da42c8d2132a9ddaf714f9e7c920711/rulesets/phases/http_request_late_transform/entrypoint" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"success":true,"errors":[],"result":{
+			"id":"70339d97bdb34195bbf054b1ebe81f76","name":"default","kind":"zone","phase":"http_request_late_transform",
+			"rules":[{"id":"1","action":"rewrite","expression":"true","description":"existing","enabled":true,
+				"action_parameters":{"headers":{"X-Example":{"operation":"set","value":"1"}}}}]}}`))
+	})
+
+	d := resourceCloudflareRulesetPhaseEntrypoint().TestResourceData()
+	d.SetId("zone/0da42c8d2132a9ddaf714f9e7c920711/http_request_late_transform")
+
+	imported, err := resourceCloudflareRulesetPhaseEntrypointImport(context.Background(), d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected the entrypoint to be fetched once, got %d requests", requests)
+	}
+	if imported[0].Id() != "70339d97bdb34195bbf054b1ebe81f76" || imported[0].Get("rules.#").(int) != 1 {
+		t.Errorf("expected the entrypoint to be read, got %s with %d rules", imported[0].Id(), imported[0].Get("rules.#").(int))
+	}
+	if snapshot := imported[0].Get("initial_ruleset").(string); !strings.Contains(snapshot, `"description":"existing"`) {
+		t.Errorf("expected the snapshot to hold the existing rules, got %s", snapshot)
+	}
+}
+
+func testAccCheckCloudflareRulesetPhaseEntrypointTransformationRuleHeaders(rnd, zoneID, headerValue string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset_phase_entrypoint" "%[1]s" {
+    zone_id     = "%[2]s"
+    phase       = "http_request_late_transform"
+    description = "%[1]s phase entrypoint description"
+    on_destroy  = "restore"
+
+    rules {
+      action = "rewrite"
+      action_parameters {
+        headers {
+          name      = "example1"
+          operation = "set"
+          value     = "%[3]s"
+        }
+      }
+
+      expression = "true"
+      description = "example header transformation rule"
+      enabled = false
+    }
+  }`, rnd, zoneID, headerValue)
+}
+
+func testAccCheckCloudflareRulesetPhaseEntrypointMagicTransit(rnd, accountID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset_phase_entrypoint" "%[1]s" {
+    account_id  = "%[2]s"
+    phase       = "magic_transit"
+
+    rules {
+      action = "allow"
+      expression = "tcp.dstport in { 32768..65535 }"
+      description = "Allow TCP Ephemeral Ports"
+    }
+  }`, rnd, accountID)
+}
@@ -1,13 +1,52 @@
 package cloudflare
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func TestRulesetErrorDiagnostics(t *testing.T) {
+	err := &cloudflare.APIRequestError{
+		StatusCode: 400,
+		Errors: []cloudflare.ResponseInfo{
+			{Code: 20021, Message: "'rules[1].action_parameters.id' is not a valid ruleset ID"},
+			{Message: "unknown field in /rules/3/action_parameters"},
+			{Message: "exceeded maximum number of rules"},
+		},
+	}
+
+	diags := rulesetErrorDiagnostics(err, "error updating ruleset")
+	if len(diags) != 3 {
+		t.Fatalf("expected a diagnostic per API error, got %d", len(diags))
+	}
+
+	expected := []cty.Path{
+		cty.GetAttrPath("rules").IndexInt(1),
+		cty.GetAttrPath("rules").IndexInt(3),
+		nil,
+	}
+	for i, path := range expected {
+		if !diags[i].AttributePath.Equals(path) {
+			t.Errorf("expected diagnostic %d to point at %#v, got %#v", i, path, diags[i].AttributePath)
+		}
+	}
+
+	if diags[0].Detail != "'rules[1].action_parameters.id' is not a valid ruleset ID (20021)" {
+		t.Errorf("unexpected detail: %s", diags[0].Detail)
+	}
+
+	diags = rulesetErrorDiagnostics(errors.New("HTTP request failed"), "error updating ruleset")
+	if len(diags) != 1 || diags[0].AttributePath != nil {
+		t.Errorf("expected a single diagnostic without a path, got %#v", diags)
+	}
+}
+
 func TestAccCloudflareRuleset_WAFBasic(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
 	// service does not yet support the API tokens and it results in
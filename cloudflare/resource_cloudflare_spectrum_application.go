@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -15,12 +16,12 @@ import (
 
 func resourceCloudflareSpectrumApplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareSpectrumApplicationCreate,
-		Read:   resourceCloudflareSpectrumApplicationRead,
-		Update: resourceCloudflareSpectrumApplicationUpdate,
-		Delete: resourceCloudflareSpectrumApplicationDelete,
+		CreateContext: resourceCloudflareSpectrumApplicationCreate,
+		ReadContext:   resourceCloudflareSpectrumApplicationRead,
+		UpdateContext: resourceCloudflareSpectrumApplicationUpdate,
+		DeleteContext: resourceCloudflareSpectrumApplicationDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareSpectrumApplicationImport,
+			StateContext: resourceCloudflareSpectrumApplicationImport,
 		},
 
 		SchemaVersion: 0,
@@ -157,60 +158,60 @@ func resourceCloudflareSpectrumApplication() *schema.Resource {
 	}
 }
 
-func resourceCloudflareSpectrumApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareSpectrumApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	newSpectrumApp := applicationFromResource(d)
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Creating Cloudflare Spectrum Application from struct: %+v", newSpectrumApp)
 
-	r, err := client.CreateSpectrumApplication(context.Background(), zoneID, newSpectrumApp)
+	r, err := client.CreateSpectrumApplication(ctx, zoneID, newSpectrumApp)
 	if err != nil {
-		return errors.Wrap(err, "error creating spectrum application for zone")
+		return diag.FromErr(errors.Wrap(err, "error creating spectrum application for zone"))
 	}
 
 	if r.ID == "" {
-		return fmt.Errorf("failed to find id in Create response; resource was empty")
+		return diag.Errorf("failed to find id in Create response; resource was empty")
 	}
 
 	d.SetId(r.ID)
 
 	log.Printf("[INFO] Cloudflare Spectrum Application ID: %s", d.Id())
 
-	return resourceCloudflareSpectrumApplicationRead(d, meta)
+	return resourceCloudflareSpectrumApplicationRead(ctx, d, meta)
 }
 
-func resourceCloudflareSpectrumApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareSpectrumApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	application := applicationFromResource(d)
 
 	log.Printf("[INFO] Updating Cloudflare Spectrum Application from struct: %+v", application)
 
-	_, err := client.UpdateSpectrumApplication(context.Background(), zoneID, application.ID, application)
+	_, err := client.UpdateSpectrumApplication(ctx, zoneID, application.ID, application)
 	if err != nil {
-		return errors.Wrap(err, "error creating spectrum application for zone")
+		return diag.FromErr(errors.Wrap(err, "error creating spectrum application for zone"))
 	}
 
-	return resourceCloudflareSpectrumApplicationRead(d, meta)
+	return resourceCloudflareSpectrumApplicationRead(ctx, d, meta)
 }
 
-func resourceCloudflareSpectrumApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareSpectrumApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	applicationID := d.Id()
 
-	application, err := client.SpectrumApplication(context.Background(), zoneID, applicationID)
+	application, err := client.SpectrumApplication(ctx, zoneID, applicationID)
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
 			log.Printf("[INFO] Spectrum application %s in zone %s not found", applicationID, zoneID)
 			d.SetId("")
 			return nil
 		}
-		return errors.Wrap(err,
-			fmt.Sprintf("Error reading spectrum application resource from API for resource %s in zone %s", applicationID, zoneID))
+		return diag.FromErr(errors.Wrap(err,
+			fmt.Sprintf("Error reading spectrum application resource from API for resource %s in zone %s", applicationID, zoneID)))
 	}
 
 	d.Set("protocol", application.Protocol)
@@ -256,22 +257,22 @@ func resourceCloudflareSpectrumApplicationRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceCloudflareSpectrumApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareSpectrumApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 	applicationID := d.Id()
 
 	log.Printf("[INFO] Deleting Cloudflare Spectrum Application: %s in zone: %s", applicationID, zoneID)
 
-	err := client.DeleteSpectrumApplication(context.Background(), zoneID, applicationID)
+	err := client.DeleteSpectrumApplication(ctx, zoneID, applicationID)
 	if err != nil {
-		return fmt.Errorf("error deleting Cloudflare Spectrum Application: %s", err)
+		return diag.Errorf("error deleting Cloudflare Spectrum Application: %s", err)
 	}
 
 	return nil
 }
 
-func resourceCloudflareSpectrumApplicationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareSpectrumApplicationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
 	var zoneID string
@@ -286,7 +287,7 @@ func resourceCloudflareSpectrumApplicationImport(d *schema.ResourceData, meta in
 	d.Set("zone_id", zoneID)
 	d.SetId(applicationID)
 
-	resourceCloudflareSpectrumApplicationRead(d, meta)
+	resourceCloudflareSpectrumApplicationRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
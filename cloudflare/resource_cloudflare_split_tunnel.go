@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -12,12 +14,12 @@ import (
 
 func resourceCloudflareSplitTunnel() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceCloudflareSplitTunnelRead,
-		Create: resourceCloudflareSplitTunnelUpdate, // Intentionally identical to Update as the resource is always present
-		Update: resourceCloudflareSplitTunnelUpdate,
-		Delete: resourceCloudflareSplitTunnelDelete,
+		ReadContext:   resourceCloudflareSplitTunnelRead,
+		CreateContext: resourceCloudflareSplitTunnelUpdate, // Intentionally identical to Update as the resource is always present
+		UpdateContext: resourceCloudflareSplitTunnelUpdate,
+		DeleteContext: resourceCloudflareSplitTunnelDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,59 +60,59 @@ func resourceCloudflareSplitTunnel() *schema.Resource {
 	}
 }
 
-func resourceCloudflareSplitTunnelRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareSplitTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 	mode := d.Get("mode").(string)
 
-	splitTunnel, err := client.ListSplitTunnels(context.Background(), accountID, mode)
+	splitTunnel, err := client.ListSplitTunnels(ctx, accountID, mode)
 	if err != nil {
-		return fmt.Errorf("error finding %q Split Tunnels: %s", mode, err)
+		return diag.Errorf("error finding %q Split Tunnels: %s", mode, err)
 	}
 
 	if err := d.Set("tunnels", flattenSplitTunnels(splitTunnel)); err != nil {
-		return fmt.Errorf("error setting %q tunnels attribute: %s", mode, err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("tunnels"), fmt.Errorf("error setting %q tunnels attribute: %s", mode, err))
 	}
 
 	return nil
 }
 
-func resourceCloudflareSplitTunnelUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareSplitTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 	mode := d.Get("mode").(string)
 
 	tunnelList, err := expandSplitTunnels(d.Get("tunnels").([]interface{}))
 	if err != nil {
-		return fmt.Errorf("error updating %q Split Tunnels: %s", mode, err)
+		return diag.Errorf("error updating %q Split Tunnels: %s", mode, err)
 	}
 
-	newSplitTunnels, err := client.UpdateSplitTunnel(context.Background(), accountID, mode, tunnelList)
+	newSplitTunnels, err := client.UpdateSplitTunnel(ctx, accountID, mode, tunnelList)
 	if err != nil {
-		return fmt.Errorf("error updating %q Split Tunnels: %s", mode, err)
+		return diag.Errorf("error updating %q Split Tunnels: %s", mode, err)
 	}
 
 	if err := d.Set("tunnels", flattenSplitTunnels(newSplitTunnels)); err != nil {
-		return fmt.Errorf("error setting %q tunnels attribute: %s", mode, err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("tunnels"), fmt.Errorf("error setting %q tunnels attribute: %s", mode, err))
 	}
 
 	d.SetId(accountID)
 
-	return resourceCloudflareSplitTunnelRead(d, meta)
+	return resourceCloudflareSplitTunnelRead(ctx, d, meta)
 }
 
-func resourceCloudflareSplitTunnelDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareSplitTunnelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 	mode := d.Get("mode").(string)
 
-	client.UpdateSplitTunnel(context.Background(), accountID, mode, nil)
+	client.UpdateSplitTunnel(ctx, accountID, mode, nil)
 
 	d.SetId("")
 	return nil
 }
 
-func resourceCloudflareSplitTunnelImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareSplitTunnelImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	accountID := d.Get("account_id").(string)
 	mode := d.Get("mode").(string)
 
@@ -118,7 +120,7 @@ func resourceCloudflareSplitTunnelImport(d *schema.ResourceData, meta interface{
 	d.Set("account_id", accountID)
 	d.SetId(accountID)
 
-	resourceCloudflareSplitTunnelRead(d, meta)
+	resourceCloudflareSplitTunnelRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -7,18 +7,19 @@ import (
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
 func resourceCloudflareStaticRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareStaticRouteCreate,
-		Read:   resourceCloudflareStaticRouteRead,
-		Update: resourceCloudflareStaticRouteUpdate,
-		Delete: resourceCloudflareStaticRouteDelete,
+		CreateContext: resourceCloudflareStaticRouteCreate,
+		ReadContext:   resourceCloudflareStaticRouteRead,
+		UpdateContext: resourceCloudflareStaticRouteUpdate,
+		DeleteContext: resourceCloudflareStaticRouteDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareStaticRouteImport,
+			StateContext: resourceCloudflareStaticRouteImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,23 +69,23 @@ func resourceCloudflareStaticRoute() *schema.Resource {
 	}
 }
 
-func resourceCloudflareStaticRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareStaticRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	client.AccountID = d.Get("account_id").(string)
 
-	newStaticRoute, err := client.CreateMagicTransitStaticRoute(context.Background(), staticRouteFromResource(d))
+	newStaticRoute, err := client.CreateMagicTransitStaticRoute(ctx, staticRouteFromResource(d))
 
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error creating static route for prefix %s", d.Get("prefix").(string)))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating static route for prefix %s", d.Get("prefix").(string))))
 	}
 
 	d.SetId(newStaticRoute[0].ID)
 
-	return resourceCloudflareStaticRouteRead(d, meta)
+	return resourceCloudflareStaticRouteRead(ctx, d, meta)
 }
 
-func resourceCloudflareStaticRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareStaticRouteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerClient)
 	attributes := strings.SplitN(d.Id(), "/", 2)
 
 	if len(attributes) != 2 {
@@ -96,23 +97,23 @@ func resourceCloudflareStaticRouteImport(d *schema.ResourceData, meta interface{
 	d.Set("account_id", accountID)
 	client.AccountID = accountID
 
-	resourceCloudflareStaticRouteRead(d, meta)
+	resourceCloudflareStaticRouteRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceCloudflareStaticRouteRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareStaticRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	client.AccountID = d.Get("account_id").(string)
 
-	staticRoute, err := client.GetMagicTransitStaticRoute(context.Background(), d.Id())
+	staticRoute, err := client.GetMagicTransitStaticRoute(ctx, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "Route not found") {
 			log.Printf("[INFO] Static Route %s not found", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return errors.Wrap(err, fmt.Sprintf("error reading Static Route ID %q", d.Id()))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading Static Route ID %q", d.Id())))
 	}
 
 	d.Set("prefix", staticRoute.Prefix)
@@ -135,27 +136,27 @@ func resourceCloudflareStaticRouteRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceCloudflareStaticRouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareStaticRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	client.AccountID = d.Get("account_id").(string)
 
-	_, err := client.UpdateMagicTransitStaticRoute(context.Background(), d.Id(), staticRouteFromResource(d))
+	_, err := client.UpdateMagicTransitStaticRoute(ctx, d.Id(), staticRouteFromResource(d))
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("error updating static route with ID %q", d.Id()))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating static route with ID %q", d.Id())))
 	}
 
-	return resourceCloudflareStaticRouteRead(d, meta)
+	return resourceCloudflareStaticRouteRead(ctx, d, meta)
 }
 
-func resourceCloudflareStaticRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareStaticRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	client.AccountID = d.Get("account_id").(string)
 
 	log.Printf("[INFO] Deleting Static Route:  %s", d.Id())
 
-	_, err := client.DeleteMagicTransitStaticRoute(context.Background(), d.Id())
+	_, err := client.DeleteMagicTransitStaticRoute(ctx, d.Id())
 	if err != nil {
-		return fmt.Errorf("error deleting Static Route: %s", err)
+		return diag.Errorf("error deleting Static Route: %s", err)
 	}
 
 	return nil
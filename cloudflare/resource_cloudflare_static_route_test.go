@@ -53,7 +53,7 @@ func testAccCheckCloudflareStaticRouteExists(n string, route *cloudflare.MagicTr
 			return fmt.Errorf("No static route is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		foundStaticRoute, err := client.GetMagicTransitStaticRoute(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
@@ -2,24 +2,25 @@ package cloudflare
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
 func resourceCloudflareTeamsAccount() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceCloudflareTeamsAccountRead,
-		Update: resourceCloudflareTeamsAccountUpdate,
-		Create: resourceCloudflareTeamsAccountUpdate,
+		ReadContext:   resourceCloudflareTeamsAccountRead,
+		UpdateContext: resourceCloudflareTeamsAccountUpdate,
+		CreateContext: resourceCloudflareTeamsAccountUpdate,
 		// This resource is a top-level account configuration and cant be "deleted"
-		Delete: func(_ *schema.ResourceData, _ interface{}) error { return nil },
+		DeleteContext: func(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics { return nil },
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareTeamsAccountImport,
+			StateContext: resourceCloudflareTeamsAccountImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -97,48 +98,48 @@ var antivirusSchema = map[string]*schema.Schema{
 	},
 }
 
-func resourceCloudflareTeamsAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareTeamsAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 
-	configuration, err := client.TeamsAccountConfiguration(context.Background(), accountID)
+	configuration, err := client.TeamsAccountConfiguration(ctx, accountID)
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 400") {
 			log.Printf("[INFO] Teams Account config %s does not exists", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding Teams Account config %q: %s", d.Id(), err)
+		return diag.Errorf("error finding Teams Account config %q: %s", d.Id(), err)
 	}
 
 	if configuration.Settings.BlockPage != nil {
 		if err := d.Set("block_page", flattenBlockPageConfig(configuration.Settings.BlockPage)); err != nil {
-			return errors.Wrap(err, "error parsing account block page config")
+			return attributeErrorDiagnostic(cty.GetAttrPath("block_page"), errors.Wrap(err, "error parsing account block page config"))
 		}
 	}
 
 	if configuration.Settings.Antivirus != nil {
 		if err := d.Set("antivirus", flattenAntivirusConfig(configuration.Settings.Antivirus)); err != nil {
-			return errors.Wrap(err, "error parsing account antivirus config")
+			return attributeErrorDiagnostic(cty.GetAttrPath("antivirus"), errors.Wrap(err, "error parsing account antivirus config"))
 		}
 	}
 
 	if configuration.Settings.TLSDecrypt != nil {
 		if err := d.Set("tls_decrypt_enabled", configuration.Settings.TLSDecrypt.Enabled); err != nil {
-			return errors.Wrap(err, "error parsing account tls decrypt enablement")
+			return attributeErrorDiagnostic(cty.GetAttrPath("tls_decrypt_enabled"), errors.Wrap(err, "error parsing account tls decrypt enablement"))
 		}
 	}
 
 	if configuration.Settings.ActivityLog != nil {
 		if err := d.Set("activity_log_enabled", configuration.Settings.ActivityLog.Enabled); err != nil {
-			return errors.Wrap(err, "error parsing account activity log enablement")
+			return attributeErrorDiagnostic(cty.GetAttrPath("activity_log_enabled"), errors.Wrap(err, "error parsing account activity log enablement"))
 		}
 	}
 	return nil
 }
 
-func resourceCloudflareTeamsAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareTeamsAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	accountID := d.Get("account_id").(string)
 	blockPageConfig := inflateBlockPageConfig(d.Get("block_page"))
 	antivirusConfig := inflateAntivirusConfig(d.Get("antivirus"))
@@ -160,19 +161,19 @@ func resourceCloudflareTeamsAccountUpdate(d *schema.ResourceData, meta interface
 	}
 	log.Printf("[DEBUG] Updating Cloudflare Teams Account configuration from struct: %+v", updatedTeamsAccount)
 
-	if _, err := client.TeamsAccountUpdateConfiguration(context.Background(), accountID, updatedTeamsAccount); err != nil {
-		return fmt.Errorf("error updating Teams Account configuration for account %q: %s", accountID, err)
+	if _, err := client.TeamsAccountUpdateConfiguration(ctx, accountID, updatedTeamsAccount); err != nil {
+		return diag.Errorf("error updating Teams Account configuration for account %q: %s", accountID, err)
 	}
 
 	d.SetId(accountID)
-	return resourceCloudflareTeamsAccountRead(d, meta)
+	return resourceCloudflareTeamsAccountRead(ctx, d, meta)
 }
 
-func resourceCloudflareTeamsAccountImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareTeamsAccountImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.SetId(d.Id())
 	d.Set("account_id", d.Id())
 
-	err := resourceCloudflareTeamsAccountRead(d, meta)
+	err := diagnosticsError(resourceCloudflareTeamsAccountRead(ctx, d, meta))
 	return []*schema.ResourceData{d}, err
 }
 
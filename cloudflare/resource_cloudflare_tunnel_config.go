@@ -0,0 +1,367 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// tunnelCatchAllService is the rule left behind when the configuration is
+// removed so cloudflared stops proxying traffic.
+const tunnelCatchAllService = "http_status:404"
+
+var tunnelOriginRequestResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"connect_timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"tls_timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"tcp_keep_alive": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"keep_alive_timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"keep_alive_connections": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"no_happy_eyeballs": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"http_host_header": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"origin_server_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"ca_pool": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"no_tls_verify": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"disable_chunked_encoding": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"proxy_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"", "socks"}, false),
+		},
+		"http2_origin": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	},
+}
+
+func resourceCloudflareTunnelConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareTunnelConfigUpdate,
+		ReadContext:   resourceCloudflareTunnelConfigRead,
+		UpdateContext: resourceCloudflareTunnelConfigUpdate,
+		DeleteContext: resourceCloudflareTunnelConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTunnelConfigImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tunnel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"warp_routing": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"origin_request": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     tunnelOriginRequestResource,
+						},
+						"ingress_rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hostname": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"service": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"origin_request": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem:     tunnelOriginRequestResource,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareTunnelConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+	tunnelID := d.Get("tunnel_id").(string)
+
+	config, err := expandTunnelConfiguration(d.Get("config").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Updating Cloudflare Tunnel configuration for %s: %#v", tunnelID, config)
+
+	if err := updateTunnelConfiguration(ctx, client, accountID, tunnelID, config); err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating configuration of tunnel %q", tunnelID)))
+	}
+
+	d.SetId(tunnelID)
+
+	return resourceCloudflareTunnelConfigRead(ctx, d, meta)
+}
+
+func resourceCloudflareTunnelConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	result, err := fetchTunnelConfiguration(ctx, client, accountID, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] Tunnel %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading configuration of tunnel %q", d.Id())))
+	}
+
+	if result.Config == nil || len(result.Config.Ingress) == 0 {
+		log.Printf("[INFO] Tunnel %s has no configuration", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("tunnel_id", d.Id())
+	d.Set("version", result.Version)
+
+	_, warpRoutingConfigured := d.GetOk("config.0.warp_routing")
+	if err := d.Set("config", flattenTunnelConfiguration(*result.Config, warpRoutingConfigured)); err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("config"), fmt.Errorf("error setting tunnel configuration: %s", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareTunnelConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	// The configuration can't be removed, only replaced with one that doesn't
+	// route any traffic.
+	config := tunnelConfiguration{
+		Ingress: []tunnelIngressRule{{Service: tunnelCatchAllService}},
+	}
+
+	err := updateTunnelConfiguration(ctx, client, accountID, d.Id(), config)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error removing configuration of tunnel %q", d.Id())))
+	}
+
+	return nil
+}
+
+func resourceCloudflareTunnelConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/tunnelID\"", d.Id())
+	}
+
+	accountID, tunnelID := attributes[0], attributes[1]
+	d.SetId(tunnelID)
+	d.Set("account_id", accountID)
+	d.Set("tunnel_id", tunnelID)
+
+	if err := diagnosticsError(resourceCloudflareTunnelConfigRead(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("tunnel %q has no configuration to import", tunnelID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandTunnelConfiguration(cfg []interface{}) (tunnelConfiguration, error) {
+	var config tunnelConfiguration
+	if len(cfg) == 0 || cfg[0] == nil {
+		return config, nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+
+	if warpRouting, ok := m["warp_routing"].([]interface{}); ok && len(warpRouting) > 0 && warpRouting[0] != nil {
+		config.WarpRouting = &tunnelWarpRouting{
+			Enabled: warpRouting[0].(map[string]interface{})["enabled"].(bool),
+		}
+	}
+
+	config.OriginRequest = expandTunnelOriginRequest(m["origin_request"])
+
+	rules := m["ingress_rule"].([]interface{})
+	for i, rawRule := range rules {
+		rule := rawRule.(map[string]interface{})
+		ingress := tunnelIngressRule{
+			Hostname:      rule["hostname"].(string),
+			Path:          rule["path"].(string),
+			Service:       rule["service"].(string),
+			OriginRequest: expandTunnelOriginRequest(rule["origin_request"]),
+		}
+
+		if i == len(rules)-1 && (ingress.Hostname != "" || ingress.Path != "") {
+			return config, fmt.Errorf("the last ingress rule must match all traffic, remove its hostname and path")
+		}
+
+		config.Ingress = append(config.Ingress, ingress)
+	}
+
+	return config, nil
+}
+
+func expandTunnelOriginRequest(raw interface{}) *tunnelOriginRequest {
+	cfg, ok := raw.([]interface{})
+	if !ok || len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+	return &tunnelOriginRequest{
+		ConnectTimeout:         m["connect_timeout"].(int),
+		TLSTimeout:             m["tls_timeout"].(int),
+		TCPKeepAlive:           m["tcp_keep_alive"].(int),
+		KeepAliveTimeout:       m["keep_alive_timeout"].(int),
+		KeepAliveConnections:   m["keep_alive_connections"].(int),
+		NoHappyEyeballs:        m["no_happy_eyeballs"].(bool),
+		HTTPHostHeader:         m["http_host_header"].(string),
+		OriginServerName:       m["origin_server_name"].(string),
+		CAPool:                 m["ca_pool"].(string),
+		NoTLSVerify:            m["no_tls_verify"].(bool),
+		DisableChunkedEncoding: m["disable_chunked_encoding"].(bool),
+		ProxyType:              m["proxy_type"].(string),
+		HTTP2Origin:            m["http2_origin"].(bool),
+	}
+}
+
+// flattenTunnelConfiguration converts the configuration to the `config`
+// block. The API always returns the WARP routing setting so it's only kept when
+// it's enabled or was already configured.
+func flattenTunnelConfiguration(config tunnelConfiguration, keepWarpRouting bool) []interface{} {
+	m := map[string]interface{}{}
+
+	if config.WarpRouting != nil && (config.WarpRouting.Enabled || keepWarpRouting) {
+		m["warp_routing"] = []interface{}{map[string]interface{}{"enabled": config.WarpRouting.Enabled}}
+	}
+
+	m["origin_request"] = flattenTunnelOriginRequest(config.OriginRequest)
+
+	rules := make([]interface{}, 0, len(config.Ingress))
+	for _, rule := range config.Ingress {
+		rules = append(rules, map[string]interface{}{
+			"hostname":       rule.Hostname,
+			"path":           rule.Path,
+			"service":        rule.Service,
+			"origin_request": flattenTunnelOriginRequest(rule.OriginRequest),
+		})
+	}
+	m["ingress_rule"] = rules
+
+	return []interface{}{m}
+}
+
+// flattenTunnelOriginRequest returns an empty list when none of the options
+// are set. The API returns an empty object for rules without options.
+func flattenTunnelOriginRequest(originRequest *tunnelOriginRequest) []interface{} {
+	if originRequest == nil || *originRequest == (tunnelOriginRequest{}) {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"connect_timeout":          originRequest.ConnectTimeout,
+		"tls_timeout":              originRequest.TLSTimeout,
+		"tcp_keep_alive":           originRequest.TCPKeepAlive,
+		"keep_alive_timeout":       originRequest.KeepAliveTimeout,
+		"keep_alive_connections":   originRequest.KeepAliveConnections,
+		"no_happy_eyeballs":        originRequest.NoHappyEyeballs,
+		"http_host_header":         originRequest.HTTPHostHeader,
+		"origin_server_name":       originRequest.OriginServerName,
+		"ca_pool":                  originRequest.CAPool,
+		"no_tls_verify":            originRequest.NoTLSVerify,
+		"disable_chunked_encoding": originRequest.DisableChunkedEncoding,
+		"proxy_type":               originRequest.ProxyType,
+		"http2_origin":             originRequest.HTTP2Origin,
+	}}
+}
@@ -0,0 +1,163 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareTunnelConfig_Basic(t *testing.T) {
+	accID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_tunnel_config.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareTunnelConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareTunnelConfigBasic(accID, rnd, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accID),
+					resource.TestCheckResourceAttr(name, "config.0.warp_routing.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "config.0.origin_request.0.connect_timeout", "30"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.#", "2"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.hostname", rnd+".example.com"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.service", "https://localhost:8443"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.origin_request.0.no_tls_verify", "true"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.1.service", "http_status:404"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareTunnelConfigBasic(accID, rnd, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "config.0.origin_request.0.connect_timeout", "60"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accID),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareTunnelConfigBasic(accID, name string, connectTimeout int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_argo_tunnel" "%[2]s" {
+  account_id = "%[1]s"
+  name       = "%[2]s"
+  secret     = "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+}
+
+resource "cloudflare_tunnel_config" "%[2]s" {
+  account_id = "%[1]s"
+  tunnel_id  = cloudflare_argo_tunnel.%[2]s.id
+
+  config {
+    warp_routing {
+      enabled = true
+    }
+
+    origin_request {
+      connect_timeout = %[3]d
+    }
+
+    ingress_rule {
+      hostname = "%[2]s.example.com"
+      service  = "https://localhost:8443"
+
+      origin_request {
+        no_tls_verify = true
+      }
+    }
+
+    ingress_rule {
+      service = "http_status:404"
+    }
+  }
+}`, accID, name, connectTimeout)
+}
+
+func testAccCheckCloudflareTunnelConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_tunnel_config" {
+			continue
+		}
+
+		result, err := fetchTunnelConfiguration(context.Background(), client, rs.Primary.Attributes["account_id"], rs.Primary.ID)
+		if err != nil {
+			// The tunnel itself has been removed as well.
+			continue
+		}
+
+		if result.Config != nil && (len(result.Config.Ingress) != 1 || result.Config.Ingress[0].Service != tunnelCatchAllService) {
+			return fmt.Errorf("tunnel %s still has a configuration", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestExpandTunnelConfiguration(t *testing.T) {
+	cfg := []interface{}{map[string]interface{}{
+		"warp_routing":   []interface{}{map[string]interface{}{"enabled": false}},
+		"origin_request": []interface{}{},
+		"ingress_rule": []interface{}{
+			map[string]interface{}{
+				"hostname":       "app.example.com",
+				"path":           "^/api",
+				"service":        "http://localhost:8080",
+				"origin_request": flattenTunnelOriginRequest(&tunnelOriginRequest{ConnectTimeout: 10, NoTLSVerify: true}),
+			},
+			map[string]interface{}{
+				"hostname":       "",
+				"path":           "",
+				"service":        "http_status:404",
+				"origin_request": []interface{}{},
+			},
+		},
+	}}
+
+	config, err := expandTunnelConfiguration(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := tunnelConfiguration{
+		WarpRouting: &tunnelWarpRouting{Enabled: false},
+		Ingress: []tunnelIngressRule{
+			{Hostname: "app.example.com", Path: "^/api", Service: "http://localhost:8080", OriginRequest: &tunnelOriginRequest{ConnectTimeout: 10, NoTLSVerify: true}},
+			{Service: "http_status:404"},
+		},
+	}
+	if !reflect.DeepEqual(config, expected) {
+		t.Errorf("unexpected configuration:\n got: %+v\nwant: %+v", config, expected)
+	}
+
+	if flattened := flattenTunnelConfiguration(config, true); !reflect.DeepEqual(flattened, cfg) {
+		t.Errorf("unexpected flattened configuration:\n got: %v\nwant: %v", flattened, cfg)
+	}
+
+	if _, ok := flattenTunnelConfiguration(config, false)[0].(map[string]interface{})["warp_routing"]; ok {
+		t.Error("disabled WARP routing should only be kept when configured")
+	}
+
+	cfg[0].(map[string]interface{})["ingress_rule"] = cfg[0].(map[string]interface{})["ingress_rule"].([]interface{})[:1]
+	if _, err := expandTunnelConfiguration(cfg); err == nil {
+		t.Error("expected an error when the last rule isn't a catch-all rule")
+	}
+}
@@ -0,0 +1,172 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+func resourceCloudflareTunnelRoute() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareTunnelRouteCreate,
+		ReadContext:   resourceCloudflareTunnelRouteRead,
+		UpdateContext: resourceCloudflareTunnelRouteUpdate,
+		DeleteContext: resourceCloudflareTunnelRouteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTunnelRouteImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tunnel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"network": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"virtual_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func buildTunnelRoute(d *schema.ResourceData) tunnelRoute {
+	return tunnelRoute{
+		Network:          d.Get("network").(string),
+		TunnelID:         d.Get("tunnel_id").(string),
+		Comment:          d.Get("comment").(string),
+		VirtualNetworkID: d.Get("virtual_network_id").(string),
+	}
+}
+
+func resourceCloudflareTunnelRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+	route := buildTunnelRoute(d)
+
+	log.Printf("[DEBUG] Creating Cloudflare Tunnel route from struct: %+v", route)
+
+	if err := createTunnelRoute(ctx, client, accountID, route); err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating tunnel route for %q", route.Network)))
+	}
+
+	d.SetId(route.Network)
+
+	return resourceCloudflareTunnelRouteRead(ctx, d, meta)
+}
+
+func resourceCloudflareTunnelRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	route, err := findTunnelRoute(ctx, client, accountID, d.Id(), d.Get("virtual_network_id").(string))
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading tunnel route for %q", d.Id())))
+	}
+
+	if route == nil {
+		log.Printf("[INFO] Tunnel route for %s no longer exists", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network", route.Network)
+	d.Set("tunnel_id", route.TunnelID)
+	d.Set("comment", route.Comment)
+	d.Set("virtual_network_id", route.VirtualNetworkID)
+
+	return nil
+}
+
+func resourceCloudflareTunnelRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+	route := buildTunnelRoute(d)
+
+	log.Printf("[DEBUG] Updating Cloudflare Tunnel route from struct: %+v", route)
+
+	if err := updateTunnelRoute(ctx, client, accountID, route); err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating tunnel route for %q", route.Network)))
+	}
+
+	return resourceCloudflareTunnelRouteRead(ctx, d, meta)
+}
+
+func resourceCloudflareTunnelRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	log.Printf("[DEBUG] Deleting Cloudflare Tunnel route for %s", d.Id())
+
+	err := deleteTunnelRoute(ctx, client, accountID, d.Id(), d.Get("virtual_network_id").(string))
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error deleting tunnel route for %q", d.Id())))
+	}
+
+	return nil
+}
+
+func resourceCloudflareTunnelRouteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountID, network, virtualNetworkID, err := parseTunnelRouteImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(network)
+	d.Set("account_id", accountID)
+	d.Set("network", network)
+	d.Set("virtual_network_id", virtualNetworkID)
+
+	if err := diagnosticsError(resourceCloudflareTunnelRouteRead(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("tunnel route for %q not found", network)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseTunnelRouteImportID splits an import ID of the form
+// "accountID/network" or "accountID/network/virtualNetworkID". The network is
+// a CIDR so it contains a slash itself.
+func parseTunnelRouteImportID(id string) (string, string, string, error) {
+	attributes := strings.Split(id, "/")
+	if (len(attributes) != 3 && len(attributes) != 4) || attributes[0] == "" {
+		return "", "", "", fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/network\" or \"accountID/network/virtualNetworkID\"", id)
+	}
+
+	network := attributes[1] + "/" + attributes[2]
+	if _, errs := validation.IsCIDR(network, "network"); len(errs) > 0 {
+		return "", "", "", fmt.Errorf("invalid network %q in id (\"%s\")", network, id)
+	}
+
+	virtualNetworkID := ""
+	if len(attributes) == 4 {
+		virtualNetworkID = attributes[3]
+	}
+
+	return attributes[0], network, virtualNetworkID, nil
+}
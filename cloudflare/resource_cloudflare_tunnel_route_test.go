@@ -0,0 +1,103 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareTunnelRoute_Basic(t *testing.T) {
+	accID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_tunnel_route.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareTunnelRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareTunnelRouteBasic(accID, rnd, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "network", "10.250.0.0/16"),
+					resource.TestCheckResourceAttr(name, "comment", "initial"),
+					resource.TestCheckResourceAttrPair(name, "tunnel_id", "cloudflare_argo_tunnel."+rnd, "id"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareTunnelRouteBasic(accID, rnd, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "comment", "updated"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accID),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareTunnelRouteBasic(accID, name, comment string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_argo_tunnel" "%[2]s" {
+  account_id = "%[1]s"
+  name       = "%[2]s"
+  secret     = "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+}
+
+resource "cloudflare_tunnel_route" "%[2]s" {
+  account_id = "%[1]s"
+  tunnel_id  = cloudflare_argo_tunnel.%[2]s.id
+  network    = "10.250.0.0/16"
+  comment    = "%[3]s"
+}`, accID, name, comment)
+}
+
+func testAccCheckCloudflareTunnelRouteDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_tunnel_route" {
+			continue
+		}
+
+		route, err := findTunnelRoute(context.Background(), client, rs.Primary.Attributes["account_id"], rs.Primary.ID, rs.Primary.Attributes["virtual_network_id"])
+		if err != nil {
+			return err
+		}
+
+		if route != nil {
+			return fmt.Errorf("tunnel route for %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestParseTunnelRouteImportID(t *testing.T) {
+	accountID, network, virtualNetworkID, err := parseTunnelRouteImportID("abc/10.0.0.0/16")
+	if err != nil || accountID != "abc" || network != "10.0.0.0/16" || virtualNetworkID != "" {
+		t.Errorf("unexpected result: %q %q %q %v", accountID, network, virtualNetworkID, err)
+	}
+
+	accountID, network, virtualNetworkID, err = parseTunnelRouteImportID("abc/2001:db8::/32/vnet")
+	if err != nil || accountID != "abc" || network != "2001:db8::/32" || virtualNetworkID != "vnet" {
+		t.Errorf("unexpected result: %q %q %q %v", accountID, network, virtualNetworkID, err)
+	}
+
+	for _, id := range []string{"abc", "abc/10.0.0.0", "/10.0.0.0/16", "abc/foo/bar", "abc/10.0.0.0/16/vnet/extra"} {
+		if _, _, _, err := parseTunnelRouteImportID(id); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
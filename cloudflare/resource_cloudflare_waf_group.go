@@ -6,19 +6,20 @@ import (
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCloudflareWAFGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareWAFGroupCreate,
-		Read:   resourceCloudflareWAFGroupRead,
-		Update: resourceCloudflareWAFGroupUpdate,
-		Delete: resourceCloudflareWAFGroupDelete,
+		CreateContext: resourceCloudflareWAFGroupCreate,
+		ReadContext:   resourceCloudflareWAFGroupRead,
+		UpdateContext: resourceCloudflareWAFGroupUpdate,
+		DeleteContext: resourceCloudflareWAFGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareWAFGroupImport,
+			StateContext: resourceCloudflareWAFGroupImport,
 		},
 
 		SchemaVersion: 0,
@@ -51,21 +52,21 @@ func resourceCloudflareWAFGroup() *schema.Resource {
 	}
 }
 
-func resourceCloudflareWAFGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWAFGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	groupID := d.Get("group_id").(string)
 	zoneID := d.Get("zone_id").(string)
 	packageID := d.Get("package_id").(string)
 
-	group, err := client.WAFGroup(context.Background(), zoneID, packageID, groupID)
+	group, err := client.WAFGroup(ctx, zoneID, packageID, groupID)
 	if err != nil {
 		if err.(*cloudflare.APIRequestError).InternalErrorCodeIs(1002) || err.(*cloudflare.APIRequestError).InternalErrorCodeIs(1003) {
 			d.SetId("")
 			return nil
 		}
 
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Only need to set mode as that is the only attribute that could have changed
@@ -75,8 +76,8 @@ func resourceCloudflareWAFGroupRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceCloudflareWAFGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWAFGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	groupID := d.Get("group_id").(string)
 	zoneID := d.Get("zone_id").(string)
 	packageID := d.Get("package_id").(string)
@@ -86,9 +87,9 @@ func resourceCloudflareWAFGroupCreate(d *schema.ResourceData, meta interface{})
 	var pkgList []cloudflare.WAFPackage
 	if packageID == "" {
 		var err error
-		pkgList, err = client.ListWAFPackages(context.Background(), zoneID)
+		pkgList, err = cachedWAFPackages(ctx, client, zoneID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		pkgList = append(pkgList, cloudflare.WAFPackage{ID: packageID})
@@ -98,7 +99,7 @@ func resourceCloudflareWAFGroupCreate(d *schema.ResourceData, meta interface{})
 		var err error
 		var group cloudflare.WAFGroup
 
-		group, err = client.WAFGroup(context.Background(), zoneID, pkg.ID, groupID)
+		group, err = client.WAFGroup(ctx, zoneID, pkg.ID, groupID)
 		if err != nil {
 			continue
 		}
@@ -108,29 +109,28 @@ func resourceCloudflareWAFGroupCreate(d *schema.ResourceData, meta interface{})
 		d.Set("package_id", pkg.ID)
 
 		if group.Mode != mode {
-			err = resourceCloudflareWAFGroupUpdate(d, meta)
-			if err != nil {
+			if diags := resourceCloudflareWAFGroupUpdate(ctx, d, meta); diags.HasError() {
 				d.SetId("")
-				return err
+				return diags
 			}
 		}
 
-		return resourceCloudflareWAFGroupRead(d, meta)
+		return resourceCloudflareWAFGroupRead(ctx, d, meta)
 	}
 
-	return fmt.Errorf("unable to find WAF Group %s", groupID)
+	return diag.Errorf("unable to find WAF Group %s", groupID)
 }
 
-func resourceCloudflareWAFGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWAFGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	groupID := d.Get("group_id").(string)
 	zoneID := d.Get("zone_id").(string)
 	packageID := d.Get("package_id").(string)
 
-	group, err := client.WAFGroup(context.Background(), zoneID, packageID, groupID)
+	group, err := client.WAFGroup(ctx, zoneID, packageID, groupID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Can't delete WAF Group so instead reset it to default
@@ -138,17 +138,17 @@ func resourceCloudflareWAFGroupDelete(d *schema.ResourceData, meta interface{})
 	defaultMode := schema["mode"].Default.(string)
 
 	if group.Mode != defaultMode {
-		_, err = client.UpdateWAFGroup(context.Background(), zoneID, packageID, groupID, defaultMode)
+		_, err = client.UpdateWAFGroup(ctx, zoneID, packageID, groupID, defaultMode)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceCloudflareWAFGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWAFGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	groupID := d.Get("group_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -156,16 +156,16 @@ func resourceCloudflareWAFGroupUpdate(d *schema.ResourceData, meta interface{})
 	packageID := d.Get("package_id").(string)
 
 	// We can only update the mode of a WAF Group
-	_, err := client.UpdateWAFGroup(context.Background(), zoneID, packageID, groupID, mode)
+	_, err := client.UpdateWAFGroup(ctx, zoneID, packageID, groupID, mode)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceCloudflareWAFGroupRead(d, meta)
+	return resourceCloudflareWAFGroupRead(ctx, d, meta)
 }
 
-func resourceCloudflareWAFGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWAFGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -178,13 +178,13 @@ func resourceCloudflareWAFGroupImport(d *schema.ResourceData, meta interface{})
 		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/GroupID\" for import", d.Id())
 	}
 
-	pkgList, err := client.ListWAFPackages(context.Background(), zoneID)
+	pkgList, err := cachedWAFPackages(ctx, client, zoneID)
 	if err != nil {
 		return nil, fmt.Errorf("error listing WAF packages: %s", err)
 	}
 
 	for _, pkg := range pkgList {
-		group, err := client.WAFGroup(context.Background(), zoneID, pkg.ID, groupID)
+		group, err := client.WAFGroup(ctx, zoneID, pkg.ID, groupID)
 		if err != nil {
 			continue
 		}
@@ -6,19 +6,20 @@ import (
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCloudflareWAFPackage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareWAFPackageCreate,
-		Read:   resourceCloudflareWAFPackageRead,
-		Update: resourceCloudflareWAFPackageUpdate,
-		Delete: resourceCloudflareWAFPackageDelete,
+		CreateContext: resourceCloudflareWAFPackageCreate,
+		ReadContext:   resourceCloudflareWAFPackageRead,
+		UpdateContext: resourceCloudflareWAFPackageUpdate,
+		DeleteContext: resourceCloudflareWAFPackageDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareWAFPackageImport,
+			StateContext: resourceCloudflareWAFPackageImport,
 		},
 
 		SchemaVersion: 0,
@@ -52,20 +53,20 @@ func resourceCloudflareWAFPackage() *schema.Resource {
 	}
 }
 
-func resourceCloudflareWAFPackageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWAFPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	packageID := d.Get("package_id").(string)
 	zoneID := d.Get("zone_id").(string)
 
-	pkg, err := client.WAFPackage(context.Background(), zoneID, packageID)
+	pkg, err := client.WAFPackage(ctx, zoneID, packageID)
 	if err != nil {
 		if err.(*cloudflare.APIRequestError).InternalErrorCodeIs(1002) {
 			d.SetId("")
 			return nil
 		}
 
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("sensitivity", pkg.Sensitivity)
@@ -75,17 +76,17 @@ func resourceCloudflareWAFPackageRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceCloudflareWAFPackageCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWAFPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	packageID := d.Get("package_id").(string)
 	zoneID := d.Get("zone_id").(string)
 	sensitivity := d.Get("sensitivity").(string)
 	actionMode := d.Get("action_mode").(string)
 
-	pkg, err := client.WAFPackage(context.Background(), zoneID, packageID)
+	pkg, err := client.WAFPackage(ctx, zoneID, packageID)
 	if err != nil {
-		return fmt.Errorf("unable to find WAF Package %s", packageID)
+		return diag.Errorf("unable to find WAF Package %s", packageID)
 	}
 
 	d.Set("zone_id", zoneID)
@@ -101,25 +102,24 @@ func resourceCloudflareWAFPackageCreate(d *schema.ResourceData, meta interface{}
 	d.SetId(packageID)
 
 	if pkg.Sensitivity != sensitivity || pkg.ActionMode != actionMode {
-		err = resourceCloudflareWAFPackageUpdate(d, meta)
-		if err != nil {
+		if diags := resourceCloudflareWAFPackageUpdate(ctx, d, meta); diags.HasError() {
 			d.SetId("")
-			return err
+			return diags
 		}
 	}
 
 	return nil
 }
 
-func resourceCloudflareWAFPackageDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWAFPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	packageID := d.Get("package_id").(string)
 	zoneID := d.Get("zone_id").(string)
 
-	pkg, err := client.WAFPackage(context.Background(), zoneID, packageID)
+	pkg, err := client.WAFPackage(ctx, zoneID, packageID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Can't delete WAF Package so instead reset it to default
@@ -133,17 +133,17 @@ func resourceCloudflareWAFPackageDelete(d *schema.ResourceData, meta interface{}
 			ActionMode:  defaultActionMode,
 		}
 
-		_, err = client.UpdateWAFPackage(context.Background(), zoneID, packageID, options)
+		_, err = client.UpdateWAFPackage(ctx, zoneID, packageID, options)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceCloudflareWAFPackageUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWAFPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	packageID := d.Get("package_id").(string)
 	zoneID := d.Get("zone_id").(string)
@@ -155,16 +155,16 @@ func resourceCloudflareWAFPackageUpdate(d *schema.ResourceData, meta interface{}
 		ActionMode:  actionMode,
 	}
 
-	_, err := client.UpdateWAFPackage(context.Background(), zoneID, packageID, options)
+	_, err := client.UpdateWAFPackage(ctx, zoneID, packageID, options)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceCloudflareWAFPackageImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWAFPackageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerClient)
 
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -177,7 +177,7 @@ func resourceCloudflareWAFPackageImport(d *schema.ResourceData, meta interface{}
 		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/PackageID\" for import", d.Id())
 	}
 
-	pkg, err := client.WAFPackage(context.Background(), zoneID, packageID)
+	pkg, err := client.WAFPackage(ctx, zoneID, packageID)
 	if err != nil {
 		return nil, err
 	}
@@ -6,17 +6,19 @@ import (
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceCloudflareWorkerCronTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareWorkerCronTriggerUpdate,
-		Read:   resourceCloudflareWorkerCronTriggerRead,
-		Update: resourceCloudflareWorkerCronTriggerUpdate,
-		Delete: resourceCloudflareWorkerCronTriggerDelete,
+		CreateContext: resourceCloudflareWorkerCronTriggerUpdate,
+		ReadContext:   resourceCloudflareWorkerCronTriggerRead,
+		UpdateContext: resourceCloudflareWorkerCronTriggerUpdate,
+		DeleteContext: resourceCloudflareWorkerCronTriggerDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareWorkerCronTriggerImport,
+			StateContext: resourceCloudflareWorkerCronTriggerImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -38,14 +40,14 @@ func resourceCloudflareWorkerCronTrigger() *schema.Resource {
 
 // resourceCloudflareWorkerCronTriggerUpdate is used for creation and updates of
 // Worker Cron Triggers as the remote API endpoint is shared uses HTTP PUT.
-func resourceCloudflareWorkerCronTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkerCronTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	scriptName := d.Get("script_name").(string)
 
-	_, err := client.UpdateWorkerCronTriggers(context.Background(), scriptName, transformSchemaToWorkerCronTriggerStruct(d))
+	_, err := client.UpdateWorkerCronTriggers(ctx, scriptName, transformSchemaToWorkerCronTriggerStruct(d))
 	if err != nil {
-		return fmt.Errorf("failed to update Worker Cron Trigger: %s", err)
+		return diag.Errorf("failed to update Worker Cron Trigger: %s", err)
 	}
 
 	d.SetId(stringChecksum(scriptName))
@@ -53,11 +55,11 @@ func resourceCloudflareWorkerCronTriggerUpdate(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceCloudflareWorkerCronTriggerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkerCronTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	scriptName := d.Get("script_name").(string)
 
-	s, err := client.ListWorkerCronTriggers(context.Background(), scriptName)
+	s, err := client.ListWorkerCronTriggers(ctx, scriptName)
 	if err != nil {
 		// If the script is removed, we also need to remove the triggers.
 		if strings.Contains(err.Error(), "workers.api.error.script_not_found") {
@@ -65,31 +67,31 @@ func resourceCloudflareWorkerCronTriggerRead(d *schema.ResourceData, meta interf
 			return nil
 		}
 
-		return fmt.Errorf("failed to read Worker Cron Trigger: %s", err)
+		return diag.Errorf("failed to read Worker Cron Trigger: %s", err)
 	}
 
 	if err := d.Set("schedules", transformWorkerCronTriggerStructToSet(s)); err != nil {
-		return fmt.Errorf("failed to set schedules attribute: %s", err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("schedules"), fmt.Errorf("failed to set schedules attribute: %s", err))
 	}
 
 	return nil
 }
 
-func resourceCloudflareWorkerCronTriggerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkerCronTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	scriptName := d.Get("script_name").(string)
 
-	client.UpdateWorkerCronTriggers(context.Background(), scriptName, []cloudflare.WorkerCronTrigger{})
+	client.UpdateWorkerCronTriggers(ctx, scriptName, []cloudflare.WorkerCronTrigger{})
 
 	d.SetId("")
 
 	return nil
 }
 
-func resourceCloudflareWorkerCronTriggerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareWorkerCronTriggerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.SetId(stringChecksum(d.Id()))
 
-	resourceCloudflareWorkerCronTriggerRead(d, meta)
+	resourceCloudflareWorkerCronTriggerRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
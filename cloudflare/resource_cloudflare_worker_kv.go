@@ -6,19 +6,19 @@ import (
 	"log"
 	"strings"
 
-	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
 func resourceCloudflareWorkerKV() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareWorkersKVUpdate,
-		Read:   resourceCloudflareWorkersKVRead,
-		Update: resourceCloudflareWorkersKVUpdate,
-		Delete: resourceCloudflareWorkersKVDelete,
+		CreateContext: resourceCloudflareWorkersKVUpdate,
+		ReadContext:   resourceCloudflareWorkersKVRead,
+		UpdateContext: resourceCloudflareWorkersKVUpdate,
+		DeleteContext: resourceCloudflareWorkersKVDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareWorkersKVImport,
+			StateContext: resourceCloudflareWorkersKVImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -39,13 +39,13 @@ func resourceCloudflareWorkerKV() *schema.Resource {
 	}
 }
 
-func resourceCloudflareWorkersKVRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkersKVRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	namespaceID, key := parseId(d.Id())
 
-	value, err := client.ReadWorkersKV(context.Background(), namespaceID, key)
+	value, err := client.ReadWorkersKV(ctx, namespaceID, key)
 	if err != nil {
-		return errors.Wrap(err, "error reading workers kv")
+		return diag.FromErr(errors.Wrap(err, "error reading workers kv"))
 	}
 
 	if value == nil {
@@ -57,45 +57,45 @@ func resourceCloudflareWorkersKVRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceCloudflareWorkersKVUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkersKVUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	namespaceID := d.Get("namespace_id").(string)
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
 
-	_, err := client.WriteWorkersKV(context.Background(), namespaceID, key, []byte(value))
+	_, err := client.WriteWorkersKV(ctx, namespaceID, key, []byte(value))
 	if err != nil {
-		return errors.Wrap(err, "error creating workers kv")
+		return diag.FromErr(errors.Wrap(err, "error creating workers kv"))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", namespaceID, key))
 
 	log.Printf("[INFO] Cloudflare Workers KV Namespace ID: %s", d.Id())
 
-	return resourceCloudflareWorkersKVRead(d, meta)
+	return resourceCloudflareWorkersKVRead(ctx, d, meta)
 }
 
-func resourceCloudflareWorkersKVDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkersKVDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	namespaceID, key := parseId(d.Id())
 
 	log.Printf("[INFO] Deleting Cloudflare Workers KV with id: %+v", d.Id())
 
-	_, err := client.DeleteWorkersKV(context.Background(), namespaceID, key)
+	_, err := client.DeleteWorkersKV(ctx, namespaceID, key)
 	if err != nil {
-		return errors.Wrap(err, "error deleting workers kv")
+		return diag.FromErr(errors.Wrap(err, "error deleting workers kv"))
 	}
 
 	return nil
 }
 
-func resourceCloudflareWorkersKVImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareWorkersKVImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	namespaceID, key := parseId(d.Id())
 
 	d.Set("namespace_id", namespaceID)
 	d.Set("key", key)
 
-	resourceCloudflareWorkersKVRead(d, meta)
+	resourceCloudflareWorkersKVRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
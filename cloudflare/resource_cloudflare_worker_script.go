@@ -9,7 +9,10 @@ import (
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 )
 
@@ -53,6 +56,25 @@ var secretTextBindingResource = &schema.Resource{
 	},
 }
 
+var workerScriptModuleResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"content": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"content_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      workerScriptDefaultModuleContentType,
+			ValidateFunc: validation.StringInSlice(workerScriptModuleContentTypes, false),
+		},
+	},
+}
+
 var webAssemblyBindingResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"name": {
@@ -66,14 +88,101 @@ var webAssemblyBindingResource = &schema.Resource{
 	},
 }
 
+var durableObjectNamespaceBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"class_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"script_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	},
+}
+
+var serviceBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"service": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"environment": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "production",
+		},
+	},
+}
+
+var r2BucketBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"bucket_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+var analyticsEngineBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"dataset": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+var unknownBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	},
+}
+
+// workerMetadataBindingTypes maps the binding blocks cloudflare-go has no type
+// for to the binding type used by the API and the attributes of the block that
+// are sent as is.
+var workerMetadataBindingTypes = map[string]struct {
+	bindingType string
+	attributes  []string
+}{
+	"durable_object_namespace_binding": {"durable_object_namespace", []string{"class_name", "script_name"}},
+	"service_binding":                  {"service", []string{"service", "environment"}},
+	"r2_bucket_binding":                {"r2_bucket", []string{"bucket_name"}},
+	"analytics_engine_binding":         {"analytics_engine", []string{"dataset"}},
+}
+
 func resourceCloudflareWorkerScript() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareWorkerScriptCreate,
-		Read:   resourceCloudflareWorkerScriptRead,
-		Update: resourceCloudflareWorkerScriptUpdate,
-		Delete: resourceCloudflareWorkerScriptDelete,
+		CreateContext: resourceCloudflareWorkerScriptCreate,
+		ReadContext:   resourceCloudflareWorkerScriptRead,
+		UpdateContext: resourceCloudflareWorkerScriptUpdate,
+		DeleteContext: resourceCloudflareWorkerScriptDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareWorkerScriptImport,
+			StateContext: resourceCloudflareWorkerScriptImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -86,6 +195,21 @@ func resourceCloudflareWorkerScript() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"module": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"main_module": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "index.js",
+			},
+			"modules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     workerScriptModuleResource,
+			},
 			"plain_text_binding": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -106,6 +230,31 @@ func resourceCloudflareWorkerScript() *schema.Resource {
 				Optional: true,
 				Elem:     webAssemblyBindingResource,
 			},
+			"durable_object_namespace_binding": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     durableObjectNamespaceBindingResource,
+			},
+			"service_binding": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     serviceBindingResource,
+			},
+			"r2_bucket_binding": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     r2BucketBindingResource,
+			},
+			"analytics_engine_binding": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     analyticsEngineBindingResource,
+			},
+			"unknown_binding": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     unknownBindingResource,
+			},
 		},
 	}
 }
@@ -117,7 +266,7 @@ type ScriptData struct {
 	Params cloudflare.WorkerRequestParams
 }
 
-func getScriptData(d *schema.ResourceData, client *cloudflare.API) (ScriptData, error) {
+func getScriptData(d *schema.ResourceData, client *providerClient) (ScriptData, error) {
 	scriptName := d.Get("name").(string)
 
 	params := cloudflare.WorkerRequestParams{
@@ -132,8 +281,8 @@ func getScriptData(d *schema.ResourceData, client *cloudflare.API) (ScriptData,
 
 type ScriptBindings map[string]cloudflare.WorkerBinding
 
-func getWorkerScriptBindings(scriptName string, client *cloudflare.API) (ScriptBindings, error) {
-	resp, err := client.ListWorkerBindings(context.Background(), &cloudflare.WorkerRequestParams{ScriptName: scriptName})
+func getWorkerScriptBindings(ctx context.Context, scriptName string, client *providerClient) (ScriptBindings, error) {
+	resp, err := client.ListWorkerBindings(ctx, &cloudflare.WorkerRequestParams{ScriptName: scriptName})
 	if err != nil {
 		return nil, fmt.Errorf("cannot list script bindings: %v", err)
 	}
@@ -178,39 +327,231 @@ func parseWorkerBindings(d *schema.ResourceData, bindings ScriptBindings) {
 	}
 }
 
-func resourceCloudflareWorkerScriptCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
-
-	scriptData, err := getScriptData(d, client)
-	if err != nil {
-		return err
+// parseWorkerBindingMetadata returns the metadata of the configured bindings
+// that cloudflare-go has no type for, keyed by name.
+func parseWorkerBindingMetadata(d *schema.ResourceData) map[string]map[string]interface{} {
+	bindings := make(map[string]map[string]interface{})
+
+	for key, binding := range workerMetadataBindingTypes {
+		for _, rawData := range d.Get(key).(*schema.Set).List() {
+			data := rawData.(map[string]interface{})
+			metadata := map[string]interface{}{"type": binding.bindingType}
+			for _, attribute := range binding.attributes {
+				if value := data[attribute].(string); value != "" {
+					metadata[attribute] = value
+				}
+			}
+			bindings[data["name"].(string)] = metadata
+		}
 	}
 
-	// make sure that the worker does not already exist
-	r, _ := client.DownloadWorker(context.Background(), &scriptData.Params)
-	if r.WorkerScript.Script != "" {
-		return fmt.Errorf("script already exists")
+	return bindings
+}
+
+// flattenWorkerBindingMetadata sorts the bindings returned by the API into the
+// binding blocks they belong to. Bindings of a type the provider doesn't
+// support end up in `unknown_binding` so they show up as drift instead of
+// being silently dropped.
+func flattenWorkerBindingMetadata(scriptName string, configured, bindings map[string]map[string]interface{}) map[string][]interface{} {
+	result := make(map[string][]interface{})
+
+	for name, metadata := range bindings {
+		bindingType, _ := metadata["type"].(string)
+
+		key := ""
+		for k, binding := range workerMetadataBindingTypes {
+			if binding.bindingType == bindingType {
+				key = k
+			}
+		}
+
+		if key == "" {
+			result["unknown_binding"] = append(result["unknown_binding"], map[string]interface{}{
+				"name": name,
+				"type": bindingType,
+			})
+			continue
+		}
+
+		data := map[string]interface{}{"name": name}
+		for _, attribute := range workerMetadataBindingTypes[key].attributes {
+			value, _ := metadata[attribute].(string)
+			data[attribute] = value
+		}
+
+		// The API fills in the script name of Durable Objects defined by the
+		// script itself, keep it empty when it was left out of the config.
+		if bindingType == "durable_object_namespace" && data["script_name"] == scriptName {
+			if _, ok := configured[name]["script_name"]; !ok {
+				data["script_name"] = ""
+			}
+		}
+
+		result[key] = append(result[key], data)
 	}
 
+	return result
+}
+
+// buildWorkerScriptUpload returns the script and bindings to upload. Module
+// format workers upload `content` as the main module alongside `modules`.
+func buildWorkerScriptUpload(d *schema.ResourceData) (workerScriptUpload, error) {
 	scriptBody := d.Get("content").(string)
 	if scriptBody == "" {
-		return fmt.Errorf("script content cannot be empty")
+		return workerScriptUpload{}, fmt.Errorf("script content cannot be empty")
 	}
 
-	log.Printf("[INFO] Creating Cloudflare Worker Script from struct: %+v", &scriptData.Params)
-
 	bindings := make(ScriptBindings)
 
 	parseWorkerBindings(d, bindings)
 
-	scriptParams := cloudflare.WorkerScriptParams{
-		Script:   scriptBody,
-		Bindings: bindings,
+	upload := workerScriptUpload{
+		Script:           scriptBody,
+		Bindings:         bindings,
+		MetadataBindings: parseWorkerBindingMetadata(d),
 	}
 
-	_, err = client.UploadWorkerWithBindings(context.Background(), &scriptData.Params, &scriptParams)
+	modules := d.Get("modules").(*schema.Set).List()
+	if !d.Get("module").(bool) {
+		if len(modules) > 0 {
+			return upload, fmt.Errorf("modules can only be used when module is true")
+		}
+		return upload, nil
+	}
+
+	upload.MainModule = d.Get("main_module").(string)
+	upload.Modules = []workerScriptModule{{
+		Name:        upload.MainModule,
+		ContentType: workerScriptDefaultModuleContentType,
+		Content:     []byte(scriptBody),
+	}}
+
+	for _, rawData := range modules {
+		data := rawData.(map[string]interface{})
+		module := workerScriptModule{
+			Name:        data["name"].(string),
+			ContentType: data["content_type"].(string),
+			Content:     []byte(data["content"].(string)),
+		}
+
+		if module.Name == upload.MainModule {
+			return upload, fmt.Errorf("module %q has the same name as main_module, use content for the main module", module.Name)
+		}
+
+		if contains(workerScriptBinaryContentTypes, module.ContentType) {
+			content, err := base64.StdEncoding.DecodeString(data["content"].(string))
+			if err != nil {
+				return upload, fmt.Errorf("module %q must be base64 encoded: %s", module.Name, err)
+			}
+			module.Content = content
+		}
+
+		upload.Modules = append(upload.Modules, module)
+	}
+
+	return upload, nil
+}
+
+// setWorkerScriptContent sets `content` from the downloaded script. Module
+// format workers are downloaded as multipart form data which is split into
+// `content` for the main module and `modules` for the others.
+func setWorkerScriptContent(d *schema.ResourceData, script string) diag.Diagnostics {
+	downloaded, isModule := parseWorkerScriptModules(script)
+	if !isModule {
+		if err := d.Set("content", script); err != nil {
+			return attributeErrorDiagnostic(cty.GetAttrPath("content"), fmt.Errorf("cannot set content: %v", err))
+		}
+		if err := d.Set("module", false); err != nil {
+			return attributeErrorDiagnostic(cty.GetAttrPath("module"), fmt.Errorf("cannot set module: %v", err))
+		}
+		return nil
+	}
+
+	configuredTypes := make(map[string]string)
+	for _, rawData := range d.Get("modules").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		configuredTypes[data["name"].(string)] = data["content_type"].(string)
+	}
+
+	mainModule := d.Get("main_module").(string)
+	found := false
+	for _, module := range downloaded {
+		if module.Name == mainModule {
+			found = true
+		}
+	}
+	if !found {
+		mainModule = downloaded[0].Name
+	}
+
+	modules := &schema.Set{F: schema.HashResource(workerScriptModuleResource)}
+	for _, module := range downloaded {
+		if module.Name == mainModule {
+			if err := d.Set("content", string(module.Content)); err != nil {
+				return attributeErrorDiagnostic(cty.GetAttrPath("content"), fmt.Errorf("cannot set content: %v", err))
+			}
+			continue
+		}
+
+		contentType := module.ContentType
+		if configured, ok := configuredTypes[module.Name]; ok && (contentType == "" || strings.HasPrefix(configured, contentType)) {
+			contentType = configured
+		}
+		if !contains(workerScriptModuleContentTypes, contentType) {
+			contentType = workerScriptDefaultModuleContentType
+		}
+
+		content := string(module.Content)
+		if contains(workerScriptBinaryContentTypes, contentType) {
+			content = base64.StdEncoding.EncodeToString(module.Content)
+		}
+
+		modules.Add(map[string]interface{}{
+			"name":         module.Name,
+			"content":      content,
+			"content_type": contentType,
+		})
+	}
+
+	if err := d.Set("module", true); err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("module"), fmt.Errorf("cannot set module: %v", err))
+	}
+
+	if err := d.Set("main_module", mainModule); err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("main_module"), fmt.Errorf("cannot set main module: %v", err))
+	}
+
+	if err := d.Set("modules", modules); err != nil {
+		return attributeErrorDiagnostic(cty.GetAttrPath("modules"), fmt.Errorf("cannot set modules (%s): %v", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	scriptData, err := getScriptData(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// make sure that the worker does not already exist
+	r, _ := client.DownloadWorker(ctx, &scriptData.Params)
+	if r.WorkerScript.Script != "" {
+		return diag.Errorf("script already exists")
+	}
+
+	upload, err := buildWorkerScriptUpload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating Cloudflare Worker Script from struct: %+v", &scriptData.Params)
+
+	err = uploadWorkerScript(ctx, client, scriptData.Params.ScriptName, upload)
 	if err != nil {
-		return errors.Wrap(err, "error creating worker script")
+		return diag.FromErr(errors.Wrap(err, "error creating worker script"))
 	}
 
 	d.SetId(scriptData.ID)
@@ -218,15 +559,15 @@ func resourceCloudflareWorkerScriptCreate(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceCloudflareWorkerScriptRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	scriptData, err := getScriptData(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	r, err := client.DownloadWorker(context.Background(), &scriptData.Params)
+	r, err := client.DownloadWorker(ctx, &scriptData.Params)
 	if err != nil {
 		// If the resource is deleted, we should set the ID to "" and not
 		// return an error according to the terraform spec
@@ -235,17 +576,17 @@ func resourceCloudflareWorkerScriptRead(d *schema.ResourceData, meta interface{}
 			return nil
 		}
 
-		return errors.Wrap(err,
-			fmt.Sprintf("Error reading worker script from API for resource %+v", &scriptData.Params))
+		return diag.FromErr(errors.Wrap(err,
+			fmt.Sprintf("Error reading worker script from API for resource %+v", &scriptData.Params)))
 	}
 
 	existingBindings := make(ScriptBindings)
 
 	parseWorkerBindings(d, existingBindings)
 
-	bindings, err := getWorkerScriptBindings(d.Get("name").(string), client)
+	bindings, err := getWorkerScriptBindings(ctx, d.Get("name").(string), client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	kvNamespaceBindings := &schema.Set{F: schema.HashResource(kvNamespaceBindingResource)}
@@ -253,8 +594,13 @@ func resourceCloudflareWorkerScriptRead(d *schema.ResourceData, meta interface{}
 	secretTextBindings := &schema.Set{F: schema.HashResource(secretTextBindingResource)}
 	webAssemblyBindings := &schema.Set{F: schema.HashResource(webAssemblyBindingResource)}
 
+	hasUnknownBindings := false
 	for name, binding := range bindings {
 		switch v := binding.(type) {
+		case cloudflare.WorkerInheritBinding:
+			// cloudflare-go returns every binding type it doesn't know about
+			// as an inherit binding.
+			hasUnknownBindings = true
 		case cloudflare.WorkerKvNamespaceBinding:
 			kvNamespaceBindings.Add(map[string]interface{}{
 				"name":         name,
@@ -278,7 +624,7 @@ func resourceCloudflareWorkerScriptRead(d *schema.ResourceData, meta interface{}
 		case cloudflare.WorkerWebAssemblyBinding:
 			module, err := ioutil.ReadAll(v.Module)
 			if err != nil {
-				return errors.Wrap(err, fmt.Sprintf("cannot read contents of wasm bindings (%s)", name))
+				return diag.FromErr(errors.Wrap(err, fmt.Sprintf("cannot read contents of wasm bindings (%s)", name)))
 			}
 			webAssemblyBindings.Add(map[string]interface{}{
 				"name":   name,
@@ -287,72 +633,94 @@ func resourceCloudflareWorkerScriptRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if err := d.Set("content", r.Script); err != nil {
-		return fmt.Errorf("cannot set content: %v", err)
+	metadataBindings := make(map[string]map[string]interface{})
+	if hasUnknownBindings {
+		metadataBindings, err = listWorkerScriptBindingMetadata(ctx, client, scriptData.Params.ScriptName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for name := range metadataBindings {
+			if _, ok := bindings[name].(cloudflare.WorkerInheritBinding); !ok {
+				delete(metadataBindings, name)
+			}
+		}
+	}
+
+	flattenedBindings := flattenWorkerBindingMetadata(scriptData.Params.ScriptName, parseWorkerBindingMetadata(d), metadataBindings)
+
+	diags := setWorkerScriptContent(d, r.Script)
+	if diags.HasError() {
+		return diags
 	}
 
 	if err := d.Set("kv_namespace_binding", kvNamespaceBindings); err != nil {
-		return fmt.Errorf("cannot set kv namespace bindings (%s): %v", d.Id(), err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("kv_namespace_binding"), fmt.Errorf("cannot set kv namespace bindings (%s): %v", d.Id(), err))
 	}
 
 	if err := d.Set("plain_text_binding", plainTextBindings); err != nil {
-		return fmt.Errorf("cannot set plain text bindings (%s): %v", d.Id(), err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("plain_text_binding"), fmt.Errorf("cannot set plain text bindings (%s): %v", d.Id(), err))
 	}
 
 	if err := d.Set("secret_text_binding", secretTextBindings); err != nil {
-		return fmt.Errorf("cannot set secret text bindings (%s): %v", d.Id(), err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("secret_text_binding"), fmt.Errorf("cannot set secret text bindings (%s): %v", d.Id(), err))
 	}
 
 	if err := d.Set("webassembly_binding", webAssemblyBindings); err != nil {
-		return fmt.Errorf("cannot set webassembly bindings (%s): %v", d.Id(), err)
+		return attributeErrorDiagnostic(cty.GetAttrPath("webassembly_binding"), fmt.Errorf("cannot set webassembly bindings (%s): %v", d.Id(), err))
 	}
 
-	return nil
+	for _, key := range []string{"durable_object_namespace_binding", "service_binding", "r2_bucket_binding", "analytics_engine_binding", "unknown_binding"} {
+		if err := d.Set(key, flattenedBindings[key]); err != nil {
+			return attributeErrorDiagnostic(cty.GetAttrPath(key), fmt.Errorf("cannot set %s (%s): %v", key, d.Id(), err))
+		}
+	}
+
+	for _, rawData := range flattenedBindings["unknown_binding"] {
+		data := rawData.(map[string]interface{})
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Unsupported binding %q of type %q", data["name"], data["type"]),
+			Detail:   "The worker script has a binding this provider can't manage. It will be removed the next time the script is updated.",
+		})
+	}
+
+	return diags
 }
 
-func resourceCloudflareWorkerScriptUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkerScriptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	scriptData, err := getScriptData(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	scriptBody := d.Get("content").(string)
-	if scriptBody == "" {
-		return fmt.Errorf("script content cannot be empty")
+	upload, err := buildWorkerScriptUpload(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Updating Cloudflare Worker Script from struct: %+v", &scriptData.Params)
 
-	bindings := make(ScriptBindings)
-
-	parseWorkerBindings(d, bindings)
-
-	scriptParams := cloudflare.WorkerScriptParams{
-		Script:   scriptBody,
-		Bindings: bindings,
-	}
-
-	_, err = client.UploadWorkerWithBindings(context.Background(), &scriptData.Params, &scriptParams)
+	err = uploadWorkerScript(ctx, client, scriptData.Params.ScriptName, upload)
 	if err != nil {
-		return errors.Wrap(err, "error updating worker script")
+		return diag.FromErr(errors.Wrap(err, "error updating worker script"))
 	}
 
 	return nil
 }
 
-func resourceCloudflareWorkerScriptDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkerScriptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	scriptData, err := getScriptData(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting Cloudflare Worker Script from struct: %+v", &scriptData.Params)
 
-	_, err = client.DeleteWorker(context.Background(), &scriptData.Params)
+	_, err = client.DeleteWorker(ctx, &scriptData.Params)
 	if err != nil {
 		// If the resource is already deleted, we should return without an error
 		// according to the terraform spec
@@ -360,17 +728,17 @@ func resourceCloudflareWorkerScriptDelete(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return errors.Wrap(err, "error deleting worker script")
+		return diag.FromErr(errors.Wrap(err, "error deleting worker script"))
 	}
 
 	return nil
 }
 
-func resourceCloudflareWorkerScriptImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareWorkerScriptImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	scriptID := d.Id()
 	_ = d.Set("name", scriptID)
 
-	_ = resourceCloudflareWorkerScriptRead(d, meta)
+	_ = resourceCloudflareWorkerScriptRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
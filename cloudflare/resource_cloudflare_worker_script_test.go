@@ -3,11 +3,13 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -15,6 +17,7 @@ const (
 	scriptContent1 = `addEventListener('fetch', event => {event.respondWith(new Response('test 1'))});`
 	scriptContent2 = `addEventListener('fetch', event => {event.respondWith(new Response('test 2'))});`
 	encodedWasm    = "AGFzbQEAAAAGgYCAgAAA" // wat source: `(module)`, so literally just an empty wasm module
+	moduleContent  = `import { greeting } from "./utils.js"; export default { fetch() { return new Response(greeting); } };`
 )
 
 func TestAccCloudflareWorkerScript_MultiScriptEnt(t *testing.T) {
@@ -60,6 +63,111 @@ func TestAccCloudflareWorkerScript_MultiScriptEnt(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWorkerScript_ModuleUpload(t *testing.T) {
+	t.Parallel()
+
+	var script cloudflare.WorkerScript
+	rnd := generateRandomResourceName()
+	name := "cloudflare_worker_script." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareWorkerScriptDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareWorkerScriptConfigModule(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerScriptExists(name, &script, []string{"MY_PLAIN_TEXT"}),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "module", "true"),
+					resource.TestCheckResourceAttr(name, "main_module", "worker.js"),
+					resource.TestCheckResourceAttr(name, "content", moduleContent+"\n"),
+					resource.TestCheckResourceAttr(name, "modules.#", "1"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareWorkerScript_ServiceBinding(t *testing.T) {
+	t.Parallel()
+
+	var script cloudflare.WorkerScript
+	rnd := generateRandomResourceName()
+	name := "cloudflare_worker_script." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareWorkerScriptDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareWorkerScriptConfigServiceBinding(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerScriptExists(name, &script, []string{"MY_SERVICE"}),
+					resource.TestCheckResourceAttr(name, "service_binding.#", "1"),
+					resource.TestCheckResourceAttr(name, "service_binding.0.name", "MY_SERVICE"),
+					resource.TestCheckResourceAttr(name, "service_binding.0.service", rnd+"-backend"),
+					resource.TestCheckResourceAttr(name, "service_binding.0.environment", "production"),
+					resource.TestCheckResourceAttr(name, "unknown_binding.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareWorkerScriptConfigServiceBinding(rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s_backend" {
+  name    = "%[1]s-backend"
+  content = "%[2]s"
+}
+
+resource "cloudflare_worker_script" "%[1]s" {
+  name    = "%[1]s"
+  content = "%[2]s"
+
+  service_binding {
+    name    = "MY_SERVICE"
+    service = cloudflare_worker_script.%[1]s_backend.name
+  }
+}`, rnd, scriptContent1)
+}
+
+func testAccCheckCloudflareWorkerScriptConfigModule(rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s" {
+  name        = "%[1]s"
+  module      = true
+  main_module = "worker.js"
+  content     = <<EOT
+%[2]s
+EOT
+
+  modules {
+    name    = "utils.js"
+    content = "export const greeting = \"hello\";"
+  }
+
+  plain_text_binding {
+    name = "MY_PLAIN_TEXT"
+    text = "%[1]s"
+  }
+}`, rnd, moduleContent)
+}
+
 func testAccCheckCloudflareWorkerScriptConfigMultiScriptInitial(rnd string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_worker_script" "%[1]s" {
@@ -127,7 +235,7 @@ func testAccCheckCloudflareWorkerScriptExists(n string, script *cloudflare.Worke
 			return fmt.Errorf("No Worker Script ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		params := getRequestParamsFromResource(rs)
 		r, err := client.DownloadWorker(context.Background(), &params)
 		if err != nil {
@@ -139,7 +247,7 @@ func testAccCheckCloudflareWorkerScriptExists(n string, script *cloudflare.Worke
 		}
 
 		name := strings.Replace(n, "cloudflare_worker_script.", "", -1)
-		foundBindings, err := getWorkerScriptBindings(name, client)
+		foundBindings, err := getWorkerScriptBindings(context.Background(), name, client)
 		if err != nil {
 			return fmt.Errorf("cannot list script bindings: %v", err)
 		}
@@ -161,7 +269,7 @@ func testAccCheckCloudflareWorkerScriptDestroy(s *terraform.State) error {
 			continue
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		params := getRequestParamsFromResource(rs)
 		r, _ := client.DownloadWorker(context.Background(), &params)
 
@@ -172,3 +280,48 @@ func testAccCheckCloudflareWorkerScriptDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestWorkerBindingMetadata(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareWorkerScript().Schema, map[string]interface{}{
+		"name":    "my-script",
+		"content": scriptContent1,
+		"durable_object_namespace_binding": []interface{}{
+			map[string]interface{}{"name": "MY_DO", "class_name": "Counter"},
+		},
+		"service_binding": []interface{}{
+			map[string]interface{}{"name": "MY_SERVICE", "service": "backend"},
+		},
+		"r2_bucket_binding": []interface{}{
+			map[string]interface{}{"name": "MY_BUCKET", "bucket_name": "bucket"},
+		},
+		"analytics_engine_binding": []interface{}{
+			map[string]interface{}{"name": "MY_DATASET", "dataset": "dataset"},
+		},
+	})
+
+	configured := parseWorkerBindingMetadata(d)
+	expected := map[string]map[string]interface{}{
+		"MY_DO":      {"type": "durable_object_namespace", "class_name": "Counter"},
+		"MY_SERVICE": {"type": "service", "service": "backend", "environment": "production"},
+		"MY_BUCKET":  {"type": "r2_bucket", "bucket_name": "bucket"},
+		"MY_DATASET": {"type": "analytics_engine", "dataset": "dataset"},
+	}
+	if !reflect.DeepEqual(configured, expected) {
+		t.Errorf("unexpected metadata:\n got: %v\nwant: %v", configured, expected)
+	}
+
+	flattened := flattenWorkerBindingMetadata("my-script", configured, map[string]map[string]interface{}{
+		"MY_DO":      {"name": "MY_DO", "type": "durable_object_namespace", "class_name": "Counter", "script_name": "my-script", "namespace_id": "abc"},
+		"MY_SERVICE": {"name": "MY_SERVICE", "type": "service", "service": "backend", "environment": "production"},
+		"MY_QUEUE":   {"name": "MY_QUEUE", "type": "queue", "queue_name": "queue"},
+	})
+
+	expectedFlattened := map[string][]interface{}{
+		"durable_object_namespace_binding": {map[string]interface{}{"name": "MY_DO", "class_name": "Counter", "script_name": ""}},
+		"service_binding":                  {map[string]interface{}{"name": "MY_SERVICE", "service": "backend", "environment": "production"}},
+		"unknown_binding":                  {map[string]interface{}{"name": "MY_QUEUE", "type": "queue"}},
+	}
+	if !reflect.DeepEqual(flattened, expectedFlattened) {
+		t.Errorf("unexpected bindings:\n got: %v\nwant: %v", flattened, expectedFlattened)
+	}
+}
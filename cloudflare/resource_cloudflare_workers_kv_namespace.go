@@ -6,18 +6,19 @@ import (
 	"log"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
 func resourceCloudflareWorkersKVNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareWorkersKVNamespaceCreate,
-		Read:   resourceCloudflareWorkersKVNamespaceRead,
-		Update: resourceCloudflareWorkersKVNamespaceUpdate,
-		Delete: resourceCloudflareWorkersKVNamespaceDelete,
+		CreateContext: resourceCloudflareWorkersKVNamespaceCreate,
+		ReadContext:   resourceCloudflareWorkersKVNamespaceRead,
+		UpdateContext: resourceCloudflareWorkersKVNamespaceUpdate,
+		DeleteContext: resourceCloudflareWorkersKVNamespaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareWorkersKVNamespaceImport,
+			StateContext: resourceCloudflareWorkersKVNamespaceImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -29,8 +30,8 @@ func resourceCloudflareWorkersKVNamespace() *schema.Resource {
 	}
 }
 
-func resourceCloudflareWorkersKVNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkersKVNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	req := &cloudflare.WorkersKVNamespaceRequest{
 		Title: d.Get("title").(string),
@@ -38,13 +39,13 @@ func resourceCloudflareWorkersKVNamespaceCreate(d *schema.ResourceData, meta int
 
 	log.Printf("[Info] Creating Cloudflare Workers KV Namespace from struct: %+v", req)
 
-	r, err := client.CreateWorkersKVNamespace(context.Background(), req)
+	r, err := client.CreateWorkersKVNamespace(ctx, req)
 	if err != nil {
-		return errors.Wrap(err, "error creating workers kv namespace")
+		return diag.FromErr(errors.Wrap(err, "error creating workers kv namespace"))
 	}
 
 	if r.Result.ID == "" {
-		return fmt.Errorf("failed to find id in Create response; resource was empty")
+		return diag.Errorf("failed to find id in Create response; resource was empty")
 	}
 
 	d.SetId(r.Result.ID)
@@ -54,13 +55,13 @@ func resourceCloudflareWorkersKVNamespaceCreate(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceCloudflareWorkersKVNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkersKVNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	namespaceID := d.Id()
 
-	resp, err := client.ListWorkersKVNamespaces(context.Background())
+	resp, err := client.ListWorkersKVNamespaces(ctx)
 	if err != nil {
-		return errors.Wrap(err, "error reading workers kv namespaces")
+		return diag.FromErr(errors.Wrap(err, "error reading workers kv namespaces"))
 	}
 
 	var namespace cloudflare.WorkersKVNamespace
@@ -79,8 +80,8 @@ func resourceCloudflareWorkersKVNamespaceRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceCloudflareWorkersKVNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkersKVNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	namespace := &cloudflare.WorkersKVNamespaceRequest{
 		Title: d.Get("title").(string),
@@ -88,31 +89,31 @@ func resourceCloudflareWorkersKVNamespaceUpdate(d *schema.ResourceData, meta int
 
 	log.Printf("[INFO] Updating Cloudflare Workers KV Namespace from struct %+v", namespace)
 
-	_, err := client.UpdateWorkersKVNamespace(context.Background(), d.Id(), namespace)
+	_, err := client.UpdateWorkersKVNamespace(ctx, d.Id(), namespace)
 	if err != nil {
-		return errors.Wrap(err, "error updating workers kv namespace")
+		return diag.FromErr(errors.Wrap(err, "error updating workers kv namespace"))
 	}
 
 	return nil
 }
 
-func resourceCloudflareWorkersKVNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkersKVNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	log.Printf("[INFO] Deleting Cloudflare Workers KV Namespace with id: %+v", d.Id())
 
-	_, err := client.DeleteWorkersKVNamespace(context.Background(), d.Id())
+	_, err := client.DeleteWorkersKVNamespace(ctx, d.Id())
 	if err != nil {
-		return errors.Wrap(err, "error deleting workers kv namespace")
+		return diag.FromErr(errors.Wrap(err, "error deleting workers kv namespace"))
 	}
 
 	return nil
 }
 
-func resourceCloudflareWorkersKVNamespaceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareWorkersKVNamespaceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerClient)
 
-	namespaces, err := client.ListWorkersKVNamespaces(context.Background())
+	namespaces, err := client.ListWorkersKVNamespaces(ctx)
 	var title string
 
 	for _, n := range namespaces {
@@ -9,6 +9,8 @@ import (
 	"golang.org/x/net/idna"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +24,10 @@ const (
 	planIDEnterprise = "enterprise"
 )
 
+// zoneTypeSecondary is the type of zones whose records are transferred from
+// a primary name server rather than managed on Cloudflare.
+const zoneTypeSecondary = "secondary"
+
 // we keep a private map and we will have a function to check and validate the descriptive name from the RatePlan API with the legacy_id
 var idForName = map[string]string{
 	"Free Website":       planIDFree,
@@ -41,13 +47,17 @@ var subscriptionIDOfRatePlans = map[string]string{
 
 func resourceCloudflareZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareZoneCreate,
-		Read:   resourceCloudflareZoneRead,
-		Update: resourceCloudflareZoneUpdate,
-		Delete: resourceCloudflareZoneDelete,
+		CreateContext: resourceCloudflareZoneCreate,
+		ReadContext:   resourceCloudflareZoneRead,
+		UpdateContext: resourceCloudflareZoneUpdate,
+		DeleteContext: resourceCloudflareZoneDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		// A zone can't be converted to or from a secondary zone.
+		CustomizeDiff: customdiff.ForceNewIfChange("type", func(ctx context.Context, old, new, meta interface{}) bool {
+			return old.(string) != "" && (old.(string) == zoneTypeSecondary) != (new.(string) == zoneTypeSecondary)
+		}),
 
 		Schema: map[string]*schema.Schema{
 			"zone": {
@@ -91,7 +101,7 @@ func resourceCloudflareZone() *schema.Resource {
 			},
 			"type": {
 				Type:         schema.TypeString,
-				ValidateFunc: validation.StringInSlice([]string{"full", "partial"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"full", "partial", zoneTypeSecondary}, false),
 				Default:      "full",
 				Optional:     true,
 			},
@@ -110,8 +120,8 @@ func resourceCloudflareZone() *schema.Resource {
 	}
 }
 
-func resourceCloudflareZoneCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	zoneName := d.Get("zone").(string)
 	jumpstart := d.Get("jump_start").(bool)
@@ -120,47 +130,55 @@ func resourceCloudflareZoneCreate(d *schema.ResourceData, meta interface{}) erro
 		ID: client.AccountID,
 	}
 
+	// The records of secondary zones come from the zone transfers so there
+	// is nothing to scan.
+	if zoneType == zoneTypeSecondary && jumpstart {
+		log.Printf("[WARN] jump_start is ignored for secondary zone %s", zoneName)
+		jumpstart = false
+	}
+
 	log.Printf("[INFO] Creating Cloudflare Zone: name %s", zoneName)
 
-	zone, err := client.CreateZone(context.Background(), zoneName, jumpstart, account, zoneType)
+	zone, err := client.CreateZone(ctx, zoneName, jumpstart, account, zoneType)
 
 	if err != nil {
-		return fmt.Errorf("error creating zone %q: %s", zoneName, err)
+		return diag.Errorf("error creating zone %q: %s", zoneName, err)
 	}
 
 	d.SetId(zone.ID)
+	client.cache.invalidate(zoneNamesCacheKey)
 
 	if paused, ok := d.GetOk("paused"); ok {
 		if paused.(bool) == true {
-			_, err := client.ZoneSetPaused(context.Background(), zone.ID, paused.(bool))
+			_, err := client.ZoneSetPaused(ctx, zone.ID, paused.(bool))
 
 			if err != nil {
-				return fmt.Errorf("error updating zone_id %q: %s", zone.ID, err)
+				return diag.Errorf("error updating zone_id %q: %s", zone.ID, err)
 			}
 		}
 	}
 
 	if plan, ok := d.GetOk("plan"); ok {
-		if err := setRatePlan(client, zone.ID, plan.(string), true, d); err != nil {
-			return err
+		if err := setRatePlan(ctx, client, zone.ID, plan.(string), true, d); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	if ztype, ok := d.GetOk("type"); ok {
-		_, err := client.ZoneSetType(context.Background(), zone.ID, ztype.(string))
+	if ztype, ok := d.GetOk("type"); ok && ztype.(string) != zoneTypeSecondary {
+		_, err := client.ZoneSetType(ctx, zone.ID, ztype.(string))
 		if err != nil {
-			return fmt.Errorf("error setting type on zone ID %q: %s", zone.ID, err)
+			return diag.Errorf("error setting type on zone ID %q: %s", zone.ID, err)
 		}
 	}
 
-	return resourceCloudflareZoneRead(d, meta)
+	return resourceCloudflareZoneRead(ctx, d, meta)
 }
 
-func resourceCloudflareZoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Id()
 
-	zone, err := client.ZoneDetails(context.Background(), zoneID)
+	zone, err := client.ZoneDetails(ctx, zoneID)
 
 	log.Printf("[DEBUG] ZoneDetails: %#v", zone)
 	log.Printf("[DEBUG] ZoneDetails error: %#v", err)
@@ -171,7 +189,7 @@ func resourceCloudflareZoneRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding Zone %q: %s", d.Id(), err)
+		return diag.Errorf("error finding Zone %q: %s", d.Id(), err)
 	}
 
 	// In the cases where the zone isn't completely setup yet, we need to
@@ -197,28 +215,32 @@ func resourceCloudflareZoneRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceCloudflareZoneUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Id()
-	zone, _ := client.ZoneDetails(context.Background(), zoneID)
+	zone, _ := client.ZoneDetails(ctx, zoneID)
 
 	log.Printf("[INFO] Updating Cloudflare Zone: id %s", zoneID)
 
+	cache := client.cache
+	defer cache.invalidate(zoneNamesCacheKey)
+	defer cache.invalidate(zoneCacheKey(zoneID))
+
 	if paused, ok := d.GetOkExists("paused"); ok && d.HasChange("paused") {
 		log.Printf("[DEBUG] _ paused")
 
-		_, err := client.ZoneSetPaused(context.Background(), zoneID, paused.(bool))
+		_, err := client.ZoneSetPaused(ctx, zoneID, paused.(bool))
 
 		if err != nil {
-			return fmt.Errorf("error setting paused for zone ID %q: %s", zoneID, err)
+			return diag.Errorf("error setting paused for zone ID %q: %s", zoneID, err)
 		}
 	}
 
 	if ztype, ok := d.GetOkExists("type"); ok && d.HasChange("type") {
-		_, err := client.ZoneSetType(context.Background(), zoneID, ztype.(string))
+		_, err := client.ZoneSetType(ctx, zoneID, ztype.(string))
 
 		if err != nil {
-			return fmt.Errorf("error setting type for on zone ID %q: %s", zoneID, err)
+			return diag.Errorf("error setting type for on zone ID %q: %s", zoneID, err)
 		}
 	}
 
@@ -237,24 +259,28 @@ func resourceCloudflareZoneUpdate(d *schema.ResourceData, meta interface{}) erro
 		wasFreePlan := existingPlan.(string) == "free"
 		planID := newPlan.(string)
 
-		if err := setRatePlan(client, zoneID, planID, wasFreePlan, d); err != nil {
-			return err
+		if err := setRatePlan(ctx, client, zoneID, planID, wasFreePlan, d); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceCloudflareZoneRead(d, meta)
+	return resourceCloudflareZoneRead(ctx, d, meta)
 }
 
-func resourceCloudflareZoneDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Id()
 
 	log.Printf("[INFO] Deleting Cloudflare Zone: id %s", zoneID)
 
-	_, err := client.DeleteZone(context.Background(), zoneID)
+	cache := client.cache
+	defer cache.invalidate(zoneNamesCacheKey)
+	defer cache.invalidate(zoneCacheKey(zoneID))
+
+	_, err := client.DeleteZone(ctx, zoneID)
 
 	if err != nil {
-		return fmt.Errorf("error deleting Cloudflare Zone: %s", err)
+		return diag.Errorf("error deleting Cloudflare Zone: %s", err)
 	}
 
 	return nil
@@ -273,23 +299,23 @@ func flattenMeta(d *schema.ResourceData, meta cloudflare.ZoneMeta) map[string]in
 
 // setRatePlan handles the internals of creating or updating a zone
 // subscription rate plan.
-func setRatePlan(client *cloudflare.API, zoneID, planID string, isNewPlan bool, d *schema.ResourceData) error {
+func setRatePlan(ctx context.Context, client *providerClient, zoneID, planID string, isNewPlan bool, d *schema.ResourceData) error {
 	if isNewPlan {
 		// A free rate plan is the default so no need to explicitly make another
 		// HTTP call to set it.
 		if subscriptionIDOfRatePlans[planID] != planIDFree {
-			if err := client.ZoneSetPlan(context.Background(), zoneID, subscriptionIDOfRatePlans[planID]); err != nil {
+			if err := client.ZoneSetPlan(ctx, zoneID, subscriptionIDOfRatePlans[planID]); err != nil {
 				return fmt.Errorf("error setting plan %s for zone %q: %s", planID, zoneID, err)
 			}
 		}
 	} else {
-		if err := client.ZoneUpdatePlan(context.Background(), zoneID, subscriptionIDOfRatePlans[planID]); err != nil {
+		if err := client.ZoneUpdatePlan(ctx, zoneID, subscriptionIDOfRatePlans[planID]); err != nil {
 			return fmt.Errorf("error updating plan %s for zone %q: %s", planID, zoneID, err)
 		}
 	}
 
-	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		zone, _ := client.ZoneDetails(context.Background(), zoneID)
+	return resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		zone, _ := client.ZoneDetails(ctx, zoneID)
 
 		if zone.Plan.LegacyID != planID {
 			return resource.RetryableError(fmt.Errorf("plan ID change has not yet propagated"))
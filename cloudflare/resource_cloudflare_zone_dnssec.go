@@ -2,12 +2,12 @@ package cloudflare
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,12 +19,12 @@ const (
 
 func resourceCloudflareZoneDNSSEC() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareZoneDNSSECCreate,
-		Read:   resourceCloudflareZoneDNSSECRead,
-		Update: resourceCloudflareZoneDNSSECUpdate,
-		Delete: resourceCloudflareZoneDNSSECDelete,
+		CreateContext: resourceCloudflareZoneDNSSECCreate,
+		ReadContext:   resourceCloudflareZoneDNSSECRead,
+		UpdateContext: resourceCloudflareZoneDNSSECUpdate,
+		DeleteContext: resourceCloudflareZoneDNSSECDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,33 +82,33 @@ func resourceCloudflareZoneDNSSEC() *schema.Resource {
 	}
 }
 
-func resourceCloudflareZoneDNSSECCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneDNSSECCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Creating Cloudflare Zone DNSSEC: name %s", zoneID)
 
-	currentDNSSEC, err := client.ZoneDNSSECSetting(context.Background(), zoneID)
+	currentDNSSEC, err := client.ZoneDNSSECSetting(ctx, zoneID)
 	if err != nil {
-		return fmt.Errorf("error finding Zone DNSSEC %q: %s", zoneID, err)
+		return diag.Errorf("error finding Zone DNSSEC %q: %s", zoneID, err)
 	}
 	if currentDNSSEC.Status != DNSSECStatusActive {
-		_, err := client.UpdateZoneDNSSEC(context.Background(), zoneID, cloudflare.ZoneDNSSECUpdateOptions{Status: DNSSECStatusActive})
+		_, err := client.UpdateZoneDNSSEC(ctx, zoneID, cloudflare.ZoneDNSSECUpdateOptions{Status: DNSSECStatusActive})
 
 		if err != nil {
-			return fmt.Errorf("error creating zone DNSSEC %q: %s", zoneID, err)
+			return diag.Errorf("error creating zone DNSSEC %q: %s", zoneID, err)
 		}
 
 	}
 
 	d.SetId(zoneID)
 
-	return resourceCloudflareZoneDNSSECRead(d, meta)
+	return resourceCloudflareZoneDNSSECRead(ctx, d, meta)
 }
 
-func resourceCloudflareZoneDNSSECRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	zoneID := d.Get("zone_id").(string)
 
@@ -118,13 +118,13 @@ func resourceCloudflareZoneDNSSECRead(d *schema.ResourceData, meta interface{})
 		zoneID = d.Id()
 	}
 
-	dnssec, err := client.ZoneDNSSECSetting(context.Background(), zoneID)
+	dnssec, err := client.ZoneDNSSECSetting(ctx, zoneID)
 	if err != nil {
-		return fmt.Errorf("error finding Zone DNSSEC %q: %s", zoneID, err)
+		return diag.Errorf("error finding Zone DNSSEC %q: %s", zoneID, err)
 	}
 
 	if dnssec.Status == DNSSECStatusDisabled {
-		return fmt.Errorf("zone DNSSEC %q: already disabled", zoneID)
+		return diag.Errorf("zone DNSSEC %q: already disabled", zoneID)
 	}
 
 	d.Set("zone_id", zoneID)
@@ -144,18 +144,18 @@ func resourceCloudflareZoneDNSSECRead(d *schema.ResourceData, meta interface{})
 }
 
 // Just returning remote state since changing the zone ID would force a new resource
-func resourceCloudflareZoneDNSSECUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceCloudflareZoneRead(d, meta)
+func resourceCloudflareZoneDNSSECUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceCloudflareZoneRead(ctx, d, meta)
 }
 
-func resourceCloudflareZoneDNSSECDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneDNSSECDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Zone DNSSEC: id %s", zoneID)
 
-	_, err := client.UpdateZoneDNSSEC(context.Background(), zoneID, cloudflare.ZoneDNSSECUpdateOptions{Status: DNSSECStatusDisabled})
+	_, err := client.UpdateZoneDNSSEC(ctx, zoneID, cloudflare.ZoneDNSSECUpdateOptions{Status: DNSSECStatusDisabled})
 
 	if err != nil {
 		if strings.Contains(err.Error(), "DNSSEC is already disabled") {
@@ -163,7 +163,7 @@ func resourceCloudflareZoneDNSSECDelete(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error deleting Cloudflare Zone DNSSEC: %s", err)
+		return diag.Errorf("error deleting Cloudflare Zone DNSSEC: %s", err)
 	}
 
 	return nil
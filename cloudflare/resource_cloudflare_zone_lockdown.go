@@ -7,18 +7,19 @@ import (
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCloudflareZoneLockdown() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareZoneLockdownCreate,
-		Read:   resourceCloudflareZoneLockdownRead,
-		Update: resourceCloudflareZoneLockdownUpdate,
-		Delete: resourceCloudflareZoneLockdownDelete,
+		CreateContext: resourceCloudflareZoneLockdownCreate,
+		ReadContext:   resourceCloudflareZoneLockdownRead,
+		UpdateContext: resourceCloudflareZoneLockdownUpdate,
+		DeleteContext: resourceCloudflareZoneLockdownDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCloudflareZoneLockdownImport,
+			StateContext: resourceCloudflareZoneLockdownImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,8 +72,8 @@ func resourceCloudflareZoneLockdown() *schema.Resource {
 	}
 }
 
-func resourceCloudflareZoneLockdownCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneLockdownCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	var err error
@@ -103,28 +104,28 @@ func resourceCloudflareZoneLockdownCreate(d *schema.ResourceData, meta interface
 
 	var r *cloudflare.ZoneLockdownResponse
 
-	r, err = client.CreateZoneLockdown(context.Background(), zoneID, newZoneLockdown)
+	r, err = client.CreateZoneLockdown(ctx, zoneID, newZoneLockdown)
 
 	if err != nil {
-		return fmt.Errorf("error creating zone lockdown for zone ID %q: %s", zoneID, err)
+		return diag.Errorf("error creating zone lockdown for zone ID %q: %s", zoneID, err)
 	}
 
 	if r.Result.ID == "" {
-		return fmt.Errorf("failed to find id in Create response; resource was empty")
+		return diag.Errorf("failed to find id in Create response; resource was empty")
 	}
 
 	d.SetId(r.Result.ID)
 
 	log.Printf("[INFO] Cloudflare Zone Lockdown ID: %s", d.Id())
 
-	return resourceCloudflareZoneLockdownRead(d, meta)
+	return resourceCloudflareZoneLockdownRead(ctx, d, meta)
 }
 
-func resourceCloudflareZoneLockdownRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneLockdownRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
-	zoneLockdownResponse, err := client.ZoneLockdown(context.Background(), zoneID, d.Id())
+	zoneLockdownResponse, err := client.ZoneLockdown(ctx, zoneID, d.Id())
 
 	log.Printf("[DEBUG] zoneLockdownResponse: %#v", zoneLockdownResponse)
 	log.Printf("[DEBUG] zoneLockdownResponse error: %#v", err)
@@ -135,7 +136,7 @@ func resourceCloudflareZoneLockdownRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error finding zone lockdown %q: %s", d.Id(), err)
+		return diag.Errorf("error finding zone lockdown %q: %s", d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] Cloudflare Zone Lockdown read configuration: %#v", zoneLockdownResponse)
@@ -163,8 +164,8 @@ func resourceCloudflareZoneLockdownRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceCloudflareZoneLockdownUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneLockdownUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	var newZoneLockdown cloudflare.ZoneLockdown
@@ -191,33 +192,33 @@ func resourceCloudflareZoneLockdownUpdate(d *schema.ResourceData, meta interface
 
 	log.Printf("[INFO] Updating Cloudflare Zone Lockdown from struct: %+v", newZoneLockdown)
 
-	r, err := client.UpdateZoneLockdown(context.Background(), zoneID, d.Id(), newZoneLockdown)
+	r, err := client.UpdateZoneLockdown(ctx, zoneID, d.Id(), newZoneLockdown)
 
 	if err != nil {
-		return fmt.Errorf("error updating zone lockdown for zone %q: %s", d.Get("zone").(string), err)
+		return diag.Errorf("error updating zone lockdown for zone %q: %s", d.Get("zone").(string), err)
 	}
 
 	if r.Result.ID == "" {
-		return fmt.Errorf("failed to find id in Update response; resource was empty")
+		return diag.Errorf("failed to find id in Update response; resource was empty")
 	}
 
 	d.SetId(r.Result.ID)
 
 	log.Printf("[INFO] Cloudflare Zone Lockdown ID: %s", d.Id())
 
-	return resourceCloudflareZoneLockdownRead(d, meta)
+	return resourceCloudflareZoneLockdownRead(ctx, d, meta)
 }
 
-func resourceCloudflareZoneLockdownDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneLockdownDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 	zoneID := d.Get("zone_id").(string)
 
 	log.Printf("[INFO] Deleting Cloudflare Zone Lockdown: id %s for zone %s", d.Id(), zoneID)
 
-	_, err := client.DeleteZoneLockdown(context.Background(), zoneID, d.Id())
+	_, err := client.DeleteZoneLockdown(ctx, zoneID, d.Id())
 
 	if err != nil {
-		return fmt.Errorf("error deleting Cloudflare Zone Lockdown: %s", err)
+		return diag.Errorf("error deleting Cloudflare Zone Lockdown: %s", err)
 	}
 
 	return nil
@@ -235,7 +236,7 @@ func expandZoneLockdownConfig(configs *schema.Set) []cloudflare.ZoneLockdownConf
 	return configArray
 }
 
-func resourceCloudflareZoneLockdownImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCloudflareZoneLockdownImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
 	var zoneID string
@@ -252,7 +253,7 @@ func resourceCloudflareZoneLockdownImport(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] zoneID: %s", zoneID)
 	log.Printf("[DEBUG] Resource ID : %s", zoneLockdownID)
 
-	resourceCloudflareZoneLockdownRead(d, meta)
+	resourceCloudflareZoneLockdownRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -12,6 +12,8 @@ import (
 	"reflect"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
@@ -19,10 +21,13 @@ import (
 
 func resourceCloudflareZoneSettingsOverride() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudflareZoneSettingsOverrideCreate,
-		Read:   resourceCloudflareZoneSettingsOverrideRead,
-		Update: resourceCloudflareZoneSettingsOverrideUpdate,
-		Delete: resourceCloudflareZoneSettingsOverrideDelete,
+		CreateContext: resourceCloudflareZoneSettingsOverrideCreate,
+		ReadContext:   resourceCloudflareZoneSettingsOverrideRead,
+		UpdateContext: resourceCloudflareZoneSettingsOverrideUpdate,
+		DeleteContext: resourceCloudflareZoneSettingsOverrideDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneSettingsOverrideImport,
+		},
 
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
@@ -50,6 +55,21 @@ func resourceCloudflareZoneSettingsOverride() *schema.Resource {
 				},
 			},
 
+			"baseline_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: resourceCloudflareZoneSettingsSchema,
+				},
+			},
+
+			"revert_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"initial_settings_read_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -549,8 +569,8 @@ var fetchAsSingleSetting = []string{
 	"early_hints",
 }
 
-func resourceCloudflareZoneSettingsOverrideCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneSettingsOverrideCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	zoneID := d.Get("zone_id").(string)
 	d.SetId(zoneID)
@@ -558,19 +578,19 @@ func resourceCloudflareZoneSettingsOverrideCreate(d *schema.ResourceData, meta i
 	log.Printf("[INFO] Creating zone settings resource for zone ID: %s", d.Id())
 
 	// do extra initial read to get initial_settings before updating
-	zoneSettings, err := client.ZoneSettings(context.Background(), d.Id())
+	zoneSettings, err := client.ZoneSettings(ctx, d.Id())
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("Error reading initial settings for zone %q", d.Id()))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("Error reading initial settings for zone %q", d.Id())))
 	}
 
-	if err = updateZoneSettingsResponseWithSingleZoneSettings(zoneSettings, d.Id(), client); err != nil {
-		return err
+	if err = updateZoneSettingsResponseWithSingleZoneSettings(ctx, zoneSettings, d.Id(), client); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if _, ok := d.GetOk("settings.0.universal_ssl"); ok {
 		// pulling USSL status and wrapping it into a cloudflare.ZoneSetting that we can set initial_settings
-		if err = updateZoneSettingsResponseWithUniversalSSLSettings(zoneSettings, d.Id(), client); err != nil {
-			return err
+		if err = updateZoneSettingsResponseWithUniversalSSLSettings(ctx, zoneSettings, d.Id(), client); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -589,12 +609,78 @@ func resourceCloudflareZoneSettingsOverrideCreate(d *schema.ResourceData, meta i
 
 	log.Printf("[DEBUG] Saved CloudflareZone initial settings: %#v", d.Get("initial_settings"))
 
-	return resourceCloudflareZoneSettingsOverrideUpdate(d, meta)
+	return resourceCloudflareZoneSettingsOverrideUpdate(ctx, d, meta)
 }
 
-func updateZoneSettingsResponseWithSingleZoneSettings(zoneSettings *cloudflare.ZoneSettingResponse, zoneId string, client *cloudflare.API) error {
+func resourceCloudflareZoneSettingsOverrideImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*providerClient)
+
+	zoneID := d.Id()
+	d.Set("zone_id", zoneID)
+	d.Set("revert_on_destroy", true)
+
+	log.Printf("[INFO] Importing zone settings resource for zone ID: %s", zoneID)
+
+	// The live settings are the best guess there is for the initial settings
+	// of an existing zone, `baseline_settings` takes precedence on destroy.
+	zoneSettings, err := client.ZoneSettings(ctx, zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Error reading initial settings for zone %q", zoneID))
+	}
+
+	if err = updateZoneSettingsResponseWithSingleZoneSettings(ctx, zoneSettings, zoneID, client); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("initial_settings", flattenZoneSettings(d, zoneSettings.Result, true)); err != nil {
+		return nil, fmt.Errorf("error setting initial_settings for zone %q: %s", zoneID, err)
+	}
+
+	d.Set("initial_settings_read_at", time.Now().UTC().Format(time.RFC3339Nano))
+
+	if err := diagnosticsError(resourceCloudflareZoneSettingsOverrideRead(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("zone %q not found", zoneID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// zoneSettingsBaseline returns the settings set in `baseline_settings`, which
+// are restored on destroy instead of the initial settings. The raw state is
+// used as zero values, such as a `browser_cache_ttl` of 0, can't otherwise be
+// told apart from settings missing from the baseline.
+func zoneSettingsBaseline(d *schema.ResourceData) map[string]interface{} {
+	baseline := map[string]interface{}{}
+
+	state := d.GetRawState()
+	if state.IsNull() || !state.IsKnown() {
+		return baseline
+	}
+
+	block := state.GetAttr("baseline_settings")
+	if block.IsNull() || !block.IsKnown() || block.LengthInt() == 0 {
+		return baseline
+	}
+
+	settings := block.Index(cty.NumberIntVal(0))
+	for k := range resourceCloudflareZoneSettingsSchema {
+		v := settings.GetAttr(k)
+		if v.IsNull() || !v.IsKnown() || (v.CanIterateElements() && v.LengthInt() == 0) {
+			continue
+		}
+		baseline[k] = d.Get(fmt.Sprintf("baseline_settings.0.%s", k))
+	}
+
+	return baseline
+}
+
+func updateZoneSettingsResponseWithSingleZoneSettings(ctx context.Context, zoneSettings *cloudflare.ZoneSettingResponse, zoneId string, client *providerClient) error {
 	for _, settingName := range fetchAsSingleSetting {
-		singleSetting, err := client.ZoneSingleSetting(context.Background(), zoneId, settingName)
+		singleSetting, err := client.ZoneSingleSetting(ctx, zoneId, settingName)
 		if err != nil {
 			return errors.Wrap(err, fmt.Sprintf("Error reading setting '%q' for zone %q", settingName, zoneId))
 		}
@@ -603,8 +689,8 @@ func updateZoneSettingsResponseWithSingleZoneSettings(zoneSettings *cloudflare.Z
 	return nil
 }
 
-func updateZoneSettingsResponseWithUniversalSSLSettings(zoneSettings *cloudflare.ZoneSettingResponse, zoneId string, client *cloudflare.API) error {
-	ussl, err := client.UniversalSSLSettingDetails(context.Background(), zoneId)
+func updateZoneSettingsResponseWithUniversalSSLSettings(ctx context.Context, zoneSettings *cloudflare.ZoneSettingResponse, zoneId string, client *providerClient) error {
+	ussl, err := client.UniversalSSLSettingDetails(ctx, zoneId)
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("Error reading initial Universal SSL settings for zone %q", zoneId))
 	}
@@ -620,17 +706,17 @@ func updateZoneSettingsResponseWithUniversalSSLSettings(zoneSettings *cloudflare
 	return nil
 }
 
-func resourceCloudflareZoneSettingsOverrideRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneSettingsOverrideRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
-	zone, err := client.ZoneDetails(context.Background(), d.Id())
+	zone, err := client.ZoneDetails(ctx, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
 			log.Printf("[INFO] Zone %q not found", d.Id())
 			d.SetId("")
 			return nil
 		} else {
-			return errors.Wrap(err, fmt.Sprintf("Error reading zone %q", d.Id()))
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("Error reading zone %q", d.Id())))
 		}
 	}
 
@@ -638,18 +724,18 @@ func resourceCloudflareZoneSettingsOverrideRead(d *schema.ResourceData, meta int
 
 	// not all settings are visible to all users, so this might be a subset
 	// assume (for now) that user can see/do everything
-	zoneSettings, err := client.ZoneSettings(context.Background(), d.Id())
+	zoneSettings, err := client.ZoneSettings(ctx, d.Id())
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("Error reading settings for zone %q", d.Id()))
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("Error reading settings for zone %q", d.Id())))
 	}
 
-	if err = updateZoneSettingsResponseWithSingleZoneSettings(zoneSettings, d.Id(), client); err != nil {
-		return err
+	if err = updateZoneSettingsResponseWithSingleZoneSettings(ctx, zoneSettings, d.Id(), client); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if _, ok := d.GetOk("settings.0.universal_ssl"); ok {
-		if err = updateZoneSettingsResponseWithUniversalSSLSettings(zoneSettings, d.Id(), client); err != nil {
-			return err
+		if err = updateZoneSettingsResponseWithUniversalSSLSettings(ctx, zoneSettings, d.Id(), client); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -732,11 +818,11 @@ func flattenReadOnlyZoneSettings(settings []cloudflare.ZoneSetting) []string {
 	return ids
 }
 
-func updateSingleZoneSettings(zoneSettings []cloudflare.ZoneSetting, client *cloudflare.API, zoneID string) ([]cloudflare.ZoneSetting, error) {
+func updateSingleZoneSettings(ctx context.Context, zoneSettings []cloudflare.ZoneSetting, client *providerClient, zoneID string) ([]cloudflare.ZoneSetting, error) {
 	var indexesToCut []int
 	for i, setting := range zoneSettings {
 		if contains(fetchAsSingleSetting, setting.ID) {
-			_, err := client.UpdateZoneSingleSetting(context.Background(), zoneID, setting.ID, setting)
+			_, err := client.UpdateZoneSingleSetting(ctx, zoneID, setting.ID, setting)
 			if err != nil {
 				return zoneSettings, err
 			}
@@ -750,13 +836,13 @@ func updateSingleZoneSettings(zoneSettings []cloudflare.ZoneSetting, client *clo
 	return zoneSettings, nil
 }
 
-func updateUniversalSSLSetting(zoneSettings []cloudflare.ZoneSetting, client *cloudflare.API, zoneID string) ([]cloudflare.ZoneSetting, error) {
+func updateUniversalSSLSetting(ctx context.Context, zoneSettings []cloudflare.ZoneSetting, client *providerClient, zoneID string) ([]cloudflare.ZoneSetting, error) {
 	indexToCut := -1
 	for i, setting := range zoneSettings {
 		// Skipping USSL Update if value is empty, especially when we are reverting to the initial state and we did not had the information
 		if setting.ID == "universal_ssl" {
 			if setting.Value.(string) != "" {
-				_, err := client.EditUniversalSSLSetting(context.Background(), zoneID, cloudflare.UniversalSSLSetting{Enabled: boolFromString(setting.Value.(string))})
+				_, err := client.EditUniversalSSLSetting(ctx, zoneID, cloudflare.UniversalSSLSetting{Enabled: boolFromString(setting.Value.(string))})
 				if err != nil {
 					return zoneSettings, err
 				}
@@ -772,38 +858,38 @@ func updateUniversalSSLSetting(zoneSettings []cloudflare.ZoneSetting, client *cl
 	return zoneSettings, nil
 }
 
-func resourceCloudflareZoneSettingsOverrideUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneSettingsOverrideUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
 
 	if cfg, ok := d.GetOkExists("settings"); ok && cfg != nil && len(cfg.([]interface{})) > 0 {
 
 		readOnlySettings := expandInterfaceToStringList(d.Get("readonly_settings"))
 		zoneSettings, err := expandOverriddenZoneSettings(d, "settings", readOnlySettings)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		log.Printf("[DEBUG] Cloudflare Zone Settings update configuration: %#v", zoneSettings)
 
-		if zoneSettings, err = updateSingleZoneSettings(zoneSettings, client, d.Id()); err != nil {
-			return err
+		if zoneSettings, err = updateSingleZoneSettings(ctx, zoneSettings, client, d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 
-		if zoneSettings, err = updateUniversalSSLSetting(zoneSettings, client, d.Id()); err != nil {
-			return err
+		if zoneSettings, err = updateUniversalSSLSetting(ctx, zoneSettings, client, d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 
 		if len(zoneSettings) > 0 {
-			_, err = client.UpdateZoneSettings(context.Background(), d.Id(), zoneSettings)
+			_, err = client.UpdateZoneSettings(ctx, d.Id(), zoneSettings)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			log.Printf("[DEBUG] Skipped update call because no settings were set")
 		}
 	}
 
-	return resourceCloudflareZoneSettingsOverrideRead(d, meta)
+	return resourceCloudflareZoneSettingsOverrideRead(ctx, d, meta)
 }
 
 func expandOverriddenZoneSettings(d *schema.ResourceData, settingsKey string, readOnlySettings []string) ([]cloudflare.ZoneSetting, error) {
@@ -817,7 +903,8 @@ func expandOverriddenZoneSettings(d *schema.ResourceData, settingsKey string, re
 		// note that if user removes an attribute, we don't do anything
 		if settingValue, ok := d.GetOkExists(fmt.Sprintf(keyFormat, k)); ok && d.HasChange(fmt.Sprintf(keyFormat, k)) {
 
-			zoneSettingValue, err := expandZoneSetting(d, keyFormat, k, settingValue, readOnlySettings)
+			polish := d.Get(fmt.Sprintf(keyFormat, "polish")).(string)
+			zoneSettingValue, err := expandZoneSetting(k, settingValue, polish, readOnlySettings)
 			if err != nil {
 				return zoneSettings, err
 			}
@@ -841,7 +928,9 @@ func expandOverriddenZoneSettings(d *schema.ResourceData, settingsKey string, re
 	return zoneSettings, nil
 }
 
-func expandZoneSetting(d *schema.ResourceData, keyFormatString, k string, settingValue interface{}, readOnlySettings []string) (interface{}, error) {
+// expandZoneSetting returns the API value of the setting. polish is the value
+// of polish alongside it as webp is only set when polish is on.
+func expandZoneSetting(k string, settingValue interface{}, polish string, readOnlySettings []string) (interface{}, error) {
 
 	if contains(readOnlySettings, k) {
 		return nil, fmt.Errorf("invalid zone setting %q (value: %v) found - cannot be set as it is read only", k, settingValue)
@@ -852,9 +941,6 @@ func expandZoneSetting(d *schema.ResourceData, keyFormatString, k string, settin
 	case "webp":
 		{
 			// only ever set webp if polish is on
-			polishKey := fmt.Sprintf(keyFormatString, "polish")
-			polish := d.Get(polishKey).(string)
-
 			if polish != "" && polish != "off" {
 				zoneSettingValue = settingValue
 			}
@@ -884,8 +970,13 @@ func expandZoneSetting(d *schema.ResourceData, keyFormatString, k string, settin
 	return zoneSettingValue, nil
 }
 
-func resourceCloudflareZoneSettingsOverrideDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*cloudflare.API)
+func resourceCloudflareZoneSettingsOverrideDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	if !d.Get("revert_on_destroy").(bool) {
+		log.Printf("[INFO] Leaving settings of zone %q untouched as revert_on_destroy is false", d.Id())
+		return nil
+	}
 
 	if cfg, ok := d.GetOkExists("settings"); ok && cfg != nil && len(cfg.([]interface{})) > 0 {
 
@@ -893,23 +984,23 @@ func resourceCloudflareZoneSettingsOverrideDelete(d *schema.ResourceData, meta i
 
 		zoneSettings, err := expandRevertibleZoneSettings(d, readOnlySettings)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		log.Printf("[DEBUG] Reverting Cloudflare Zone Settings to initial settings with update configuration: %#v", zoneSettings)
 
-		if zoneSettings, err = updateSingleZoneSettings(zoneSettings, client, d.Id()); err != nil {
-			return err
+		if zoneSettings, err = updateSingleZoneSettings(ctx, zoneSettings, client, d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 
-		if zoneSettings, err = updateUniversalSSLSetting(zoneSettings, client, d.Id()); err != nil {
-			return err
+		if zoneSettings, err = updateUniversalSSLSetting(ctx, zoneSettings, client, d.Id()); err != nil {
+			return diag.FromErr(err)
 		}
 
 		if len(zoneSettings) > 0 {
-			_, err = client.UpdateZoneSettings(context.Background(), d.Id(), zoneSettings)
+			_, err = client.UpdateZoneSettings(ctx, d.Id(), zoneSettings)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			log.Printf("[DEBUG] Skipped call to revert settings because no settings were changed")
@@ -921,12 +1012,20 @@ func resourceCloudflareZoneSettingsOverrideDelete(d *schema.ResourceData, meta i
 func expandRevertibleZoneSettings(d *schema.ResourceData, readOnlySettings []string) ([]cloudflare.ZoneSetting, error) {
 	zoneSettings := make([]cloudflare.ZoneSetting, 0)
 
-	keyFormat := fmt.Sprintf("%s.0.%%s", "initial_settings")
+	// Each setting is reverted to its baseline if it has one and to its initial
+	// value otherwise.
+	baseline := zoneSettingsBaseline(d)
+	revertedValue := func(k string) interface{} {
+		if baselineVal, ok := baseline[k]; ok {
+			return baselineVal
+		}
+		return d.Get(fmt.Sprintf("initial_settings.0.%s", k))
+	}
+	polish := revertedValue("polish").(string)
 
 	for k, _ := range resourceCloudflareZoneSettingsSchema {
 
-		initialKey := fmt.Sprintf("initial_settings.0.%s", k)
-		initialVal := d.Get(initialKey)
+		initialVal := revertedValue(k)
 		currentKey := fmt.Sprintf("settings.0.%s", k)
 
 		if k == "zero_rtt" {
@@ -936,7 +1035,7 @@ func expandRevertibleZoneSettings(d *schema.ResourceData, readOnlySettings []str
 		// if the value was never set we don't need to revert it
 		if currentVal, ok := d.GetOk(currentKey); ok && !schemaValueEquals(initialVal, currentVal) {
 
-			zoneSettingValue, err := expandZoneSetting(d, keyFormat, k, initialVal, readOnlySettings)
+			zoneSettingValue, err := expandZoneSetting(k, initialVal, polish, readOnlySettings)
 			if err != nil {
 				return zoneSettings, err
 			}
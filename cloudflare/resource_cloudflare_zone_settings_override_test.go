@@ -2,13 +2,14 @@ package cloudflare
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"testing"
 
 	"reflect"
 
-	cloudflare "github.com/cloudflare/cloudflare-go"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -81,6 +82,151 @@ func TestAccCloudflareZoneSettingsOverride_RemoveAttributes(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareZoneSettingsOverride_Baseline(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_settings_override." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareZoneSettingsOverrideConfigBaseline(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "settings.0.brotli", "on"),
+					resource.TestCheckResourceAttr(name, "baseline_settings.0.brotli", "off"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings", "initial_settings", "initial_settings_read_at", "baseline_settings"},
+			},
+		},
+		CheckDestroy: testAccCheckZoneSettingValue(zoneID, "brotli", "off"),
+	})
+}
+
+func TestAccCloudflareZoneSettingsOverride_NoRevertOnDestroy(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_settings_override." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareZoneSettingsOverrideConfigBaseline(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "settings.0.brotli", "on"),
+					resource.TestCheckResourceAttr(name, "revert_on_destroy", "false"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckZoneSettingValue(zoneID, "brotli", "on"),
+	})
+}
+
+func testAccCheckZoneSettingValue(zoneID, setting string, value interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*providerClient)
+
+		zoneSetting, err := client.ZoneSingleSetting(context.Background(), zoneID, setting)
+		if err != nil {
+			return err
+		}
+
+		if !reflect.DeepEqual(zoneSetting.Value, value) {
+			return fmt.Errorf("expected %q to be %#v after destroy but got %#v", setting, value, zoneSetting.Value)
+		}
+
+		return nil
+	}
+}
+
+func TestExpandRevertibleZoneSettingsBaseline(t *testing.T) {
+	testCases := map[string]struct {
+		state    string
+		expected map[string]interface{}
+	}{
+		"zero values": {
+			state: `{
+				"settings": [{"brotli": "on", "browser_cache_ttl": 14400, "security_level": "high"}],
+				"initial_settings": [{"brotli": "off", "browser_cache_ttl": 7200, "security_level": "medium"}],
+				"baseline_settings": [{"browser_cache_ttl": 0, "security_level": "low"}]
+			}`,
+			expected: map[string]interface{}{
+				"brotli":            "off",
+				"browser_cache_ttl": 0,
+				"security_level":    "low",
+			},
+		},
+		"webp without polish in the baseline": {
+			state: `{
+				"settings": [{"polish": "lossless", "webp": "off", "security_level": "high"}],
+				"initial_settings": [{"polish": "lossless", "webp": "on", "security_level": "medium"}],
+				"baseline_settings": [{"security_level": "low"}]
+			}`,
+			expected: map[string]interface{}{
+				"webp":           "on",
+				"security_level": "low",
+			},
+		},
+		"webp with polish turned off by the baseline": {
+			state: `{
+				"settings": [{"polish": "lossless", "webp": "off"}],
+				"initial_settings": [{"polish": "lossless", "webp": "off"}],
+				"baseline_settings": [{"polish": "off", "webp": "on"}]
+			}`,
+			expected: map[string]interface{}{
+				"polish": "off",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := resourceCloudflareZoneSettingsOverride()
+
+			var attributes map[string]interface{}
+			if err := json.Unmarshal([]byte(tc.state), &attributes); err != nil {
+				t.Fatal(err)
+			}
+			attributes["id"] = "0da42c8d2132a9ddaf714f9e7c920711"
+			attributes["zone_id"] = "0da42c8d2132a9ddaf714f9e7c920711"
+			raw, _ := json.Marshal(attributes)
+
+			state, err := ctyjson.Unmarshal(raw, r.CoreConfigSchema().ImpliedType())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s, err := r.ShimInstanceStateFromValue(state)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s.RawState = state
+
+			zoneSettings, err := expandRevertibleZoneSettings(r.Data(s), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			reverted := map[string]interface{}{}
+			for _, setting := range zoneSettings {
+				reverted[setting.ID] = setting.Value
+			}
+
+			if !reflect.DeepEqual(reverted, tc.expected) {
+				t.Errorf("unexpected reverted settings:\n got: %#v\nwant: %#v", reverted, tc.expected)
+			}
+		})
+	}
+}
+
 func testAccCheckCloudflareZoneSettings(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -92,7 +238,7 @@ func testAccCheckCloudflareZoneSettings(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Zone ID is set")
 		}
 
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 		foundZone, err := client.ZoneSettings(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
@@ -122,7 +268,7 @@ func testAccCheckCloudflareZoneSettings(n string) resource.TestCheckFunc {
 
 func testAccGetInitialZoneSettings(t *testing.T, zoneID string, settings map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 
 		foundZone, err := client.ZoneSettings(context.Background(), zoneID)
 		if err != nil {
@@ -133,11 +279,11 @@ func testAccGetInitialZoneSettings(t *testing.T, zoneID string, settings map[str
 			t.Fatalf("Zone settings not found")
 		}
 
-		if err = updateZoneSettingsResponseWithSingleZoneSettings(foundZone, zoneID, client); err != nil {
+		if err = updateZoneSettingsResponseWithSingleZoneSettings(context.Background(), foundZone, zoneID, client); err != nil {
 			return err
 		}
 
-		if err = updateZoneSettingsResponseWithUniversalSSLSettings(foundZone, zoneID, client); err != nil {
+		if err = updateZoneSettingsResponseWithUniversalSSLSettings(context.Background(), foundZone, zoneID, client); err != nil {
 			return err
 		}
 
@@ -150,7 +296,7 @@ func testAccGetInitialZoneSettings(t *testing.T, zoneID string, settings map[str
 
 func testAccCheckInitialZoneSettings(zoneID string, initialSettings map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*cloudflare.API)
+		client := testAccProvider.Meta().(*providerClient)
 
 		foundZone, err := client.ZoneSettings(context.Background(), zoneID)
 		if err != nil {
@@ -161,11 +307,11 @@ func testAccCheckInitialZoneSettings(zoneID string, initialSettings map[string]i
 			return fmt.Errorf("Zone settings not found")
 		}
 
-		if err = updateZoneSettingsResponseWithSingleZoneSettings(foundZone, zoneID, client); err != nil {
+		if err = updateZoneSettingsResponseWithSingleZoneSettings(context.Background(), foundZone, zoneID, client); err != nil {
 			return err
 		}
 
-		if err = updateZoneSettingsResponseWithUniversalSSLSettings(foundZone, zoneID, client); err != nil {
+		if err = updateZoneSettingsResponseWithUniversalSSLSettings(context.Background(), foundZone, zoneID, client); err != nil {
 			return err
 		}
 
@@ -214,3 +360,17 @@ resource "cloudflare_zone_settings_override" "%[1]s" {
 	}
 }`, rnd, zoneID)
 }
+
+func testAccCheckCloudflareZoneSettingsOverrideConfigBaseline(rnd, zoneID string, revertOnDestroy bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_settings_override" "%[1]s" {
+	zone_id = "%[2]s"
+	revert_on_destroy = %[3]t
+	settings {
+		brotli = "on"
+	}
+	baseline_settings {
+		brotli = "off"
+	}
+}`, rnd, zoneID, revertOnDestroy)
+}
@@ -0,0 +1,190 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+func resourceCloudflareZoneTransferIncoming() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareZoneTransferIncomingCreate,
+		ReadContext:   resourceCloudflareZoneTransferIncomingRead,
+		UpdateContext: resourceCloudflareZoneTransferIncomingUpdate,
+		DeleteContext: resourceCloudflareZoneTransferIncomingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneTransferIncomingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"peers": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"auto_refresh_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"force_axfr_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"soa_serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"checked_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareZoneTransferIncomingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	zoneID := d.Get("zone_id").(string)
+
+	incoming, err := buildZoneTransferIncoming(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating incoming zone transfer for zone %s", zoneID)
+
+	_, err = client.CreateSecondaryDNSZone(ctx, zoneID, incoming)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating incoming zone transfer for zone %q", zoneID)))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareZoneTransferIncomingRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneTransferIncomingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	incoming, err := client.GetSecondaryDNSZone(ctx, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] Incoming zone transfer for zone %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading incoming zone transfer for zone %q", d.Id())))
+	}
+
+	d.Set("zone_id", d.Id())
+	d.Set("name", incoming.Name)
+	d.Set("peers", incoming.Primaries)
+	d.Set("auto_refresh_seconds", incoming.AutoRefreshSeconds)
+	d.Set("soa_serial", incoming.SoaSerial)
+
+	if !incoming.CheckedTime.IsZero() {
+		d.Set("checked_time", incoming.CheckedTime.Format(time.RFC3339Nano))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferIncomingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	zoneID := d.Id()
+
+	if d.HasChanges("name", "peers", "auto_refresh_seconds") {
+		incoming, err := buildZoneTransferIncoming(ctx, client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = client.UpdateSecondaryDNSZone(ctx, zoneID, incoming)
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating incoming zone transfer for zone %q", zoneID)))
+		}
+	}
+
+	// The trigger has no meaning of its own, any change to it requests a
+	// transfer of the whole zone from the peers right away.
+	if d.HasChange("force_axfr_trigger") {
+		log.Printf("[INFO] Forcing AXFR of zone %s", zoneID)
+
+		if err := client.ForceSecondaryDNSZoneAXFR(ctx, zoneID); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error forcing AXFR of zone %q", zoneID)))
+		}
+	}
+
+	return resourceCloudflareZoneTransferIncomingRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneTransferIncomingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	err := client.DeleteSecondaryDNSZone(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error deleting incoming zone transfer for zone %q", d.Id())))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferIncomingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	if err := diagnosticsError(resourceCloudflareZoneTransferIncomingRead(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildZoneTransferIncoming(ctx context.Context, client *providerClient, d *schema.ResourceData) (cloudflare.SecondaryDNSZone, error) {
+	name, err := zoneTransferName(ctx, client, d)
+	if err != nil {
+		return cloudflare.SecondaryDNSZone{}, err
+	}
+
+	return cloudflare.SecondaryDNSZone{
+		Name:               name,
+		Primaries:          expandInterfaceToStringList(d.Get("peers").(*schema.Set).List()),
+		AutoRefreshSeconds: d.Get("auto_refresh_seconds").(int),
+	}, nil
+}
+
+// zoneTransferName returns the name of the zone transfer configuration which
+// defaults to the name of the zone.
+func zoneTransferName(ctx context.Context, client *providerClient, d *schema.ResourceData) (string, error) {
+	if name, ok := d.GetOk("name"); ok {
+		return name.(string), nil
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	zone, err := cachedZoneDetails(ctx, client, zoneID)
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("error finding zone %q", zoneID))
+	}
+
+	return zone.Name, nil
+}
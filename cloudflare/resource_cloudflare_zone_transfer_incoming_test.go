@@ -0,0 +1,80 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareZoneTransferIncoming(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_transfer_incoming.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_SECONDARY_ZONE_ID")
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckSecondaryZone(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareZoneTransferIncomingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneTransferIncomingConfig(rnd, accountID, zoneID, 86400, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttrSet(name, "name"),
+					resource.TestCheckResourceAttr(name, "peers.#", "1"),
+					resource.TestCheckResourceAttr(name, "auto_refresh_seconds", "86400"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneTransferIncomingConfig(rnd, accountID, zoneID, 3600, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "auto_refresh_seconds", "3600"),
+					resource.TestCheckResourceAttr(name, "force_axfr_trigger", "1"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_axfr_trigger", "soa_serial", "checked_time"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneTransferIncomingConfig(rnd, accountID, zoneID string, autoRefreshSeconds int, forceAXFRTrigger string) string {
+	return testAccCloudflareZoneTransferPeerConfig(rnd, accountID, 53) + fmt.Sprintf(`
+
+resource "cloudflare_zone_transfer_incoming" "%[1]s" {
+  zone_id              = "%[2]s"
+  peers                = [cloudflare_zone_transfer_peer.%[1]s.id]
+  auto_refresh_seconds = %[3]d
+  force_axfr_trigger   = "%[4]s"
+}`, rnd, zoneID, autoRefreshSeconds, forceAXFRTrigger)
+}
+
+func testAccCheckCloudflareZoneTransferIncomingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_zone_transfer_incoming" {
+			continue
+		}
+
+		_, err := client.GetSecondaryDNSZone(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("incoming zone transfer for zone %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+func resourceCloudflareZoneTransferOutgoing() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareZoneTransferOutgoingCreate,
+		ReadContext:   resourceCloudflareZoneTransferOutgoingRead,
+		UpdateContext: resourceCloudflareZoneTransferOutgoingUpdate,
+		DeleteContext: resourceCloudflareZoneTransferOutgoingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneTransferOutgoingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"peers": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"force_notify_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"soa_serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"checked_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_transferred_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareZoneTransferOutgoingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	zoneID := d.Get("zone_id").(string)
+
+	outgoing, err := buildZoneTransferOutgoing(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating outgoing zone transfer for zone %s", zoneID)
+
+	if err := createZoneTransferOutgoing(ctx, client, zoneID, outgoing); err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating outgoing zone transfer for zone %q", zoneID)))
+	}
+
+	d.SetId(zoneID)
+
+	if err := syncZoneTransferOutgoingEnabled(ctx, client, zoneID, d.Get("enabled").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareZoneTransferOutgoingRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneTransferOutgoingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	outgoing, err := fetchZoneTransferOutgoing(ctx, client, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] Outgoing zone transfer for zone %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading outgoing zone transfer for zone %q", d.Id())))
+	}
+
+	enabled, err := fetchZoneTransferOutgoingEnabled(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading outgoing zone transfer status for zone %q", d.Id())))
+	}
+
+	d.Set("zone_id", d.Id())
+	d.Set("name", outgoing.Name)
+	d.Set("peers", outgoing.Peers)
+	d.Set("enabled", enabled)
+	d.Set("soa_serial", outgoing.SoaSerial)
+
+	if outgoing.CheckedTime != nil {
+		d.Set("checked_time", outgoing.CheckedTime.Format(time.RFC3339Nano))
+	}
+	if outgoing.LastTransferredTime != nil {
+		d.Set("last_transferred_time", outgoing.LastTransferredTime.Format(time.RFC3339Nano))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferOutgoingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	zoneID := d.Id()
+
+	if d.HasChanges("name", "peers") {
+		outgoing, err := buildZoneTransferOutgoing(ctx, client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := updateZoneTransferOutgoing(ctx, client, zoneID, outgoing); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating outgoing zone transfer for zone %q", zoneID)))
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := syncZoneTransferOutgoingEnabled(ctx, client, zoneID, d.Get("enabled").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// The trigger has no meaning of its own, any change to it makes the peers
+	// transfer the zone right away.
+	if d.HasChange("force_notify_trigger") && d.Get("enabled").(bool) {
+		log.Printf("[INFO] Notifying the peers of zone %s", zoneID)
+
+		if err := forceZoneTransferOutgoingNotify(ctx, client, zoneID); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error notifying the peers of zone %q", zoneID)))
+		}
+	}
+
+	return resourceCloudflareZoneTransferOutgoingRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneTransferOutgoingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+
+	err := deleteZoneTransferOutgoing(ctx, client, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] Outgoing zone transfer for zone %s no longer exists", d.Id())
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error deleting outgoing zone transfer for zone %q", d.Id())))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferOutgoingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	if err := diagnosticsError(resourceCloudflareZoneTransferOutgoingRead(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildZoneTransferOutgoing(ctx context.Context, client *providerClient, d *schema.ResourceData) (zoneTransferOutgoing, error) {
+	name, err := zoneTransferName(ctx, client, d)
+	if err != nil {
+		return zoneTransferOutgoing{}, err
+	}
+
+	return zoneTransferOutgoing{
+		Name:  name,
+		Peers: expandInterfaceToStringList(d.Get("peers").(*schema.Set).List()),
+	}, nil
+}
+
+// syncZoneTransferOutgoingEnabled enables or disables outgoing zone transfers
+// when the current status differs as the API rejects a no-op change.
+func syncZoneTransferOutgoingEnabled(ctx context.Context, client *providerClient, zoneID string, enabled bool) error {
+	current, err := fetchZoneTransferOutgoingEnabled(ctx, client, zoneID)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("error reading outgoing zone transfer status for zone %q", zoneID))
+	}
+
+	if current == enabled {
+		return nil
+	}
+
+	log.Printf("[INFO] Setting outgoing zone transfers of zone %s enabled to %t", zoneID, enabled)
+
+	if err := setZoneTransferOutgoingEnabled(ctx, client, zoneID, enabled); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("error setting outgoing zone transfers of zone %q enabled to %t", zoneID, enabled))
+	}
+
+	return nil
+}
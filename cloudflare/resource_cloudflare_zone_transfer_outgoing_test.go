@@ -0,0 +1,57 @@
+package cloudflare
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareZoneTransferOutgoing(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_transfer_outgoing.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneTransferOutgoingConfig(rnd, accountID, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", zoneName),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "peers.#", "1"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneTransferOutgoingConfig(rnd, accountID, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneTransferOutgoingConfig(rnd, accountID, zoneID string, enabled bool) string {
+	return testAccCloudflareZoneTransferPeerConfig(rnd, accountID, 53) + fmt.Sprintf(`
+
+resource "cloudflare_zone_transfer_outgoing" "%[1]s" {
+  zone_id = "%[2]s"
+  peers   = [cloudflare_zone_transfer_peer.%[1]s.id]
+  enabled = %[3]t
+}`, rnd, zoneID, enabled)
+}
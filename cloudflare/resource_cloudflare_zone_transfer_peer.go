@@ -0,0 +1,151 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+func resourceCloudflareZoneTransferPeer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareZoneTransferPeerCreate,
+		ReadContext:   resourceCloudflareZoneTransferPeerRead,
+		UpdateContext: resourceCloudflareZoneTransferPeerUpdate,
+		DeleteContext: resourceCloudflareZoneTransferPeerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneTransferPeerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      53,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"ixfr_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tsig_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareZoneTransferPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	peer, err := client.CreateSecondaryDNSPrimary(ctx, accountID, buildZoneTransferPeer(d))
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating zone transfer peer %q", d.Get("name").(string))))
+	}
+
+	d.SetId(peer.ID)
+
+	return resourceCloudflareZoneTransferPeerRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneTransferPeerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	peer, err := client.GetSecondaryDNSPrimary(ctx, accountID, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] Zone transfer peer %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading zone transfer peer %q", d.Id())))
+	}
+
+	d.Set("name", peer.Name)
+	d.Set("ip", peer.IP)
+	d.Set("port", peer.Port)
+	d.Set("ixfr_enable", peer.IxfrEnable)
+	d.Set("tsig_id", peer.TsigID)
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferPeerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	peer := buildZoneTransferPeer(d)
+	peer.ID = d.Id()
+
+	_, err := client.UpdateSecondaryDNSPrimary(ctx, accountID, peer)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating zone transfer peer %q", d.Id())))
+	}
+
+	return resourceCloudflareZoneTransferPeerRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneTransferPeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	err := client.DeleteSecondaryDNSPrimary(ctx, accountID, d.Id())
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error deleting zone transfer peer %q", d.Id())))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferPeerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/peerID\"", d.Id())
+	}
+
+	accountID, peerID := attributes[0], attributes[1]
+	d.SetId(peerID)
+	d.Set("account_id", accountID)
+
+	if err := diagnosticsError(resourceCloudflareZoneTransferPeerRead(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// buildZoneTransferPeer returns the peer of the configuration. Peers used to
+// be called primaries in the API which is the name cloudflare-go still uses.
+func buildZoneTransferPeer(d *schema.ResourceData) cloudflare.SecondaryDNSPrimary {
+	return cloudflare.SecondaryDNSPrimary{
+		Name:       d.Get("name").(string),
+		IP:         d.Get("ip").(string),
+		Port:       d.Get("port").(int),
+		IxfrEnable: d.Get("ixfr_enable").(bool),
+		TsigID:     d.Get("tsig_id").(string),
+	}
+}
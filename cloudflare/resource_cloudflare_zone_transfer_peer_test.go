@@ -0,0 +1,90 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareZoneTransferPeer(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_transfer_peer.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareZoneTransferPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneTransferPeerConfig(rnd, accountID, 53),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneTransferPeerExists(name),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "ip", "192.0.2.53"),
+					resource.TestCheckResourceAttr(name, "port", "53"),
+					resource.TestCheckResourceAttr(name, "ixfr_enable", "true"),
+					resource.TestCheckResourceAttrPair(name, "tsig_id", "cloudflare_zone_transfer_tsig."+rnd, "id"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneTransferPeerConfig(rnd, accountID, 5353),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneTransferPeerExists(name),
+					resource.TestCheckResourceAttr(name, "port", "5353"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneTransferPeerConfig(rnd, accountID string, port int) string {
+	return testAccCloudflareZoneTransferTSIGConfig(rnd, accountID, "hmac-sha256.") + fmt.Sprintf(`
+
+resource "cloudflare_zone_transfer_peer" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  ip          = "192.0.2.53"
+  port        = %[3]d
+  ixfr_enable = true
+  tsig_id     = cloudflare_zone_transfer_tsig.%[1]s.id
+}`, rnd, accountID, port)
+}
+
+func testAccCheckCloudflareZoneTransferPeerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No peer ID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+		_, err := client.GetSecondaryDNSPrimary(context.Background(), rs.Primary.Attributes["account_id"], rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckCloudflareZoneTransferPeerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_zone_transfer_peer" {
+			continue
+		}
+
+		_, err := client.GetSecondaryDNSPrimary(context.Background(), rs.Primary.Attributes["account_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("peer %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
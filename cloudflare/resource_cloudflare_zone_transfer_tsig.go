@@ -0,0 +1,134 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+func resourceCloudflareZoneTransferTSIG() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareZoneTransferTSIGCreate,
+		ReadContext:   resourceCloudflareZoneTransferTSIGRead,
+		UpdateContext: resourceCloudflareZoneTransferTSIGUpdate,
+		DeleteContext: resourceCloudflareZoneTransferTSIGDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneTransferTSIGImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"algo": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareZoneTransferTSIGCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	tsig, err := client.CreateSecondaryDNSTSIG(ctx, accountID, buildZoneTransferTSIG(d))
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating zone transfer TSIG %q", d.Get("name").(string))))
+	}
+
+	d.SetId(tsig.ID)
+
+	return resourceCloudflareZoneTransferTSIGRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneTransferTSIGRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	tsig, err := client.GetSecondaryDNSTSIG(ctx, accountID, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] Zone transfer TSIG %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading zone transfer TSIG %q", d.Id())))
+	}
+
+	d.Set("name", tsig.Name)
+	d.Set("algo", tsig.Algo)
+	d.Set("secret", tsig.Secret)
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferTSIGUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	tsig := buildZoneTransferTSIG(d)
+	tsig.ID = d.Id()
+
+	_, err := client.UpdateSecondaryDNSTSIG(ctx, accountID, tsig)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating zone transfer TSIG %q", d.Id())))
+	}
+
+	return resourceCloudflareZoneTransferTSIGRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneTransferTSIGDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerClient)
+	accountID := d.Get("account_id").(string)
+
+	err := client.DeleteSecondaryDNSTSIG(ctx, accountID, d.Id())
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error deleting zone transfer TSIG %q", d.Id())))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneTransferTSIGImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/tsigID\"", d.Id())
+	}
+
+	accountID, tsigID := attributes[0], attributes[1]
+	d.SetId(tsigID)
+	d.Set("account_id", accountID)
+
+	if err := diagnosticsError(resourceCloudflareZoneTransferTSIGRead(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildZoneTransferTSIG(d *schema.ResourceData) cloudflare.SecondaryDNSTSIG {
+	return cloudflare.SecondaryDNSTSIG{
+		Name:   d.Get("name").(string),
+		Algo:   d.Get("algo").(string),
+		Secret: d.Get("secret").(string),
+	}
+}
@@ -0,0 +1,90 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareZoneTransferTSIG(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_transfer_tsig.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareZoneTransferTSIGDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneTransferTSIGConfig(rnd, accountID, "hmac-sha256."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneTransferTSIGExists(name),
+					resource.TestCheckResourceAttr(name, "name", rnd+".tsig.example.com."),
+					resource.TestCheckResourceAttr(name, "algo", "hmac-sha256."),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneTransferTSIGConfig(rnd, accountID, "hmac-sha512."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareZoneTransferTSIGExists(name),
+					resource.TestCheckResourceAttr(name, "algo", "hmac-sha512."),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneTransferTSIGConfig(rnd, accountID, algo string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_transfer_tsig" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s.tsig.example.com."
+  algo       = "%[3]s"
+  secret     = "caf79a7804b04337c9c66ccd7bef9190a1e1679b5dd03d8aa10f7ad45e1a9dab92b417896c15d4d007c7c14194538d2a5d0feffdecc5a7f0e1c570cfa700837c"
+}`, rnd, accountID, algo)
+}
+
+func testAccCheckCloudflareZoneTransferTSIGExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No TSIG ID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+		_, err := client.GetSecondaryDNSTSIG(context.Background(), rs.Primary.Attributes["account_id"], rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckCloudflareZoneTransferTSIGDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_zone_transfer_tsig" {
+			continue
+		}
+
+		_, err := client.GetSecondaryDNSTSIG(context.Background(), rs.Primary.Attributes["account_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("TSIG %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
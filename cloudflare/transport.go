@@ -0,0 +1,99 @@
+package cloudflare
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// configureTransport applies the proxy, CA bundle, client certificate and TLS
+// verification settings of the provider to the transport.
+func configureTransport(transport *http.Transport, d *schema.ResourceData) error {
+	if v, ok := d.GetOk("http_proxy"); ok {
+		proxyURL, err := url.Parse(v.(string))
+		if err != nil {
+			return fmt.Errorf("error parsing http_proxy: %s", err)
+		}
+		if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" {
+			return fmt.Errorf("http_proxy must be an http:// or https:// URL")
+		}
+
+		log.Printf("[INFO] Using HTTP proxy %s for the Cloudflare API", proxyURL.Redacted())
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+
+	caBundle, err := pemFromFileOrString(d, "ca_bundle_file", "ca_bundle_pem")
+	if err != nil {
+		return err
+	}
+	if caBundle != nil {
+		// Private CAs are added to the system roots so the public API can
+		// still be reached through the same client.
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return fmt.Errorf("no certificates found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	certificate, err := pemFromFileOrString(d, "client_certificate_file", "client_certificate_pem")
+	if err != nil {
+		return err
+	}
+	key, err := pemFromFileOrString(d, "client_key_file", "client_key_pem")
+	if err != nil {
+		return err
+	}
+	if certificate != nil || key != nil {
+		if certificate == nil || key == nil {
+			return fmt.Errorf("both a client certificate and key are required for mutual TLS")
+		}
+
+		keyPair, err := tls.X509KeyPair(certificate, key)
+		if err != nil {
+			return fmt.Errorf("error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	if d.Get("insecure_skip_verify").(bool) {
+		log.Printf("[WARN] TLS certificate verification of the Cloudflare API is disabled")
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return nil
+}
+
+// pemFromFileOrString returns the PEM content of the file or string
+// attribute, whichever is set.
+func pemFromFileOrString(d *schema.ResourceData, fileKey, pemKey string) ([]byte, error) {
+	if v, ok := d.GetOk(fileKey); ok {
+		content, err := ioutil.ReadFile(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %s", fileKey, err)
+		}
+		return content, nil
+	}
+
+	if v, ok := d.GetOk(pemKey); ok {
+		return []byte(v.(string)), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,169 @@
+package cloudflare
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testTransport(t *testing.T, raw map[string]interface{}) (*http.Transport, error) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+	transport := cleanhttp.DefaultTransport()
+	return transport, configureTransport(transport, d)
+}
+
+func TestConfigureTransportCABundleAndClientCertificate(t *testing.T) {
+	certificate, key := testClientCertificate(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "terraform" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	transport, err := testTransport(t, map[string]interface{}{
+		"ca_bundle_pem":          string(caBundle),
+		"client_certificate_pem": certificate,
+		"client_key_pem":         key,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected the client certificate to be accepted but got %d", resp.StatusCode)
+	}
+}
+
+func TestConfigureTransportHTTPProxy(t *testing.T) {
+	var proxyAuthorization, requestURI string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxyAuthorization = r.Header.Get("Proxy-Authorization")
+		requestURI = r.RequestURI
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	transport, err := testTransport(t, map[string]interface{}{
+		"http_proxy": strings.Replace(proxy.URL, "http://", "http://user:secret@", 1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Get("http://api.internal.example/client/v4/zones")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if expected := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:secret")); proxyAuthorization != expected {
+		t.Errorf("expected the proxy credentials %q but got %q", expected, proxyAuthorization)
+	}
+	if requestURI != "http://api.internal.example/client/v4/zones" {
+		t.Errorf("expected the request to go through the proxy but got %q", requestURI)
+	}
+}
+
+func TestConfigureTransportErrors(t *testing.T) {
+	certificate, _ := testClientCertificate(t)
+
+	testCases := map[string]struct {
+		raw      map[string]interface{}
+		expected string
+	}{
+		"proxy scheme": {
+			raw:      map[string]interface{}{"http_proxy": "socks5://proxy.example:1080"},
+			expected: "http_proxy must be an http:// or https:// URL",
+		},
+		"empty CA bundle": {
+			raw:      map[string]interface{}{"ca_bundle_pem": "not a certificate"},
+			expected: "no certificates found in the CA bundle",
+		},
+		"missing CA bundle file": {
+			raw:      map[string]interface{}{"ca_bundle_file": "/does/not/exist.pem"},
+			expected: "error reading ca_bundle_file",
+		},
+		"certificate without key": {
+			raw:      map[string]interface{}{"client_certificate_pem": certificate},
+			expected: "both a client certificate and key are required for mutual TLS",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := testTransport(t, tc.raw)
+			if err == nil || !strings.Contains(err.Error(), tc.expected) {
+				t.Errorf("expected error %q but got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestConfigureTransportInsecureSkipVerify(t *testing.T) {
+	transport, err := testTransport(t, map[string]interface{}{"insecure_skip_verify": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Error("expected TLS verification to be skipped")
+	}
+}
+
+// testClientCertificate returns a self-signed PEM encoded client certificate
+// and key.
+func testClientCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
@@ -0,0 +1,146 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// cloudflare-go doesn't support remotely managed tunnel configurations or
+// teamnet routes yet so the endpoints are called here using the raw client.
+
+// tunnelConfiguration is the cloudflared configuration of a remotely managed
+// tunnel. The field names follow the cloudflared config file.
+type tunnelConfiguration struct {
+	Ingress       []tunnelIngressRule  `json:"ingress,omitempty"`
+	WarpRouting   *tunnelWarpRouting   `json:"warp-routing,omitempty"`
+	OriginRequest *tunnelOriginRequest `json:"originRequest,omitempty"`
+}
+
+type tunnelIngressRule struct {
+	Hostname      string               `json:"hostname,omitempty"`
+	Path          string               `json:"path,omitempty"`
+	Service       string               `json:"service"`
+	OriginRequest *tunnelOriginRequest `json:"originRequest,omitempty"`
+}
+
+type tunnelWarpRouting struct {
+	Enabled bool `json:"enabled"`
+}
+
+// tunnelOriginRequest holds the settings cloudflared uses to connect to the
+// origin. Timeouts are in seconds.
+type tunnelOriginRequest struct {
+	ConnectTimeout         int    `json:"connectTimeout,omitempty"`
+	TLSTimeout             int    `json:"tlsTimeout,omitempty"`
+	TCPKeepAlive           int    `json:"tcpKeepAlive,omitempty"`
+	KeepAliveTimeout       int    `json:"keepAliveTimeout,omitempty"`
+	KeepAliveConnections   int    `json:"keepAliveConnections,omitempty"`
+	NoHappyEyeballs        bool   `json:"noHappyEyeballs,omitempty"`
+	HTTPHostHeader         string `json:"httpHostHeader,omitempty"`
+	OriginServerName       string `json:"originServerName,omitempty"`
+	CAPool                 string `json:"caPool,omitempty"`
+	NoTLSVerify            bool   `json:"noTLSVerify,omitempty"`
+	DisableChunkedEncoding bool   `json:"disableChunkedEncoding,omitempty"`
+	ProxyType              string `json:"proxyType,omitempty"`
+	HTTP2Origin            bool   `json:"http2Origin,omitempty"`
+}
+
+type tunnelConfigurationResult struct {
+	TunnelID string               `json:"tunnel_id"`
+	Version  int                  `json:"version"`
+	Config   *tunnelConfiguration `json:"config"`
+}
+
+// tunnelRoute routes a private network to a tunnel.
+type tunnelRoute struct {
+	ID               string `json:"id,omitempty"`
+	Network          string `json:"network,omitempty"`
+	TunnelID         string `json:"tunnel_id"`
+	TunnelName       string `json:"tunnel_name,omitempty"`
+	Comment          string `json:"comment"`
+	VirtualNetworkID string `json:"virtual_network_id,omitempty"`
+}
+
+func fetchTunnelConfiguration(ctx context.Context, client *providerClient, accountID, tunnelID string) (tunnelConfigurationResult, error) {
+	var result tunnelConfigurationResult
+
+	res, err := client.request(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/configurations", accountID, tunnelID), nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling tunnel configuration: %w", err)
+	}
+
+	return result, nil
+}
+
+func updateTunnelConfiguration(ctx context.Context, client *providerClient, accountID, tunnelID string, config tunnelConfiguration) error {
+	body := map[string]interface{}{"config": config}
+	_, err := client.request(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/configurations", accountID, tunnelID), body)
+	return err
+}
+
+// tunnelRouteURI returns the endpoint of a single route. The network is a CIDR
+// so it has to be escaped.
+func tunnelRouteURI(accountID, network string) string {
+	return fmt.Sprintf("/accounts/%s/teamnet/routes/network/%s", accountID, url.PathEscape(network))
+}
+
+// findTunnelRoute returns the route of the network in the virtual network, or
+// nil when there is no such route.
+func findTunnelRoute(ctx context.Context, client *providerClient, accountID, network, virtualNetworkID string) (*tunnelRoute, error) {
+	params := url.Values{}
+	params.Set("is_deleted", "false")
+	params.Set("network_subset", network)
+	params.Set("network_superset", network)
+	if virtualNetworkID != "" {
+		params.Set("virtual_network_id", virtualNetworkID)
+	}
+
+	res, err := client.request(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/teamnet/routes?%s", accountID, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var routes []tunnelRoute
+	if err := json.Unmarshal(res, &routes); err != nil {
+		return nil, fmt.Errorf("error unmarshalling tunnel routes: %w", err)
+	}
+
+	for _, route := range routes {
+		if route.Network == network && (virtualNetworkID == "" || route.VirtualNetworkID == virtualNetworkID) {
+			return &route, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func createTunnelRoute(ctx context.Context, client *providerClient, accountID string, route tunnelRoute) error {
+	network := route.Network
+	route.Network = ""
+	_, err := client.request(ctx, http.MethodPost, tunnelRouteURI(accountID, network), route)
+	return err
+}
+
+func updateTunnelRoute(ctx context.Context, client *providerClient, accountID string, route tunnelRoute) error {
+	network := route.Network
+	route.Network = ""
+	_, err := client.request(ctx, http.MethodPatch, tunnelRouteURI(accountID, network), route)
+	return err
+}
+
+func deleteTunnelRoute(ctx context.Context, client *providerClient, accountID, network, virtualNetworkID string) error {
+	uri := tunnelRouteURI(accountID, network)
+	if virtualNetworkID != "" {
+		uri += "?" + url.Values{"virtual_network_id": {virtualNetworkID}}.Encode()
+	}
+
+	_, err := client.request(ctx, http.MethodDelete, uri, nil)
+	return err
+}
@@ -11,7 +11,8 @@ import (
 	"sort"
 	"strings"
 
-	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -126,11 +127,11 @@ func stringFromBool(status bool) string {
 	return "off"
 }
 
-func getAccountIDFromZoneID(d *schema.ResourceData, client *cloudflare.API) (string, error) {
+func getAccountIDFromZoneID(ctx context.Context, d *schema.ResourceData, client *providerClient) (string, error) {
 	accountID := d.Get("account_id").(string)
 	if accountID == "" {
 		zoneID := d.Get("zone_id").(string)
-		zone, err := client.ZoneDetails(context.Background(), zoneID)
+		zone, err := cachedZoneDetails(ctx, client, zoneID)
 		if err != nil {
 			return "", fmt.Errorf("error retrieving zone for zone_id %q: %s", zoneID, err)
 		}
@@ -207,3 +208,39 @@ func hashCodeStrings(strings []string) string {
 
 	return fmt.Sprintf("%d", hashCodeString(buf.String()))
 }
+
+// diagnosticsError flattens the error diagnostics into a single error for the
+// places which can only return an error, such as importers.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var messages []string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+
+		if d.Detail != "" {
+			messages = append(messages, fmt.Sprintf("%s: %s", d.Summary, d.Detail))
+		} else {
+			messages = append(messages, d.Summary)
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
+
+// attributeErrorDiagnostic returns an error diagnostic pointing at the
+// attribute the error is about so it's shown against that part of the
+// configuration.
+func attributeErrorDiagnostic(path cty.Path, err error) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity:      diag.Error,
+			Summary:       err.Error(),
+			AttributePath: path,
+		},
+	}
+}
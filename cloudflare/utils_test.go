@@ -0,0 +1,46 @@
+package cloudflare
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestDiagnosticsError(t *testing.T) {
+	if err := diagnosticsError(nil); err != nil {
+		t.Errorf("expected no error for empty diagnostics, got %s", err)
+	}
+
+	warnings := diag.Diagnostics{{Severity: diag.Warning, Summary: "deprecated"}}
+	if err := diagnosticsError(warnings); err != nil {
+		t.Errorf("expected warnings to be ignored, got %s", err)
+	}
+
+	diags := diag.Diagnostics{
+		{Severity: diag.Error, Summary: "first"},
+		{Severity: diag.Warning, Summary: "deprecated"},
+		{Severity: diag.Error, Summary: "second", Detail: "more detail"},
+	}
+	if err := diagnosticsError(diags); err == nil || err.Error() != "first; second: more detail" {
+		t.Errorf("expected the errors to be flattened, got %v", err)
+	}
+}
+
+func TestAttributeErrorDiagnostic(t *testing.T) {
+	path := cty.GetAttrPath("rules").IndexInt(3).GetAttr("expression")
+	diags := attributeErrorDiagnostic(path, errors.New("invalid expression"))
+
+	if len(diags) != 1 || !diags.HasError() {
+		t.Fatalf("expected a single error diagnostic, got %+v", diags)
+	}
+
+	if diags[0].Summary != "invalid expression" {
+		t.Errorf("expected the error as the summary, got %q", diags[0].Summary)
+	}
+
+	if !diags[0].AttributePath.Equals(path) {
+		t.Errorf("expected the attribute path to be kept, got %#v", diags[0].AttributePath)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"net"
 	"net/url"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var allowedHTTPMethods = []string{"GET", "POST", "PUT", "DELETE", "PATCH", "HEAD", "_ALL_"}
@@ -74,3 +77,46 @@ func validateURL(v interface{}, k string) (s []string, errors []error) {
 	}
 	return
 }
+
+// validateDuration ensures the value is a duration like "10m" or "1h30m".
+func validateDuration(v interface{}, k string) (s []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid duration: %s", k, err))
+	}
+	return
+}
+
+// validateWirefilterExpression ensures that the value is a filter expression
+// using the fields and functions of the scheme which evaluates to a boolean.
+func validateWirefilterExpression(scheme *wirefilterScheme) schema.SchemaValidateFunc {
+	return validateWirefilterExpressionType(scheme, wirefilterBool)
+}
+
+// validateWirefilterExpressionType ensures that the value is an expression
+// using the fields and functions of the scheme which evaluates to `expected`,
+// such as the dynamic values of rewrite rules.
+func validateWirefilterExpressionType(scheme *wirefilterScheme, expected wirefilterType) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (s []string, errors []error) {
+		expression, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		if scheme.allowEmpty && strings.TrimSpace(expression) == "" {
+			return
+		}
+
+		typ, err := checkWirefilterExpression(scheme, expression)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q is not a valid %s expression: %s", k, scheme.name, err))
+			return
+		}
+
+		if !typ.equal(expected) {
+			errors = append(errors, fmt.Errorf("%q must evaluate to %s but evaluates to %s", k, expected, typ))
+		}
+
+		return
+	}
+}
@@ -0,0 +1,783 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// wirefilterKind is the kind of value a field, literal or function in a
+// filter expression (the Cloudflare Rules language, based on wirefilter)
+// evaluates to.
+type wirefilterKind int
+
+const (
+	wirefilterKindBytes wirefilterKind = iota
+	wirefilterKindInt
+	wirefilterKindBool
+	wirefilterKindIP
+	wirefilterKindArray
+	wirefilterKindMap
+)
+
+// wirefilterType is the type of a value within a filter expression. Arrays and
+// maps carry the type of their elements.
+type wirefilterType struct {
+	kind wirefilterKind
+	elem *wirefilterType
+}
+
+var (
+	wirefilterBytes = wirefilterType{kind: wirefilterKindBytes}
+	wirefilterInt   = wirefilterType{kind: wirefilterKindInt}
+	wirefilterBool  = wirefilterType{kind: wirefilterKindBool}
+	wirefilterIP    = wirefilterType{kind: wirefilterKindIP}
+)
+
+func wirefilterArray(elem wirefilterType) wirefilterType {
+	return wirefilterType{kind: wirefilterKindArray, elem: &elem}
+}
+
+func wirefilterMap(elem wirefilterType) wirefilterType {
+	return wirefilterType{kind: wirefilterKindMap, elem: &elem}
+}
+
+func (t wirefilterType) String() string {
+	switch t.kind {
+	case wirefilterKindBytes:
+		return "Bytes"
+	case wirefilterKindInt:
+		return "Int"
+	case wirefilterKindBool:
+		return "Bool"
+	case wirefilterKindIP:
+		return "IP"
+	case wirefilterKindArray:
+		return fmt.Sprintf("Array<%s>", t.elem)
+	case wirefilterKindMap:
+		return fmt.Sprintf("Map<%s>", t.elem)
+	}
+
+	return "Unknown"
+}
+
+func (t wirefilterType) equal(other wirefilterType) bool {
+	if t.kind != other.kind {
+		return false
+	}
+
+	if t.elem == nil || other.elem == nil {
+		return t.elem == other.elem
+	}
+
+	return t.elem.equal(*other.elem)
+}
+
+// wirefilterFunction describes the arguments accepted by a function and the
+// type it returns.
+type wirefilterFunction struct {
+	minArgs int
+	// maxArgs of -1 allows any number of arguments.
+	maxArgs int
+	check   func(args []wirefilterValue) (wirefilterType, error)
+	// reduce is set for functions (`any` and `all`) which collapse the
+	// elements produced by a `[*]` index into a single value.
+	reduce bool
+}
+
+// wirefilterScheme is the set of fields and functions available to the
+// expressions of a product.
+type wirefilterScheme struct {
+	name      string
+	fields    map[string]wirefilterType
+	functions map[string]wirefilterFunction
+	// allowEmpty permits an empty expression, such as an unset Gateway
+	// `identity` expression.
+	allowEmpty bool
+}
+
+// wirefilterValue is the result of type checking part of an expression.
+type wirefilterValue struct {
+	typ wirefilterType
+	// unpacked is set when the value comes from a `[*]` index and therefore
+	// applies to every element of an array.
+	unpacked bool
+	// literal is set for values written out in the expression rather than
+	// read from a field or function.
+	literal bool
+}
+
+type wirefilterTokenKind int
+
+const (
+	wirefilterTokenEOF wirefilterTokenKind = iota
+	wirefilterTokenIdent
+	wirefilterTokenString
+	wirefilterTokenInt
+	wirefilterTokenIP
+	wirefilterTokenList
+	wirefilterTokenPunct
+)
+
+type wirefilterToken struct {
+	kind wirefilterTokenKind
+	text string
+	pos  int
+}
+
+var wirefilterComparisonOperators = map[string]string{
+	"eq":       "eq",
+	"==":       "eq",
+	"ne":       "ne",
+	"!=":       "ne",
+	"lt":       "lt",
+	"<":        "lt",
+	"le":       "le",
+	"<=":       "le",
+	"gt":       "gt",
+	">":        "gt",
+	"ge":       "ge",
+	">=":       "ge",
+	"contains": "contains",
+	"matches":  "matches",
+	"~":        "matches",
+	"in":       "in",
+	"wildcard": "wildcard",
+	"strict":   "strict wildcard",
+}
+
+// checkWirefilterExpression parses the expression and type checks it against
+// the scheme, returning the type the expression evaluates to.
+func checkWirefilterExpression(scheme *wirefilterScheme, expression string) (wirefilterType, error) {
+	tokens, err := lexWirefilterExpression(expression)
+	if err != nil {
+		return wirefilterType{}, err
+	}
+
+	p := &wirefilterParser{scheme: scheme, tokens: tokens}
+	if p.peek().kind == wirefilterTokenEOF {
+		return wirefilterType{}, fmt.Errorf("expression is empty")
+	}
+
+	value, err := p.parseOr()
+	if err != nil {
+		return wirefilterType{}, err
+	}
+
+	if tok := p.peek(); tok.kind != wirefilterTokenEOF {
+		return wirefilterType{}, p.errorf(tok, "unexpected %q", tok.text)
+	}
+
+	if value.unpacked {
+		return wirefilterType{}, fmt.Errorf("values indexed with [*] must be wrapped in any() or all()")
+	}
+
+	return value.typ, nil
+}
+
+func lexWirefilterExpression(input string) ([]wirefilterToken, error) {
+	var tokens []wirefilterToken
+
+	for i := 0; i < len(input); {
+		c := input[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '"':
+			value, end, err := lexWirefilterString(input, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, wirefilterToken{kind: wirefilterTokenString, text: value, pos: i})
+			i = end
+
+		case c == 'r' && i+1 < len(input) && (input[i+1] == '"' || input[i+1] == '#'):
+			value, end, err := lexWirefilterRawString(input, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, wirefilterToken{kind: wirefilterTokenString, text: value, pos: i})
+			i = end
+
+		case c == '$':
+			// Managed list names are dotted, e.g. $cf.open_proxies.
+			end := i + 1
+			for end < len(input) && (isWirefilterIdentChar(input[end]) || input[end] == '-' || input[end] == '.') {
+				end++
+			}
+			if end == i+1 || input[i+1] == '.' || input[end-1] == '.' {
+				return nil, fmt.Errorf("expected a list name after \"$\" at position %d", i+1)
+			}
+			tokens = append(tokens, wirefilterToken{kind: wirefilterTokenList, text: input[i:end], pos: i})
+			i = end
+
+		case isWirefilterIPv6Start(input, i):
+			end := scanWirefilterAddress(input, i, true)
+			if err := validateWirefilterIP(input[i:end]); err != nil {
+				return nil, fmt.Errorf("%s at position %d", err, i+1)
+			}
+			tokens = append(tokens, wirefilterToken{kind: wirefilterTokenIP, text: input[i:end], pos: i})
+			i = end
+
+		case isWirefilterDigit(c) || (c == '-' && i+1 < len(input) && isWirefilterDigit(input[i+1])):
+			end := scanWirefilterAddress(input, i+1, false)
+			text := input[i:end]
+			if strings.Contains(text, ".") || strings.Contains(text, "/") {
+				if err := validateWirefilterIP(text); err != nil {
+					return nil, fmt.Errorf("%s at position %d", err, i+1)
+				}
+				tokens = append(tokens, wirefilterToken{kind: wirefilterTokenIP, text: text, pos: i})
+			} else {
+				if _, err := strconv.ParseInt(text, 10, 64); err != nil {
+					return nil, fmt.Errorf("invalid integer %q at position %d", text, i+1)
+				}
+				tokens = append(tokens, wirefilterToken{kind: wirefilterTokenInt, text: text, pos: i})
+			}
+			i = end
+
+		case isWirefilterIdentStart(c):
+			end := i + 1
+			for end < len(input) && (isWirefilterIdentChar(input[end]) || input[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, wirefilterToken{kind: wirefilterTokenIdent, text: input[i:end], pos: i})
+			i = end
+
+		default:
+			if i+1 < len(input) {
+				switch input[i : i+2] {
+				case "==", "!=", "<=", ">=", "&&", "||", "^^", "..":
+					tokens = append(tokens, wirefilterToken{kind: wirefilterTokenPunct, text: input[i : i+2], pos: i})
+					i += 2
+					continue
+				}
+			}
+
+			if strings.IndexByte("(){}[],*<>~!", c) == -1 {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i+1)
+			}
+			tokens = append(tokens, wirefilterToken{kind: wirefilterTokenPunct, text: string(c), pos: i})
+			i++
+		}
+	}
+
+	return append(tokens, wirefilterToken{kind: wirefilterTokenEOF, text: "end of expression", pos: len(input)}), nil
+}
+
+// lexWirefilterString decodes a quoted string starting at `start` and returns
+// its value along with the position just after the closing quote.
+func lexWirefilterString(input string, start int) (string, int, error) {
+	var b strings.Builder
+
+	for i := start + 1; i < len(input); i++ {
+		switch input[i] {
+		case '"':
+			return b.String(), i + 1, nil
+		case '\\':
+			if i+1 >= len(input) {
+				break
+			}
+			i++
+			switch input[i] {
+			case '"', '\\':
+				b.WriteByte(input[i])
+			case 'x':
+				if i+2 >= len(input) {
+					return "", 0, fmt.Errorf("invalid escape sequence in string at position %d", i)
+				}
+				v, err := strconv.ParseUint(input[i+1:i+3], 16, 8)
+				if err != nil {
+					return "", 0, fmt.Errorf("invalid escape sequence in string at position %d", i)
+				}
+				b.WriteByte(byte(v))
+				i += 2
+			default:
+				return "", 0, fmt.Errorf("invalid escape sequence \"\\%c\" in string at position %d", input[i], i)
+			}
+		default:
+			b.WriteByte(input[i])
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated string starting at position %d", start+1)
+}
+
+// lexWirefilterRawString reads a raw string (r"..." or r#"..."#) starting at
+// `start`.
+func lexWirefilterRawString(input string, start int) (string, int, error) {
+	i := start + 1
+	hashes := 0
+	for i < len(input) && input[i] == '#' {
+		hashes++
+		i++
+	}
+
+	if i >= len(input) || input[i] != '"' {
+		return "", 0, fmt.Errorf("invalid raw string at position %d", start+1)
+	}
+
+	terminator := "\"" + strings.Repeat("#", hashes)
+	end := strings.Index(input[i+1:], terminator)
+	if end == -1 {
+		return "", 0, fmt.Errorf("unterminated raw string starting at position %d", start+1)
+	}
+
+	return input[i+1 : i+1+end], i + 1 + end + len(terminator), nil
+}
+
+// scanWirefilterAddress returns the end of the integer, IPv4 or IPv6 address
+// (with an optional CIDR suffix) starting at `start`. Ranges (`..`) are left
+// for the parser.
+func scanWirefilterAddress(input string, start int, hex bool) int {
+	i := start
+	for i < len(input) {
+		c := input[i]
+		if c == '.' && i+1 < len(input) && input[i+1] == '.' {
+			break
+		}
+		if isWirefilterDigit(c) || c == '.' || (hex && (c == ':' || isWirefilterHexLetter(c))) {
+			i++
+			continue
+		}
+		break
+	}
+
+	if i < len(input) && input[i] == '/' {
+		i++
+		for i < len(input) && isWirefilterDigit(input[i]) {
+			i++
+		}
+	}
+
+	return i
+}
+
+// isWirefilterIPv6Start reports whether an IPv6 address starts at `start`.
+// Field names never contain a colon so any run of hexadecimal characters
+// containing one must be an address.
+func isWirefilterIPv6Start(input string, start int) bool {
+	for i := start; i < len(input); i++ {
+		c := input[i]
+		switch {
+		case c == ':':
+			return true
+		case isWirefilterDigit(c) || isWirefilterHexLetter(c) || c == '.':
+			continue
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+func validateWirefilterIP(text string) error {
+	if strings.Contains(text, "/") {
+		if _, _, err := net.ParseCIDR(text); err != nil {
+			return fmt.Errorf("invalid CIDR %q", text)
+		}
+		return nil
+	}
+
+	if net.ParseIP(text) == nil {
+		return fmt.Errorf("invalid IP address %q", text)
+	}
+
+	return nil
+}
+
+func isWirefilterDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isWirefilterHexLetter(c byte) bool {
+	return (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func isWirefilterIdentStart(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_'
+}
+
+func isWirefilterIdentChar(c byte) bool {
+	return isWirefilterIdentStart(c) || isWirefilterDigit(c)
+}
+
+// wirefilterParser is a recursive descent parser which type checks the
+// expression as it goes rather than building up a syntax tree.
+type wirefilterParser struct {
+	scheme *wirefilterScheme
+	tokens []wirefilterToken
+	pos    int
+}
+
+func (p *wirefilterParser) peek() wirefilterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *wirefilterParser) next() wirefilterToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != wirefilterTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *wirefilterParser) errorf(tok wirefilterToken, format string, args ...interface{}) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), tok.pos+1)
+}
+
+func (p *wirefilterParser) isPunct(text string) bool {
+	tok := p.peek()
+	return tok.kind == wirefilterTokenPunct && tok.text == text
+}
+
+func (p *wirefilterParser) isKeyword(keywords ...string) bool {
+	tok := p.peek()
+	if tok.kind != wirefilterTokenIdent && tok.kind != wirefilterTokenPunct {
+		return false
+	}
+
+	for _, keyword := range keywords {
+		if tok.text == keyword {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *wirefilterParser) expect(text string) error {
+	if tok := p.next(); tok.kind != wirefilterTokenPunct || tok.text != text {
+		return p.errorf(tok, "expected %q but found %q", text, tok.text)
+	}
+	return nil
+}
+
+func (p *wirefilterParser) parseOr() (wirefilterValue, error) {
+	return p.parseLogical(p.parseXor, "or", "||")
+}
+
+func (p *wirefilterParser) parseXor() (wirefilterValue, error) {
+	return p.parseLogical(p.parseAnd, "xor", "^^")
+}
+
+func (p *wirefilterParser) parseAnd() (wirefilterValue, error) {
+	return p.parseLogical(p.parseNot, "and", "&&")
+}
+
+func (p *wirefilterParser) parseLogical(operand func() (wirefilterValue, error), operators ...string) (wirefilterValue, error) {
+	start := p.peek()
+	left, err := operand()
+	if err != nil {
+		return left, err
+	}
+
+	for p.isKeyword(operators...) {
+		op := p.next()
+		if !left.typ.equal(wirefilterBool) {
+			return left, p.errorf(start, "%q needs a Bool on the left hand side but found %s", op.text, left.typ)
+		}
+
+		rightStart := p.peek()
+		right, err := operand()
+		if err != nil {
+			return right, err
+		}
+		if !right.typ.equal(wirefilterBool) {
+			return right, p.errorf(rightStart, "%q needs a Bool on the right hand side but found %s", op.text, right.typ)
+		}
+
+		left = wirefilterValue{typ: wirefilterBool, unpacked: left.unpacked || right.unpacked}
+	}
+
+	return left, nil
+}
+
+func (p *wirefilterParser) parseNot() (wirefilterValue, error) {
+	if p.isKeyword("not", "!") {
+		p.next()
+		start := p.peek()
+		value, err := p.parseNot()
+		if err != nil {
+			return value, err
+		}
+		if !value.typ.equal(wirefilterBool) {
+			return value, p.errorf(start, "\"not\" needs a Bool but found %s", value.typ)
+		}
+		return wirefilterValue{typ: wirefilterBool, unpacked: value.unpacked}, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *wirefilterParser) parseComparison() (wirefilterValue, error) {
+	start := p.peek()
+
+	if p.isPunct("(") {
+		p.next()
+		value, err := p.parseOr()
+		if err != nil {
+			return value, err
+		}
+		return value, p.expect(")")
+	}
+
+	left, err := p.parseOperand()
+	if err != nil {
+		return left, err
+	}
+
+	tok := p.peek()
+	if tok.kind != wirefilterTokenIdent && tok.kind != wirefilterTokenPunct {
+		return left, nil
+	}
+
+	op, ok := wirefilterComparisonOperators[tok.text]
+	if !ok {
+		return left, nil
+	}
+	p.next()
+
+	if op == "strict wildcard" {
+		if next := p.next(); next.text != "wildcard" {
+			return left, p.errorf(next, "expected \"wildcard\" but found %q", next.text)
+		}
+	}
+
+	if left.literal {
+		return left, p.errorf(start, "the left hand side of %q must be a field or function", tok.text)
+	}
+
+	if err := p.parseComparisonValue(tok, op, left.typ); err != nil {
+		return left, err
+	}
+
+	return wirefilterValue{typ: wirefilterBool, unpacked: left.unpacked}, nil
+}
+
+// parseComparisonValue parses the right hand side of a comparison and ensures
+// that the operator and the values are compatible with the left hand side.
+func (p *wirefilterParser) parseComparisonValue(opToken wirefilterToken, op string, left wirefilterType) error {
+	switch op {
+	case "eq", "ne":
+		switch left.kind {
+		case wirefilterKindBytes, wirefilterKindInt, wirefilterKindIP, wirefilterKindBool:
+			return p.parseLiteral(left, false)
+		}
+
+	case "lt", "le", "gt", "ge":
+		switch left.kind {
+		case wirefilterKindBytes, wirefilterKindInt, wirefilterKindIP:
+			return p.parseLiteral(left, false)
+		}
+
+	case "contains", "wildcard", "strict wildcard":
+		if left.kind == wirefilterKindBytes {
+			return p.parseLiteral(left, false)
+		}
+
+	case "matches":
+		if left.kind == wirefilterKindBytes {
+			tok := p.peek()
+			if err := p.parseLiteral(left, false); err != nil {
+				return err
+			}
+			if _, err := regexp.Compile(tok.text); err != nil {
+				return p.errorf(tok, "invalid regular expression: %s", err)
+			}
+			return nil
+		}
+
+	case "in":
+		switch left.kind {
+		case wirefilterKindBytes, wirefilterKindInt, wirefilterKindIP:
+			return p.parseList(left)
+		}
+	}
+
+	return p.errorf(opToken, "operator %q can't be used with %s", opToken.text, left)
+}
+
+// parseList parses either an inline list (`{ ... }`) or a reference to a
+// named list (`$name`) of values of the given type.
+func (p *wirefilterParser) parseList(elem wirefilterType) error {
+	if p.peek().kind == wirefilterTokenList {
+		p.next()
+		return nil
+	}
+
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	for !p.isPunct("}") {
+		if p.peek().kind == wirefilterTokenEOF {
+			return p.errorf(p.peek(), "unterminated list")
+		}
+
+		if err := p.parseLiteral(elem, elem.kind == wirefilterKindInt || elem.kind == wirefilterKindIP); err != nil {
+			return err
+		}
+	}
+	p.next()
+
+	return nil
+}
+
+// parseLiteral parses a literal value of the given type, optionally allowing
+// a range (`start..end`).
+func (p *wirefilterParser) parseLiteral(typ wirefilterType, allowRange bool) error {
+	tok := p.next()
+
+	var ok bool
+	switch typ.kind {
+	case wirefilterKindBytes:
+		ok = tok.kind == wirefilterTokenString
+	case wirefilterKindInt:
+		ok = tok.kind == wirefilterTokenInt
+	case wirefilterKindIP:
+		ok = tok.kind == wirefilterTokenIP
+	case wirefilterKindBool:
+		ok = tok.kind == wirefilterTokenIdent && (tok.text == "true" || tok.text == "false")
+	}
+
+	if !ok {
+		return p.errorf(tok, "expected a %s value but found %q", typ, tok.text)
+	}
+
+	if allowRange && p.isPunct("..") {
+		p.next()
+		end := p.peek()
+		if err := p.parseLiteral(typ, false); err != nil {
+			return err
+		}
+		if typ.kind == wirefilterKindIP && (strings.Contains(tok.text, "/") || strings.Contains(end.text, "/")) {
+			return p.errorf(tok, "IP ranges can't contain CIDRs")
+		}
+	}
+
+	return nil
+}
+
+// parseOperand parses a field, function call or literal along with any
+// indexes that follow it.
+func (p *wirefilterParser) parseOperand() (wirefilterValue, error) {
+	tok := p.next()
+
+	var value wirefilterValue
+	switch tok.kind {
+	case wirefilterTokenString:
+		return wirefilterValue{typ: wirefilterBytes, literal: true}, nil
+	case wirefilterTokenInt:
+		return wirefilterValue{typ: wirefilterInt, literal: true}, nil
+	case wirefilterTokenIP:
+		return wirefilterValue{typ: wirefilterIP, literal: true}, nil
+	case wirefilterTokenIdent:
+		if tok.text == "true" || tok.text == "false" {
+			return wirefilterValue{typ: wirefilterBool, literal: true}, nil
+		}
+
+		if p.isPunct("(") {
+			v, err := p.parseFunctionCall(tok)
+			if err != nil {
+				return v, err
+			}
+			value = v
+		} else {
+			typ, ok := p.scheme.fields[tok.text]
+			if !ok {
+				return value, p.errorf(tok, "unknown field %q", tok.text)
+			}
+			value = wirefilterValue{typ: typ}
+		}
+	default:
+		return value, p.errorf(tok, "expected a field or function but found %q", tok.text)
+	}
+
+	for p.isPunct("[") {
+		open := p.next()
+		index := p.next()
+
+		switch {
+		case index.kind == wirefilterTokenPunct && index.text == "*":
+			if value.typ.kind != wirefilterKindArray && value.typ.kind != wirefilterKindMap {
+				return value, p.errorf(open, "%s can't be indexed with [*]", value.typ)
+			}
+			value = wirefilterValue{typ: *value.typ.elem, unpacked: true}
+		case index.kind == wirefilterTokenInt:
+			if value.typ.kind != wirefilterKindArray {
+				return value, p.errorf(open, "%s can't be indexed by an integer", value.typ)
+			}
+			value = wirefilterValue{typ: *value.typ.elem, unpacked: value.unpacked}
+		case index.kind == wirefilterTokenString:
+			if value.typ.kind != wirefilterKindMap {
+				return value, p.errorf(open, "%s can't be indexed by a string", value.typ)
+			}
+			value = wirefilterValue{typ: *value.typ.elem, unpacked: value.unpacked}
+		default:
+			return value, p.errorf(index, "invalid index %q", index.text)
+		}
+
+		if err := p.expect("]"); err != nil {
+			return value, err
+		}
+	}
+
+	return value, nil
+}
+
+func (p *wirefilterParser) parseFunctionCall(name wirefilterToken) (wirefilterValue, error) {
+	function, ok := p.scheme.functions[name.text]
+	if !ok {
+		return wirefilterValue{}, p.errorf(name, "unknown function %q", name.text)
+	}
+
+	if err := p.expect("("); err != nil {
+		return wirefilterValue{}, err
+	}
+
+	var args []wirefilterValue
+	for !p.isPunct(")") {
+		if len(args) > 0 {
+			if err := p.expect(","); err != nil {
+				return wirefilterValue{}, err
+			}
+		}
+
+		arg, err := p.parseOr()
+		if err != nil {
+			return arg, err
+		}
+		args = append(args, arg)
+	}
+	p.next()
+
+	if len(args) < function.minArgs || (function.maxArgs != -1 && len(args) > function.maxArgs) {
+		expected := fmt.Sprintf("%d", function.minArgs)
+		switch {
+		case function.maxArgs == -1:
+			expected = fmt.Sprintf("at least %d", function.minArgs)
+		case function.maxArgs != function.minArgs:
+			expected = fmt.Sprintf("%d to %d", function.minArgs, function.maxArgs)
+		}
+		return wirefilterValue{}, p.errorf(name, "%s() expects %s arguments but got %d", name.text, expected, len(args))
+	}
+
+	typ, err := function.check(args)
+	if err != nil {
+		return wirefilterValue{}, p.errorf(name, "%s(): %s", name.text, err)
+	}
+
+	unpacked := false
+	if !function.reduce {
+		for _, arg := range args {
+			unpacked = unpacked || arg.unpacked
+		}
+	}
+
+	return wirefilterValue{typ: typ, unpacked: unpacked}, nil
+}
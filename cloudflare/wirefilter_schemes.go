@@ -0,0 +1,369 @@
+package cloudflare
+
+import (
+	"fmt"
+)
+
+// wirefilterRulesScheme covers the fields used by Firewall Rules filters and
+// the Ruleset Engine (both HTTP and network phases).
+//
+// Reference: https://developers.cloudflare.com/firewall/cf-firewall-language/fields
+var wirefilterRulesScheme = &wirefilterScheme{
+	name:      "rules",
+	functions: wirefilterFunctions,
+	fields: map[string]wirefilterType{
+		// Standard fields
+		"cf.bot_management.corporate_proxy":     wirefilterBool,
+		"cf.bot_management.detection_ids":       wirefilterArray(wirefilterInt),
+		"cf.bot_management.ja3_hash":            wirefilterBytes,
+		"cf.bot_management.js_detection.passed": wirefilterBool,
+		"cf.bot_management.score":               wirefilterInt,
+		"cf.bot_management.static_resource":     wirefilterBool,
+		"cf.bot_management.verified_bot":        wirefilterBool,
+		"cf.client.bot":                         wirefilterBool,
+		"cf.client_trust_score":                 wirefilterInt,
+		"cf.colo.id":                            wirefilterInt,
+		"cf.colo.name":                          wirefilterBytes,
+		"cf.colo.region":                        wirefilterBytes,
+		"cf.edge.server_ip":                     wirefilterIP,
+		"cf.edge.server_port":                   wirefilterInt,
+		"cf.hostname.metadata":                  wirefilterBytes,
+		"cf.metal.id":                           wirefilterInt,
+		"cf.random_seed":                        wirefilterBytes,
+		"cf.ray_id":                             wirefilterBytes,
+		"cf.response.1xxx_code":                 wirefilterInt,
+		"cf.response.error_type":                wirefilterBytes,
+		"cf.threat_score":                       wirefilterInt,
+		"cf.verified_bot_category":              wirefilterBytes,
+		"cf.worker.upstream_zone":               wirefilterBytes,
+		"cf.zone.name":                          wirefilterBytes,
+		"cf.zone.plan":                          wirefilterBytes,
+
+		"cf.tls_client_auth.cert_fingerprint_sha1":   wirefilterBytes,
+		"cf.tls_client_auth.cert_fingerprint_sha256": wirefilterBytes,
+		"cf.tls_client_auth.cert_issuer_dn":          wirefilterBytes,
+		"cf.tls_client_auth.cert_issuer_dn_legacy":   wirefilterBytes,
+		"cf.tls_client_auth.cert_issuer_dn_rfc2253":  wirefilterBytes,
+		"cf.tls_client_auth.cert_issuer_serial":      wirefilterBytes,
+		"cf.tls_client_auth.cert_issuer_ski":         wirefilterBytes,
+		"cf.tls_client_auth.cert_not_after":          wirefilterBytes,
+		"cf.tls_client_auth.cert_not_before":         wirefilterBytes,
+		"cf.tls_client_auth.cert_presented":          wirefilterBool,
+		"cf.tls_client_auth.cert_revoked":            wirefilterBool,
+		"cf.tls_client_auth.cert_serial":             wirefilterBytes,
+		"cf.tls_client_auth.cert_ski":                wirefilterBytes,
+		"cf.tls_client_auth.cert_subject_dn":         wirefilterBytes,
+		"cf.tls_client_auth.cert_subject_dn_legacy":  wirefilterBytes,
+		"cf.tls_client_auth.cert_subject_dn_rfc2253": wirefilterBytes,
+		"cf.tls_client_auth.cert_verified":           wirefilterBool,
+
+		"cf.waf.auth_detected":                                 wirefilterBool,
+		"cf.waf.credential_check.password_leaked":              wirefilterBool,
+		"cf.waf.credential_check.username_and_password_leaked": wirefilterBool,
+		"cf.waf.score":       wirefilterInt,
+		"cf.waf.score.class": wirefilterBytes,
+		"cf.waf.score.rce":   wirefilterInt,
+		"cf.waf.score.sqli":  wirefilterInt,
+		"cf.waf.score.xss":   wirefilterInt,
+
+		"http.cookie":                     wirefilterBytes,
+		"http.host":                       wirefilterBytes,
+		"http.referer":                    wirefilterBytes,
+		"http.request.full_uri":           wirefilterBytes,
+		"http.request.method":             wirefilterBytes,
+		"http.request.timestamp.msec":     wirefilterInt,
+		"http.request.timestamp.sec":      wirefilterInt,
+		"http.request.uri":                wirefilterBytes,
+		"http.request.uri.path":           wirefilterBytes,
+		"http.request.uri.path.extension": wirefilterBytes,
+		"http.request.uri.query":          wirefilterBytes,
+		"http.request.version":            wirefilterBytes,
+		"http.user_agent":                 wirefilterBytes,
+		"http.x_forwarded_for":            wirefilterBytes,
+
+		"ip.src":                          wirefilterIP,
+		"ip.src.asnum":                    wirefilterInt,
+		"ip.src.city":                     wirefilterBytes,
+		"ip.src.continent":                wirefilterBytes,
+		"ip.src.country":                  wirefilterBytes,
+		"ip.src.is_in_european_union":     wirefilterBool,
+		"ip.src.lat":                      wirefilterBytes,
+		"ip.src.lon":                      wirefilterBytes,
+		"ip.src.metro_code":               wirefilterBytes,
+		"ip.src.postal_code":              wirefilterBytes,
+		"ip.src.region":                   wirefilterBytes,
+		"ip.src.region_code":              wirefilterBytes,
+		"ip.src.subdivision_1_iso_code":   wirefilterBytes,
+		"ip.src.subdivision_2_iso_code":   wirefilterBytes,
+		"ip.src.timezone.name":            wirefilterBytes,
+		"ip.geoip.asnum":                  wirefilterInt,
+		"ip.geoip.continent":              wirefilterBytes,
+		"ip.geoip.country":                wirefilterBytes,
+		"ip.geoip.is_in_european_union":   wirefilterBool,
+		"ip.geoip.subdivision_1_iso_code": wirefilterBytes,
+		"ip.geoip.subdivision_2_iso_code": wirefilterBytes,
+
+		"ssl": wirefilterBool,
+
+		// URI argument and value fields
+		"http.request.uri.args":        wirefilterMap(wirefilterArray(wirefilterBytes)),
+		"http.request.uri.args.names":  wirefilterArray(wirefilterBytes),
+		"http.request.uri.args.values": wirefilterArray(wirefilterBytes),
+
+		// Header fields
+		"http.request.accepted_languages": wirefilterArray(wirefilterBytes),
+		"http.request.cookies":            wirefilterMap(wirefilterArray(wirefilterBytes)),
+		"http.request.headers":            wirefilterMap(wirefilterArray(wirefilterBytes)),
+		"http.request.headers.names":      wirefilterArray(wirefilterBytes),
+		"http.request.headers.truncated":  wirefilterBool,
+		"http.request.headers.values":     wirefilterArray(wirefilterBytes),
+
+		// Body fields
+		"http.request.body.form":        wirefilterMap(wirefilterArray(wirefilterBytes)),
+		"http.request.body.form.names":  wirefilterArray(wirefilterBytes),
+		"http.request.body.form.values": wirefilterArray(wirefilterBytes),
+		"http.request.body.mime":        wirefilterBytes,
+		"http.request.body.raw":         wirefilterBytes,
+		"http.request.body.size":        wirefilterInt,
+		"http.request.body.truncated":   wirefilterBool,
+
+		"http.request.body.multipart":                            wirefilterMap(wirefilterArray(wirefilterBytes)),
+		"http.request.body.multipart.content_dispositions":       wirefilterArray(wirefilterArray(wirefilterBytes)),
+		"http.request.body.multipart.content_transfer_encodings": wirefilterArray(wirefilterArray(wirefilterBytes)),
+		"http.request.body.multipart.content_types":              wirefilterArray(wirefilterArray(wirefilterBytes)),
+		"http.request.body.multipart.filenames":                  wirefilterArray(wirefilterArray(wirefilterBytes)),
+		"http.request.body.multipart.names":                      wirefilterArray(wirefilterArray(wirefilterBytes)),
+		"http.request.body.multipart.values":                     wirefilterArray(wirefilterBytes),
+
+		// Response fields
+		"http.response.code":                    wirefilterInt,
+		"http.response.content_type.media_type": wirefilterBytes,
+		"http.response.headers":                 wirefilterMap(wirefilterArray(wirefilterBytes)),
+		"http.response.headers.names":           wirefilterArray(wirefilterBytes),
+		"http.response.headers.values":          wirefilterArray(wirefilterBytes),
+
+		// Raw fields
+		"raw.http.request.full_uri":           wirefilterBytes,
+		"raw.http.request.headers":            wirefilterMap(wirefilterArray(wirefilterBytes)),
+		"raw.http.request.headers.names":      wirefilterArray(wirefilterBytes),
+		"raw.http.request.headers.values":     wirefilterArray(wirefilterBytes),
+		"raw.http.request.uri":                wirefilterBytes,
+		"raw.http.request.uri.args":           wirefilterMap(wirefilterArray(wirefilterBytes)),
+		"raw.http.request.uri.args.names":     wirefilterArray(wirefilterBytes),
+		"raw.http.request.uri.args.values":    wirefilterArray(wirefilterBytes),
+		"raw.http.request.uri.path":           wirefilterBytes,
+		"raw.http.request.uri.path.extension": wirefilterBytes,
+		"raw.http.request.uri.query":          wirefilterBytes,
+
+		// Network fields used by Magic Transit and DDoS L4 phases
+		"icmp.code":       wirefilterInt,
+		"icmp.type":       wirefilterInt,
+		"ip.dst":          wirefilterIP,
+		"ip.dst.country":  wirefilterBytes,
+		"ip.hdr_len":      wirefilterInt,
+		"ip.len":          wirefilterInt,
+		"ip.proto":        wirefilterBytes,
+		"ip.ttl":          wirefilterInt,
+		"tcp.dstport":     wirefilterInt,
+		"tcp.flags":       wirefilterInt,
+		"tcp.flags.ack":   wirefilterBool,
+		"tcp.flags.cwr":   wirefilterBool,
+		"tcp.flags.ecn":   wirefilterBool,
+		"tcp.flags.fin":   wirefilterBool,
+		"tcp.flags.push":  wirefilterBool,
+		"tcp.flags.reset": wirefilterBool,
+		"tcp.flags.syn":   wirefilterBool,
+		"tcp.flags.urg":   wirefilterBool,
+		"tcp.srcport":     wirefilterInt,
+		"udp.dstport":     wirefilterInt,
+		"udp.srcport":     wirefilterInt,
+	},
+}
+
+// wirefilterGatewayTrafficScheme covers the fields available to the `traffic`
+// expression of Teams (Gateway) rules for DNS, HTTP and network filtering.
+//
+// Reference: https://developers.cloudflare.com/cloudflare-one/policies/filtering
+var wirefilterGatewayTrafficScheme = &wirefilterScheme{
+	name:       "gateway traffic",
+	functions:  wirefilterFunctions,
+	allowEmpty: true,
+	fields: map[string]wirefilterType{
+		"app.ids":      wirefilterArray(wirefilterInt),
+		"app.type.ids": wirefilterArray(wirefilterInt),
+
+		"dns.content_category":  wirefilterArray(wirefilterInt),
+		"dns.domains":           wirefilterArray(wirefilterBytes),
+		"dns.dst.ip":            wirefilterIP,
+		"dns.fqdn":              wirefilterBytes,
+		"dns.location":          wirefilterBytes,
+		"dns.query_rtype":       wirefilterBytes,
+		"dns.resolved_ips":      wirefilterArray(wirefilterIP),
+		"dns.security_category": wirefilterArray(wirefilterInt),
+		"dns.src.ip":            wirefilterIP,
+
+		"http.conn.dst_ip":                   wirefilterIP,
+		"http.conn.dst_port":                 wirefilterInt,
+		"http.conn.src_ip":                   wirefilterIP,
+		"http.download.file_types":           wirefilterArray(wirefilterBytes),
+		"http.download.mime":                 wirefilterBytes,
+		"http.request.domains":               wirefilterArray(wirefilterBytes),
+		"http.request.full_uri":              wirefilterBytes,
+		"http.request.host":                  wirefilterBytes,
+		"http.request.method":                wirefilterBytes,
+		"http.request.uri":                   wirefilterBytes,
+		"http.request.uri.content_category":  wirefilterArray(wirefilterInt),
+		"http.request.uri.path":              wirefilterBytes,
+		"http.request.uri.security_category": wirefilterArray(wirefilterInt),
+		"http.upload.file_types":             wirefilterArray(wirefilterBytes),
+		"http.upload.mime":                   wirefilterBytes,
+
+		"net.detected_protocol": wirefilterBytes,
+		"net.dst.geo.country":   wirefilterBytes,
+		"net.dst.ip":            wirefilterIP,
+		"net.dst.port":          wirefilterInt,
+		"net.protocol":          wirefilterBytes,
+		"net.sni.domains":       wirefilterArray(wirefilterBytes),
+		"net.sni.host":          wirefilterBytes,
+		"net.src.geo.country":   wirefilterBytes,
+		"net.src.ip":            wirefilterIP,
+		"net.src.port":          wirefilterInt,
+	},
+}
+
+// wirefilterGatewayIdentityScheme covers the fields available to the
+// `identity` expression of Teams (Gateway) rules.
+var wirefilterGatewayIdentityScheme = &wirefilterScheme{
+	name:       "gateway identity",
+	functions:  wirefilterFunctions,
+	allowEmpty: true,
+	fields: map[string]wirefilterType{
+		"identity.email":           wirefilterBytes,
+		"identity.groups.email":    wirefilterArray(wirefilterBytes),
+		"identity.groups.id":       wirefilterArray(wirefilterBytes),
+		"identity.groups.name":     wirefilterArray(wirefilterBytes),
+		"identity.name":            wirefilterBytes,
+		"identity.saml_attributes": wirefilterBytes,
+	},
+}
+
+// wirefilterFunctions are the functions available to all expressions.
+//
+// Reference: https://developers.cloudflare.com/firewall/cf-firewall-language/functions
+var wirefilterFunctions = map[string]wirefilterFunction{
+	"any": {minArgs: 1, maxArgs: 1, reduce: true, check: checkWirefilterReduce},
+	"all": {minArgs: 1, maxArgs: 1, reduce: true, check: checkWirefilterReduce},
+	"bit_slice": {minArgs: 3, maxArgs: 3, check: checkWirefilterArgs(wirefilterInt,
+		wirefilterBytes, wirefilterInt, wirefilterInt)},
+	"cidr": {minArgs: 3, maxArgs: 3, check: checkWirefilterArgs(wirefilterIP,
+		wirefilterIP, wirefilterInt, wirefilterInt)},
+	"cidr6": {minArgs: 2, maxArgs: 2, check: checkWirefilterArgs(wirefilterIP,
+		wirefilterIP, wirefilterInt)},
+	"concat": {minArgs: 1, maxArgs: -1, check: checkWirefilterConcat},
+	"encode_base64": {minArgs: 1, maxArgs: 2, check: checkWirefilterArgs(wirefilterBytes,
+		wirefilterBytes, wirefilterBytes)},
+	"ends_with": {minArgs: 2, maxArgs: 2, check: checkWirefilterArgs(wirefilterBool,
+		wirefilterBytes, wirefilterBytes)},
+	"is_timed_hmac_valid_v0": {minArgs: 4, maxArgs: 6, check: checkWirefilterArgs(wirefilterBool,
+		wirefilterBytes, wirefilterBytes, wirefilterInt, wirefilterInt, wirefilterInt, wirefilterBytes)},
+	"join": {minArgs: 2, maxArgs: 2, check: checkWirefilterArgs(wirefilterBytes,
+		wirefilterArray(wirefilterBytes), wirefilterBytes)},
+	"len":                 {minArgs: 1, maxArgs: 1, check: checkWirefilterLen},
+	"lookup_json_integer": {minArgs: 2, maxArgs: -1, check: checkWirefilterLookupJSON(wirefilterInt)},
+	"lookup_json_string":  {minArgs: 2, maxArgs: -1, check: checkWirefilterLookupJSON(wirefilterBytes)},
+	"lower": {minArgs: 1, maxArgs: 1, check: checkWirefilterArgs(wirefilterBytes,
+		wirefilterBytes)},
+	"regex_replace": {minArgs: 3, maxArgs: 3, check: checkWirefilterArgs(wirefilterBytes,
+		wirefilterBytes, wirefilterBytes, wirefilterBytes)},
+	"remove_bytes": {minArgs: 2, maxArgs: 2, check: checkWirefilterArgs(wirefilterBytes,
+		wirefilterBytes, wirefilterBytes)},
+	"starts_with": {minArgs: 2, maxArgs: 2, check: checkWirefilterArgs(wirefilterBool,
+		wirefilterBytes, wirefilterBytes)},
+	"substring": {minArgs: 2, maxArgs: 3, check: checkWirefilterArgs(wirefilterBytes,
+		wirefilterBytes, wirefilterInt, wirefilterInt)},
+	"to_string": {minArgs: 1, maxArgs: 1, check: checkWirefilterToString},
+	"upper": {minArgs: 1, maxArgs: 1, check: checkWirefilterArgs(wirefilterBytes,
+		wirefilterBytes)},
+	"url_decode": {minArgs: 1, maxArgs: 2, check: checkWirefilterArgs(wirefilterBytes,
+		wirefilterBytes, wirefilterBytes)},
+	"uuidv4": {minArgs: 1, maxArgs: 1, check: checkWirefilterArgs(wirefilterBytes,
+		wirefilterBytes)},
+	"wildcard_replace": {minArgs: 3, maxArgs: 4, check: checkWirefilterArgs(wirefilterBytes,
+		wirefilterBytes, wirefilterBytes, wirefilterBytes, wirefilterBytes)},
+}
+
+// checkWirefilterArgs builds a check for functions with fixed argument types.
+// Optional arguments are handled by the min/max arguments of the function.
+func checkWirefilterArgs(result wirefilterType, params ...wirefilterType) func([]wirefilterValue) (wirefilterType, error) {
+	return func(args []wirefilterValue) (wirefilterType, error) {
+		for i, arg := range args {
+			if !arg.typ.equal(params[i]) {
+				return wirefilterType{}, fmt.Errorf("argument %d must be %s but found %s", i+1, params[i], arg.typ)
+			}
+		}
+
+		return result, nil
+	}
+}
+
+func checkWirefilterReduce(args []wirefilterValue) (wirefilterType, error) {
+	arg := args[0]
+	if (arg.unpacked && arg.typ.equal(wirefilterBool)) || arg.typ.equal(wirefilterArray(wirefilterBool)) {
+		return wirefilterBool, nil
+	}
+
+	if !arg.unpacked && arg.typ.equal(wirefilterBool) {
+		return wirefilterType{}, fmt.Errorf("argument must be a condition on values indexed with [*]")
+	}
+
+	return wirefilterType{}, fmt.Errorf("argument must be Array<Bool> but found %s", arg.typ)
+}
+
+func checkWirefilterConcat(args []wirefilterValue) (wirefilterType, error) {
+	first := args[0].typ
+	if first.kind != wirefilterKindBytes && first.kind != wirefilterKindArray {
+		return wirefilterType{}, fmt.Errorf("arguments must be Bytes or Array but found %s", first)
+	}
+
+	for i, arg := range args[1:] {
+		if !arg.typ.equal(first) {
+			return wirefilterType{}, fmt.Errorf("argument %d must be %s but found %s", i+2, first, arg.typ)
+		}
+	}
+
+	return first, nil
+}
+
+func checkWirefilterLen(args []wirefilterValue) (wirefilterType, error) {
+	switch args[0].typ.kind {
+	case wirefilterKindBytes, wirefilterKindArray:
+		return wirefilterInt, nil
+	}
+
+	return wirefilterType{}, fmt.Errorf("argument must be Bytes or Array but found %s", args[0].typ)
+}
+
+func checkWirefilterLookupJSON(result wirefilterType) func([]wirefilterValue) (wirefilterType, error) {
+	return func(args []wirefilterValue) (wirefilterType, error) {
+		if !args[0].typ.equal(wirefilterBytes) {
+			return wirefilterType{}, fmt.Errorf("argument 1 must be Bytes but found %s", args[0].typ)
+		}
+
+		for i, arg := range args[1:] {
+			if !arg.typ.equal(wirefilterBytes) && !arg.typ.equal(wirefilterInt) {
+				return wirefilterType{}, fmt.Errorf("argument %d must be Bytes or Int but found %s", i+2, arg.typ)
+			}
+		}
+
+		return result, nil
+	}
+}
+
+func checkWirefilterToString(args []wirefilterValue) (wirefilterType, error) {
+	switch args[0].typ.kind {
+	case wirefilterKindInt, wirefilterKindBool, wirefilterKindIP:
+		return wirefilterBytes, nil
+	}
+
+	return wirefilterType{}, fmt.Errorf("argument must be Int, Bool or IP but found %s", args[0].typ)
+}
@@ -0,0 +1,185 @@
+package cloudflare
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckWirefilterExpressionValid(t *testing.T) {
+	expressions := []string{
+		`true`,
+		`ssl`,
+		`not ssl`,
+		`!ssl and cf.client.bot`,
+		`(ip.geoip.country eq "GB" or ip.geoip.country eq "FR") or cf.threat_score > 0`,
+		`(http.request.uri.path ~ ".*wp-login.php" or http.request.uri.path ~ ".*xmlrpc.php") and ip.src ne 192.0.2.1`,
+		"\t\nhttp.request.method in {\"PUT\" \"DELETE\"} and\nhttp.request.uri.path eq \"/\"  \n",
+		`http.request.method == "POST" && http.request.uri == "/login.php"`,
+		`(http.request.uri.path matches "^/api/")`,
+		`(cf.zone.name eq "domain.xyz" and http.request.uri.query contains "skip=rules")`,
+		`tcp.dstport in { 32768..65535 }`,
+		`udp.dstport in { 32768..65535 } xor ip.len >= 0`,
+		`ip.src in {192.0.2.0/24 2001:db8::/32 198.51.100.1..198.51.100.10}`,
+		`ip.src eq ::1 or ip.src in {fe80::/10}`,
+		`ip.src in $office_network`,
+		`ip.src in $cf.open_proxies`,
+		`ip.src in $cf.anonymizer or ip.src in $cf.vpn`,
+		`not ip.src in $cf.botnetcc and not ip.src in $cf.malware`,
+		`lower(http.request.uri.path) contains "/admin"`,
+		`ends_with(http.request.uri.path, ".php") and starts_with(http.host, "www.")`,
+		`any(http.request.headers["content-type"][*] == "application/json")`,
+		`all(http.request.headers.names[*] ne "x-debug")`,
+		`any(lower(http.request.headers.names[*]) contains "token")`,
+		`http.request.uri.args["search"][0] == "red+apples"`,
+		`len(http.request.body.raw) gt 1024`,
+		`http.host strict wildcard "*.example.com"`,
+		`http.request.uri.path matches r"^/static/.*\.js$"`,
+		`http.request.uri.path matches r#"^/a"b/.*$"#`,
+		`is_timed_hmac_valid_v0("secret", http.request.uri, 10800, http.request.timestamp.sec, 8)`,
+		`to_string(cf.bot_management.score) eq "1"`,
+	}
+
+	for _, expression := range expressions {
+		typ, err := checkWirefilterExpression(wirefilterRulesScheme, expression)
+		if err != nil {
+			t.Errorf("%q should be valid: %s", expression, err)
+			continue
+		}
+		if !typ.equal(wirefilterBool) {
+			t.Errorf("%q should evaluate to Bool but got %s", expression, typ)
+		}
+	}
+}
+
+func TestCheckWirefilterExpressionValueTypes(t *testing.T) {
+	expressions := map[string]wirefilterType{
+		`cf.zone.name`: wirefilterBytes,
+		`concat("requestUrl=", http.request.full_uri)`:                                                        wirefilterBytes,
+		`url_decode(http.request.body.form["username"][0])`:                                                   wirefilterBytes,
+		`regex_replace(http.request.uri.path, "^/old/(.*)$", "/new/${1}")`:                                    wirefilterBytes,
+		`substring(http.request.uri.path, -5)`:                                                                wirefilterBytes,
+		`len(http.request.headers.names)`:                                                                     wirefilterInt,
+		`concat(http.request.headers.names, http.request.headers.values)`:                                     wirefilterArray(wirefilterBytes),
+		`lookup_json_string(http.request.body.raw, "user", "roles", 0)`:                                       wirefilterBytes,
+		`http.request.headers`:                                                                                wirefilterMap(wirefilterArray(wirefilterBytes)),
+		`wildcard_replace(http.request.full_uri, "https://*.example.com/*", "https://example.com/${1}/${2}")`: wirefilterBytes,
+	}
+
+	for expression, expected := range expressions {
+		typ, err := checkWirefilterExpression(wirefilterRulesScheme, expression)
+		if err != nil {
+			t.Errorf("%q should be valid: %s", expression, err)
+			continue
+		}
+		if !typ.equal(expected) {
+			t.Errorf("%q should evaluate to %s but got %s", expression, expected, typ)
+		}
+	}
+}
+
+func TestCheckWirefilterExpressionInvalid(t *testing.T) {
+	expressions := map[string]string{
+		``:                                       "expression is empty",
+		`http.uri.path eq "/old-path"`:           `unknown field "http.uri.path"`,
+		`http.host eq`:                           "expected a Bytes value",
+		`http.host eq 1`:                         "expected a Bytes value",
+		`cf.threat_score eq "10"`:                "expected a Int value",
+		`ip.src eq "192.0.2.1"`:                  "expected a IP value",
+		`ip.src eq 192.0.2.300`:                  `invalid IP address "192.0.2.300"`,
+		`ip.src in {192.0.2.0/33}`:               `invalid CIDR "192.0.2.0/33"`,
+		`cf.threat_score contains "1"`:           `operator "contains" can't be used with Int`,
+		`http.request.headers.names eq "a"`:      `operator "eq" can't be used with Array<Bytes>`,
+		`http.request.uri.path matches "(["`:     "invalid regular expression",
+		`http.host eq "example.com`:              "unterminated string",
+		`(http.host eq "example.com"`:            `expected ")"`,
+		`http.host eq "a" and`:                   "expected a field or function",
+		`http.host eq "a" "b"`:                   `unexpected "b"`,
+		`http.host`:                              "",
+		`"example.com" eq http.host`:             "must be a field or function",
+		`http.host eq "a" and cf.threat_score`:   `"and" needs a Bool on the right hand side but found Int`,
+		`lower(cf.threat_score) eq "1"`:          "lower(): argument 1 must be Bytes but found Int",
+		`lower(http.host, "a") eq "1"`:           "lower() expects 1 arguments but got 2",
+		`unknown(http.host)`:                     `unknown function "unknown"`,
+		`http.request.headers.names[*] eq "a"`:   "must be wrapped in any() or all()",
+		`any(http.host eq "a")`:                  "any(): argument must be a condition on values indexed with [*]",
+		`http.host[0] eq "a"`:                    "Bytes can't be indexed by an integer",
+		`http.request.headers[0][0] eq "a"`:      "can't be indexed by an integer",
+		`http.host eq "a" , ssl`:                 `unexpected ","`,
+		`tcp.dstport in { 1, 2 }`:                `expected a Int value but found ","`,
+		`http.host eq "\q"`:                      "invalid escape sequence",
+		`http.host eq "a" # comment`:             "unexpected character",
+		`ip.src in {192.0.2.0/24..192.0.3.0/24}`: "IP ranges can't contain CIDRs",
+		`ip.src in $`:                            "expected a list name",
+		`ip.src in $cf.`:                         "expected a list name",
+	}
+
+	for expression, expected := range expressions {
+		typ, err := checkWirefilterExpression(wirefilterRulesScheme, expression)
+		if err == nil {
+			if typ.equal(wirefilterBool) {
+				t.Errorf("%q should be invalid", expression)
+			}
+			continue
+		}
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("%q should fail with %q but got %q", expression, expected, err)
+		}
+	}
+}
+
+func TestCheckWirefilterExpressionGateway(t *testing.T) {
+	traffic := []string{
+		`any(dns.domains[*] == "example.com")`,
+		`any(dns.content_category[*] in {80 83})`,
+		`http.request.host == "example.com" and net.dst.port in {443 8443}`,
+		`dns.fqdn in $5b6c6a36-4b1c-4b0c-8a0a-0f4c6d9e3f6e`,
+		`net.dst.ip in {10.0.0.0/8}`,
+	}
+
+	for _, expression := range traffic {
+		if _, err := checkWirefilterExpression(wirefilterGatewayTrafficScheme, expression); err != nil {
+			t.Errorf("%q should be a valid traffic expression: %s", expression, err)
+		}
+	}
+
+	identity := []string{
+		`identity.email == "test@example.com"`,
+		`any(identity.groups.name[*] in {"finance"})`,
+	}
+
+	for _, expression := range identity {
+		if _, err := checkWirefilterExpression(wirefilterGatewayIdentityScheme, expression); err != nil {
+			t.Errorf("%q should be a valid identity expression: %s", expression, err)
+		}
+	}
+
+	if _, err := checkWirefilterExpression(wirefilterGatewayTrafficScheme, `identity.email == "test@example.com"`); err == nil {
+		t.Error("identity fields should not be valid in traffic expressions")
+	}
+
+	if _, err := checkWirefilterExpression(wirefilterGatewayTrafficScheme, `http.request.uri.content_category == 1`); err == nil {
+		t.Error("array fields should not be comparable with a single value")
+	}
+}
+
+func TestValidateWirefilterExpression(t *testing.T) {
+	if _, errs := validateWirefilterExpression(wirefilterRulesScheme)(`http.host eq "example.com"`, "expression"); len(errs) > 0 {
+		t.Errorf("expected no errors but got %v", errs)
+	}
+
+	if _, errs := validateWirefilterExpression(wirefilterRulesScheme)(`http.host`, "expression"); len(errs) != 1 || !strings.Contains(errs[0].Error(), "must evaluate to Bool but evaluates to Bytes") {
+		t.Errorf("expected a type error but got %v", errs)
+	}
+
+	if _, errs := validateWirefilterExpression(wirefilterRulesScheme)(``, "expression"); len(errs) != 1 {
+		t.Errorf("expected empty rules expressions to be invalid but got %v", errs)
+	}
+
+	if _, errs := validateWirefilterExpression(wirefilterGatewayIdentityScheme)(``, "identity"); len(errs) > 0 {
+		t.Errorf("expected empty gateway expressions to be valid but got %v", errs)
+	}
+
+	if _, errs := validateWirefilterExpressionType(wirefilterRulesScheme, wirefilterBytes)(`concat("/", http.request.uri.path)`, "expression"); len(errs) > 0 {
+		t.Errorf("expected no errors but got %v", errs)
+	}
+}
@@ -0,0 +1,274 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"sort"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+)
+
+const workerScriptDefaultModuleContentType = "application/javascript+module"
+
+// workerScriptModuleContentTypes are the MIME types a module of a module
+// format worker can be uploaded as.
+var workerScriptModuleContentTypes = []string{
+	"application/javascript+module",
+	"text/javascript",
+	"application/wasm",
+	"text/plain",
+	"application/octet-stream",
+}
+
+// workerScriptBinaryContentTypes are stored base64 encoded in the state.
+var workerScriptBinaryContentTypes = []string{"application/wasm", "application/octet-stream"}
+
+// workerScriptUpload is everything that makes up a worker script upload. When
+// MainModule is set the script is uploaded in the ES module format and Script
+// is ignored.
+//
+// MetadataBindings holds the bindings cloudflare-go has no type for, such as
+// Durable Object namespaces, as the metadata sent to the API keyed by name.
+type workerScriptUpload struct {
+	Script           string
+	MainModule       string
+	Modules          []workerScriptModule
+	Bindings         ScriptBindings
+	MetadataBindings map[string]map[string]interface{}
+}
+
+type workerScriptModule struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// uploadWorkerScript uploads the script using the multipart metadata format.
+// cloudflare-go can only upload service worker scripts so the request body is
+// built here and sent with the multipart content type set.
+func uploadWorkerScript(ctx context.Context, client *providerClient, scriptName string, upload workerScriptUpload) error {
+	if client.AccountID == "" {
+		return errors.New("account ID required")
+	}
+
+	contentType, body, err := formatWorkerScriptUpload(upload)
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", contentType)
+
+	_, err = client.requestWithHeaders(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/workers/scripts/%s", client.AccountID, scriptName), body, headers)
+	return err
+}
+
+// formatWorkerScriptUpload returns the content type and body of the upload.
+func formatWorkerScriptUpload(upload workerScriptUpload) (string, []byte, error) {
+	buf := &bytes.Buffer{}
+	mpw := multipart.NewWriter(buf)
+
+	metadata := map[string]interface{}{}
+	if upload.MainModule != "" {
+		metadata["main_module"] = upload.MainModule
+	} else {
+		metadata["body_part"] = "script"
+	}
+
+	// Sort the bindings so the body is the same on every run.
+	names := make([]string, 0, len(upload.Bindings)+len(upload.MetadataBindings))
+	for name := range upload.Bindings {
+		names = append(names, name)
+	}
+	for name := range upload.MetadataBindings {
+		if _, ok := upload.Bindings[name]; ok {
+			return "", nil, fmt.Errorf("duplicate binding name %q", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bindings := make([]map[string]interface{}, 0, len(names))
+	var parts []workerScriptModule
+	for _, name := range names {
+		if metadata, ok := upload.MetadataBindings[name]; ok {
+			binding := map[string]interface{}{"name": name}
+			for key, value := range metadata {
+				binding[key] = value
+			}
+			bindings = append(bindings, binding)
+			continue
+		}
+
+		binding, part, err := workerBindingMetadata(name, upload.Bindings[name])
+		if err != nil {
+			return "", nil, err
+		}
+
+		bindings = append(bindings, binding)
+		if part != nil {
+			parts = append(parts, *part)
+		}
+	}
+	metadata["bindings"] = bindings
+
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := writeWorkerScriptPart(mpw, "metadata", "", "application/json", metadataJSON); err != nil {
+		return "", nil, err
+	}
+
+	if upload.MainModule != "" {
+		for _, module := range upload.Modules {
+			if err := writeWorkerScriptPart(mpw, module.Name, module.Name, module.ContentType, module.Content); err != nil {
+				return "", nil, err
+			}
+		}
+	} else {
+		if err := writeWorkerScriptPart(mpw, "script", "", "application/javascript", []byte(upload.Script)); err != nil {
+			return "", nil, err
+		}
+	}
+
+	for _, part := range parts {
+		if err := writeWorkerScriptPart(mpw, part.Name, "", part.ContentType, part.Content); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if err := mpw.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return mpw.FormDataContentType(), buf.Bytes(), nil
+}
+
+func writeWorkerScriptPart(mpw *multipart.Writer, name, filename, contentType string, content []byte) error {
+	disposition := fmt.Sprintf(`form-data; name="%s"`, name)
+	if filename != "" {
+		disposition += fmt.Sprintf(`; filename="%s"`, filename)
+	}
+
+	hdr := textproto.MIMEHeader{}
+	hdr.Set("Content-Disposition", disposition)
+	hdr.Set("Content-Type", contentType)
+
+	pw, err := mpw.CreatePart(hdr)
+	if err != nil {
+		return err
+	}
+
+	_, err = pw.Write(content)
+	return err
+}
+
+// workerBindingMetadata returns the metadata of a binding and the extra part
+// some bindings need to upload their content.
+func workerBindingMetadata(name string, binding cloudflare.WorkerBinding) (map[string]interface{}, *workerScriptModule, error) {
+	metadata := map[string]interface{}{
+		"name": name,
+		"type": binding.Type().String(),
+	}
+
+	switch v := binding.(type) {
+	case cloudflare.WorkerKvNamespaceBinding:
+		metadata["namespace_id"] = v.NamespaceID
+	case cloudflare.WorkerPlainTextBinding:
+		metadata["text"] = v.Text
+	case cloudflare.WorkerSecretTextBinding:
+		metadata["text"] = v.Text
+	case cloudflare.WorkerWebAssemblyBinding:
+		content, err := ioutil.ReadAll(v.Module)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, fmt.Sprintf("cannot read contents of wasm bindings (%s)", name))
+		}
+		partName := "wasm_" + name
+		metadata["part"] = partName
+		return metadata, &workerScriptModule{Name: partName, ContentType: "application/wasm", Content: content}, nil
+	case cloudflare.WorkerInheritBinding:
+		if v.OldName != "" {
+			metadata["old_name"] = v.OldName
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported binding type %q for %s", binding.Type(), name)
+	}
+
+	return metadata, nil, nil
+}
+
+// listWorkerScriptBindingMetadata returns the bindings of a script as returned
+// by the API keyed by name. Unlike cloudflare.ListWorkerBindings this keeps the
+// details of the binding types cloudflare-go doesn't know about.
+func listWorkerScriptBindingMetadata(ctx context.Context, client *providerClient, scriptName string) (map[string]map[string]interface{}, error) {
+	if client.AccountID == "" {
+		return nil, errors.New("account ID required")
+	}
+
+	res, err := client.request(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/workers/scripts/%s/bindings", client.AccountID, scriptName), nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list script bindings: %v", err)
+	}
+
+	var list []map[string]interface{}
+	if err := json.Unmarshal(res, &list); err != nil {
+		return nil, fmt.Errorf("cannot list script bindings: %v", err)
+	}
+
+	bindings := make(map[string]map[string]interface{}, len(list))
+	for _, binding := range list {
+		if name, ok := binding["name"].(string); ok {
+			bindings[name] = binding
+		}
+	}
+
+	return bindings, nil
+}
+
+// parseWorkerScriptModules splits the download of a module format worker,
+// which is multipart form data, into its modules. It returns false when the
+// script is a service worker.
+func parseWorkerScriptModules(script string) ([]workerScriptModule, bool) {
+	firstLine := strings.TrimRight(strings.SplitN(script, "\n", 2)[0], "\r")
+	if !strings.HasPrefix(firstLine, "--") || len(firstLine) < 3 {
+		return nil, false
+	}
+
+	reader := multipart.NewReader(strings.NewReader(script), strings.TrimPrefix(firstLine, "--"))
+
+	var modules []workerScriptModule
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			break
+		}
+
+		content, err := ioutil.ReadAll(part)
+		if err != nil {
+			return nil, false
+		}
+
+		name := part.FileName()
+		if name == "" {
+			name = part.FormName()
+		}
+
+		modules = append(modules, workerScriptModule{
+			Name:        name,
+			ContentType: part.Header.Get("Content-Type"),
+			Content:     content,
+		})
+	}
+
+	return modules, len(modules) > 0
+}
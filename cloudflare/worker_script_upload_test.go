@@ -0,0 +1,178 @@
+package cloudflare
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"reflect"
+	"strings"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+func readWorkerScriptUpload(t *testing.T, contentType string, body []byte) (map[string]interface{}, []workerScriptModule) {
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatalf("invalid content type %q: %s", contentType, err)
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+
+	var metadata map[string]interface{}
+	var parts []workerScriptModule
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			break
+		}
+
+		content, _ := ioutil.ReadAll(part)
+		if part.FormName() == "metadata" {
+			if err := json.Unmarshal(content, &metadata); err != nil {
+				t.Fatalf("invalid metadata: %s", err)
+			}
+			continue
+		}
+
+		parts = append(parts, workerScriptModule{Name: part.FormName(), ContentType: part.Header.Get("Content-Type"), Content: content})
+	}
+
+	return metadata, parts
+}
+
+func TestFormatWorkerScriptUploadServiceWorker(t *testing.T) {
+	wasm, _ := base64.StdEncoding.DecodeString(encodedWasm)
+
+	contentType, body, err := formatWorkerScriptUpload(workerScriptUpload{
+		Script: scriptContent1,
+		Bindings: ScriptBindings{
+			"MY_TEXT": cloudflare.WorkerPlainTextBinding{Text: "text"},
+			"MY_KV":   cloudflare.WorkerKvNamespaceBinding{NamespaceID: "abc"},
+			"MY_WASM": cloudflare.WorkerWebAssemblyBinding{Module: bytes.NewReader(wasm)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	metadata, parts := readWorkerScriptUpload(t, contentType, body)
+
+	expected := map[string]interface{}{
+		"body_part": "script",
+		"bindings": []interface{}{
+			map[string]interface{}{"name": "MY_KV", "type": "kv_namespace", "namespace_id": "abc"},
+			map[string]interface{}{"name": "MY_TEXT", "type": "plain_text", "text": "text"},
+			map[string]interface{}{"name": "MY_WASM", "type": "wasm_module", "part": "wasm_MY_WASM"},
+		},
+	}
+	if !reflect.DeepEqual(metadata, expected) {
+		t.Errorf("unexpected metadata:\n got: %v\nwant: %v", metadata, expected)
+	}
+
+	expectedParts := []workerScriptModule{
+		{Name: "script", ContentType: "application/javascript", Content: []byte(scriptContent1)},
+		{Name: "wasm_MY_WASM", ContentType: "application/wasm", Content: wasm},
+	}
+	if !reflect.DeepEqual(parts, expectedParts) {
+		t.Errorf("unexpected parts:\n got: %v\nwant: %v", parts, expectedParts)
+	}
+}
+
+func TestFormatWorkerScriptUploadModules(t *testing.T) {
+	modules := []workerScriptModule{
+		{Name: "index.js", ContentType: "application/javascript+module", Content: []byte(moduleContent)},
+		{Name: "utils.js", ContentType: "application/javascript+module", Content: []byte(`export const greeting = "hello";`)},
+		{Name: "data.txt", ContentType: "text/plain", Content: []byte("some text")},
+	}
+
+	contentType, body, err := formatWorkerScriptUpload(workerScriptUpload{
+		Script:     moduleContent,
+		MainModule: "index.js",
+		Modules:    modules,
+		Bindings:   ScriptBindings{"MY_SECRET": cloudflare.WorkerSecretTextBinding{Text: "secret"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	metadata, parts := readWorkerScriptUpload(t, contentType, body)
+
+	if metadata["main_module"] != "index.js" {
+		t.Errorf("expected main_module to be index.js but got %v", metadata["main_module"])
+	}
+	if _, ok := metadata["body_part"]; ok {
+		t.Error("module uploads should not set body_part")
+	}
+	if !reflect.DeepEqual(parts, modules) {
+		t.Errorf("unexpected parts:\n got: %v\nwant: %v", parts, modules)
+	}
+
+	// The API returns module workers in the same format when downloading.
+	downloaded, ok := parseWorkerScriptModules(string(body))
+	if !ok {
+		t.Fatal("expected the upload to be parsed as a module worker")
+	}
+	if len(downloaded) != 4 || downloaded[1].Name != "index.js" || string(downloaded[1].Content) != moduleContent {
+		t.Errorf("unexpected modules: %v", downloaded)
+	}
+}
+
+func TestFormatWorkerScriptUploadMetadataBindings(t *testing.T) {
+	contentType, body, err := formatWorkerScriptUpload(workerScriptUpload{
+		Script:   scriptContent1,
+		Bindings: ScriptBindings{"MY_TEXT": cloudflare.WorkerPlainTextBinding{Text: "text"}},
+		MetadataBindings: map[string]map[string]interface{}{
+			"MY_BUCKET":  {"type": "r2_bucket", "bucket_name": "bucket"},
+			"MY_SERVICE": {"type": "service", "service": "backend", "environment": "production"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	metadata, _ := readWorkerScriptUpload(t, contentType, body)
+
+	expected := []interface{}{
+		map[string]interface{}{"name": "MY_BUCKET", "type": "r2_bucket", "bucket_name": "bucket"},
+		map[string]interface{}{"name": "MY_SERVICE", "type": "service", "service": "backend", "environment": "production"},
+		map[string]interface{}{"name": "MY_TEXT", "type": "plain_text", "text": "text"},
+	}
+	if !reflect.DeepEqual(metadata["bindings"], expected) {
+		t.Errorf("unexpected bindings:\n got: %v\nwant: %v", metadata["bindings"], expected)
+	}
+
+	_, _, err = formatWorkerScriptUpload(workerScriptUpload{
+		Script:           scriptContent1,
+		Bindings:         ScriptBindings{"MY_BINDING": cloudflare.WorkerPlainTextBinding{Text: "text"}},
+		MetadataBindings: map[string]map[string]interface{}{"MY_BINDING": {"type": "r2_bucket", "bucket_name": "bucket"}},
+	})
+	if err == nil {
+		t.Error("expected an error for duplicate binding names")
+	}
+}
+
+func TestParseWorkerScriptModulesServiceWorker(t *testing.T) {
+	for _, script := range []string{scriptContent1, "", "--", "-- not multipart\nfoo"} {
+		if _, ok := parseWorkerScriptModules(script); ok {
+			t.Errorf("%q should not be parsed as a module worker", script)
+		}
+	}
+
+	download := strings.Join([]string{
+		"--boundary",
+		`Content-Disposition: form-data; name="index.js"`,
+		"",
+		moduleContent,
+		"--boundary--",
+		"",
+	}, "\r\n")
+
+	modules, ok := parseWorkerScriptModules(download)
+	if !ok || len(modules) != 1 || modules[0].Name != "index.js" || string(modules[0].Content) != moduleContent {
+		t.Errorf("unexpected modules: %v", modules)
+	}
+}
@@ -0,0 +1,93 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// cloudflare-go doesn't support outgoing zone transfers yet so the endpoints
+// are called here using the raw client.
+
+// zoneTransferOutgoing is the outgoing zone transfer configuration of a
+// primary zone, the peers are allowed to transfer the zone from Cloudflare.
+type zoneTransferOutgoing struct {
+	ID                  string     `json:"id,omitempty"`
+	Name                string     `json:"name"`
+	Peers               []string   `json:"peers"`
+	SoaSerial           int        `json:"soa_serial,omitempty"`
+	CheckedTime         *time.Time `json:"checked_time,omitempty"`
+	CreatedTime         *time.Time `json:"created_time,omitempty"`
+	LastTransferredTime *time.Time `json:"last_transferred_time,omitempty"`
+}
+
+func zoneTransferOutgoingURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/secondary_dns/outgoing", zoneID)
+}
+
+func fetchZoneTransferOutgoing(ctx context.Context, client *providerClient, zoneID string) (zoneTransferOutgoing, error) {
+	var result zoneTransferOutgoing
+
+	res, err := client.request(ctx, http.MethodGet, zoneTransferOutgoingURI(zoneID), nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling outgoing zone transfer: %w", err)
+	}
+
+	return result, nil
+}
+
+func createZoneTransferOutgoing(ctx context.Context, client *providerClient, zoneID string, outgoing zoneTransferOutgoing) error {
+	_, err := client.request(ctx, http.MethodPost, zoneTransferOutgoingURI(zoneID), outgoing)
+	return err
+}
+
+func updateZoneTransferOutgoing(ctx context.Context, client *providerClient, zoneID string, outgoing zoneTransferOutgoing) error {
+	_, err := client.request(ctx, http.MethodPut, zoneTransferOutgoingURI(zoneID), outgoing)
+	return err
+}
+
+func deleteZoneTransferOutgoing(ctx context.Context, client *providerClient, zoneID string) error {
+	_, err := client.request(ctx, http.MethodDelete, zoneTransferOutgoingURI(zoneID), nil)
+	return err
+}
+
+// fetchZoneTransferOutgoingEnabled returns whether outgoing zone transfers are
+// currently allowed for the zone.
+func fetchZoneTransferOutgoingEnabled(ctx context.Context, client *providerClient, zoneID string) (bool, error) {
+	res, err := client.request(ctx, http.MethodGet, zoneTransferOutgoingURI(zoneID)+"/status", nil)
+	if err != nil {
+		return false, err
+	}
+
+	var status string
+	if err := json.Unmarshal(res, &status); err != nil {
+		return false, fmt.Errorf("error unmarshalling outgoing zone transfer status: %w", err)
+	}
+
+	return status == "Enabled", nil
+}
+
+// setZoneTransferOutgoingEnabled allows or disallows outgoing zone transfers
+// without removing the configuration.
+func setZoneTransferOutgoingEnabled(ctx context.Context, client *providerClient, zoneID string, enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+
+	_, err := client.request(ctx, http.MethodPost, zoneTransferOutgoingURI(zoneID)+"/"+action, map[string]interface{}{})
+	return err
+}
+
+// forceZoneTransferOutgoingNotify sends a NOTIFY to the peers so they transfer
+// the zone right away instead of waiting for the SOA refresh.
+func forceZoneTransferOutgoingNotify(ctx context.Context, client *providerClient, zoneID string) error {
+	_, err := client.request(ctx, http.MethodPost, zoneTransferOutgoingURI(zoneID)+"/force_notify", map[string]interface{}{})
+	return err
+}
@@ -0,0 +1,521 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The phases page rule actions are converted to. The True-Client-IP header is
+// set by a transform rule while host header and resolve overrides become
+// origin rules.
+const (
+	phaseCacheSettings   = "http_request_cache_settings"
+	phaseConfigSettings  = "http_config_settings"
+	phaseDynamicRedirect = "http_request_dynamic_redirect"
+	phaseLateTransform   = "http_request_late_transform"
+	phaseOrigin          = "http_request_origin"
+)
+
+// phases lists the phases in the order the rulesets are written.
+var phases = []string{phaseCacheSettings, phaseConfigSettings, phaseDynamicRedirect, phaseLateTransform, phaseOrigin}
+
+// supportedPhases are the phases `cloudflare_ruleset` supports. The actions of
+// the other phases are reported until it supports them so that the generated
+// configuration can be applied.
+var supportedPhases = map[string]bool{
+	phaseLateTransform: true,
+}
+
+// actionPhases are the phases the page rule actions are converted to.
+var actionPhases = map[string]string{
+	"cache_level":                 phaseCacheSettings,
+	"edge_cache_ttl":              phaseCacheSettings,
+	"cache_ttl_by_status":         phaseCacheSettings,
+	"browser_cache_ttl":           phaseCacheSettings,
+	"bypass_cache_on_cookie":      phaseCacheSettings,
+	"cache_by_device_type":        phaseCacheSettings,
+	"cache_deception_armor":       phaseCacheSettings,
+	"sort_query_string_for_cache": phaseCacheSettings,
+	"respect_strong_etag":         phaseCacheSettings,
+	"origin_error_page_pass_thru": phaseCacheSettings,
+	"explicit_cache_control":      phaseCacheSettings,
+	"cache_key_fields":            phaseCacheSettings,
+	"automatic_https_rewrites":    phaseConfigSettings,
+	"email_obfuscation":           phaseConfigSettings,
+	"mirage":                      phaseConfigSettings,
+	"opportunistic_encryption":    phaseConfigSettings,
+	"rocket_loader":               phaseConfigSettings,
+	"browser_check":               phaseConfigSettings,
+	"server_side_exclude":         phaseConfigSettings,
+	"disable_apps":                phaseConfigSettings,
+	"disable_railgun":             phaseConfigSettings,
+	"disable_performance":         phaseConfigSettings,
+	"disable_security":            phaseConfigSettings,
+	"minify":                      phaseConfigSettings,
+	"polish":                      phaseConfigSettings,
+	"security_level":              phaseConfigSettings,
+	"ssl":                         phaseConfigSettings,
+	"true_client_ip_header":       phaseLateTransform,
+	"always_use_https":            phaseDynamicRedirect,
+	"forwarding_url":              phaseDynamicRedirect,
+	"host_header_override":        phaseOrigin,
+	"resolve_override":            phaseOrigin,
+}
+
+// rulesetRule is a rule of a `cloudflare_ruleset`. The action parameters hold
+// values for attributes, maps for nested blocks and lists of maps for
+// repeated blocks.
+type rulesetRule struct {
+	Action           string
+	Expression       string
+	Description      string
+	Enabled          bool
+	ActionParameters map[string]interface{}
+}
+
+// unsupportedAction is a page rule action without an equivalent in the
+// rulesets engine.
+type unsupportedAction struct {
+	Address string
+	Action  string
+	Reason  string
+}
+
+func (u unsupportedAction) String() string {
+	return fmt.Sprintf("%s: %s: %s", u.Address, u.Action, u.Reason)
+}
+
+// conversion holds the rules of each phase of each zone.
+type conversion struct {
+	Rules       map[string]map[string][]rulesetRule
+	Unsupported []unsupportedAction
+}
+
+// convertPageRules converts the page rules, which must be ordered by priority.
+// Unlike a page rule, which only applies when it's the highest priority match,
+// all the matching rules of a phase apply and later ones override the
+// settings of earlier ones, except for redirects where the first match wins.
+func convertPageRules(pageRules []pageRule) *conversion {
+	c := &conversion{Rules: make(map[string]map[string][]rulesetRule)}
+
+	for _, pageRule := range pageRules {
+		if c.Rules[pageRule.ZoneID] == nil {
+			c.Rules[pageRule.ZoneID] = make(map[string][]rulesetRule)
+		}
+		zoneRules := c.Rules[pageRule.ZoneID]
+
+		converter := &pageRuleConverter{
+			pageRule: pageRule,
+			target:   parseTarget(pageRule.Target),
+			rules:    make(map[string][]rulesetRule),
+		}
+		converter.convert()
+
+		for phase, rules := range converter.rules {
+			if phase == phaseDynamicRedirect {
+				zoneRules[phase] = append(rules, zoneRules[phase]...)
+			} else {
+				zoneRules[phase] = append(zoneRules[phase], rules...)
+			}
+		}
+
+		c.Unsupported = append(c.Unsupported, converter.unsupported...)
+	}
+
+	return c
+}
+
+type pageRuleConverter struct {
+	pageRule    pageRule
+	target      pageRuleTarget
+	rules       map[string][]rulesetRule
+	unsupported []unsupportedAction
+
+	cache      map[string]interface{}
+	cacheKey   map[string]interface{}
+	customKey  map[string]interface{}
+	edgeTTL    map[string]interface{}
+	config     map[string]interface{}
+	origin     map[string]interface{}
+	extraRules []rulesetRule
+}
+
+func (c *pageRuleConverter) convert() {
+	c.cache = map[string]interface{}{}
+	c.cacheKey = map[string]interface{}{}
+	c.customKey = map[string]interface{}{}
+	c.edgeTTL = map[string]interface{}{}
+	c.config = map[string]interface{}{}
+	c.origin = map[string]interface{}{}
+
+	actions := c.pageRule.Actions
+	keys := make([]string, 0, len(actions))
+	for k := range actions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if isZeroValue(actions[k]) {
+			continue
+		}
+		if phase, ok := actionPhases[k]; ok && !supportedPhases[phase] {
+			c.report(k, fmt.Sprintf("the %s phase isn't supported by cloudflare_ruleset yet", phase))
+			continue
+		}
+		c.convertAction(k, actions)
+	}
+
+	if len(c.edgeTTL) > 0 {
+		if _, ok := c.edgeTTL["mode"]; !ok {
+			c.edgeTTL["mode"] = "respect_origin"
+		}
+		c.cache["edge_ttl"] = c.edgeTTL
+	}
+	if len(c.customKey) > 0 {
+		c.cacheKey["custom_key"] = c.customKey
+	}
+	if len(c.cacheKey) > 0 {
+		c.cache["cache_key"] = c.cacheKey
+	}
+
+	if len(c.cache) > 0 {
+		c.addRule(phaseCacheSettings, "set_cache_settings", c.target.Expression, c.cache)
+	}
+	if len(c.config) > 0 {
+		c.addRule(phaseConfigSettings, "set_config", c.target.Expression, c.config)
+	}
+	if len(c.origin) > 0 {
+		c.addRule(phaseOrigin, "route", c.target.Expression, c.origin)
+	}
+
+	for _, rule := range c.extraRules {
+		c.addRule(phaseCacheSettings, rule.Action, rule.Expression, rule.ActionParameters)
+	}
+}
+
+func (c *pageRuleConverter) convertAction(action string, actions map[string]interface{}) {
+	value := actions[action]
+	on := value == "on"
+
+	switch action {
+	case "cache_level":
+		switch value {
+		case "bypass":
+			c.cache["cache"] = false
+		case "cache_everything":
+			c.cache["cache"] = true
+		case "simplified":
+			c.customKey["query_string"] = map[string]interface{}{"exclude": []string{"*"}}
+		case "basic":
+			c.report(action, `"basic" has no equivalent, exclude the query string from the cache key instead`)
+		}
+		// "aggressive" is the default so there is nothing to convert.
+
+	case "edge_cache_ttl":
+		c.edgeTTL["mode"] = "override_origin"
+		c.edgeTTL["default"] = intValue(actions, action)
+
+	case "cache_ttl_by_status":
+		c.convertCacheTTLByStatus(listValue(value))
+
+	case "browser_cache_ttl":
+		ttl, err := strconv.Atoi(value.(string))
+		if err != nil {
+			c.report(action, fmt.Sprintf("invalid TTL %q", value))
+			return
+		}
+		if ttl == 0 {
+			c.cache["browser_ttl"] = map[string]interface{}{"mode": "respect_origin"}
+		} else {
+			c.cache["browser_ttl"] = map[string]interface{}{"mode": "override_origin", "default": ttl}
+		}
+
+	case "bypass_cache_on_cookie":
+		c.extraRules = append(c.extraRules, rulesetRule{
+			Action:           "set_cache_settings",
+			Expression:       fmt.Sprintf("(%s and http.cookie matches %s)", c.target.Expression, quote(value.(string))),
+			ActionParameters: map[string]interface{}{"cache": false},
+		})
+
+	case "cache_by_device_type":
+		c.customKey["user"] = mergeBlock(c.customKey["user"], map[string]interface{}{"device_type": on})
+
+	case "cache_deception_armor":
+		c.cacheKey["cache_deception_armor"] = on
+
+	case "sort_query_string_for_cache":
+		c.cacheKey["ignore_query_strings_order"] = on
+
+	case "respect_strong_etag":
+		c.cache["respect_strong_etags"] = on
+
+	case "origin_error_page_pass_thru":
+		c.cache["origin_error_page_passthru"] = on
+
+	case "explicit_cache_control":
+		c.cache["origin_cache_control"] = on
+
+	case "cache_key_fields":
+		c.convertCacheKeyFields(blockValue(value))
+
+	case "automatic_https_rewrites", "email_obfuscation", "mirage", "opportunistic_encryption", "rocket_loader":
+		c.config[action] = on
+
+	case "browser_check":
+		c.config["bic"] = on
+
+	case "server_side_exclude":
+		c.config["server_side_excludes"] = on
+
+	case "disable_apps", "disable_railgun":
+		c.config[action] = true
+
+	case "disable_performance":
+		c.config["autominify"] = map[string]interface{}{"css": false, "html": false, "js": false}
+		c.config["mirage"] = false
+		c.config["polish"] = "off"
+		c.config["rocket_loader"] = false
+
+	case "disable_security":
+		c.config["email_obfuscation"] = false
+		c.config["server_side_excludes"] = false
+		c.report(action, "the WAF part has no equivalent, skip the managed rulesets in the http_request_firewall_managed phase instead")
+
+	case "minify":
+		if minify := blockValue(value); minify != nil {
+			c.config["autominify"] = map[string]interface{}{
+				"css":  minify["css"] == "on",
+				"html": minify["html"] == "on",
+				"js":   minify["js"] == "on",
+			}
+		}
+
+	case "polish", "security_level", "ssl":
+		c.config[action] = value
+
+	case "true_client_ip_header":
+		if on {
+			c.addRule(phaseLateTransform, "rewrite", c.target.Expression, map[string]interface{}{
+				"headers": []map[string]interface{}{{
+					"name":       "True-Client-IP",
+					"operation":  "set",
+					"expression": "to_string(ip.src)",
+				}},
+			})
+		}
+
+	case "always_use_https":
+		c.addRule(phaseDynamicRedirect, "redirect", fmt.Sprintf("(%s and not ssl)", c.target.Expression), map[string]interface{}{
+			"from_value": map[string]interface{}{
+				"status_code":           301,
+				"preserve_query_string": true,
+				"target_url": map[string]interface{}{
+					"expression": `concat("https://", http.host, http.request.uri.path)`,
+				},
+			},
+		})
+
+	case "forwarding_url":
+		c.convertForwardingURL(blockValue(value))
+
+	case "host_header_override":
+		c.origin["host_header"] = value
+
+	case "resolve_override":
+		c.origin["origin"] = map[string]interface{}{"host": value}
+
+	case "always_online", "ip_geolocation", "response_buffering":
+		c.report(action, "no equivalent, change the zone setting instead")
+
+	case "waf":
+		c.report(action, "no equivalent, skip the managed rulesets in the http_request_firewall_managed phase instead")
+
+	default:
+		c.report(action, "no equivalent")
+	}
+}
+
+func (c *pageRuleConverter) convertCacheTTLByStatus(items []interface{}) {
+	var statusCodeTTLs []map[string]interface{}
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, codes := range strings.Split(stringValue(m, "codes"), ",") {
+			codes = strings.TrimSpace(codes)
+			statusCodeTTL := map[string]interface{}{"value": intValue(m, "ttl")}
+
+			if i := strings.Index(codes, "-"); i >= 0 {
+				fromCode, fromErr := strconv.Atoi(codes[:i])
+				toCode, toErr := strconv.Atoi(codes[i+1:])
+				if fromErr != nil || toErr != nil {
+					c.report("cache_ttl_by_status", fmt.Sprintf("invalid status code range %q", codes))
+					continue
+				}
+				statusCodeTTL["status_code_range"] = map[string]interface{}{"from": fromCode, "to": toCode}
+			} else {
+				code, err := strconv.Atoi(codes)
+				if err != nil {
+					c.report("cache_ttl_by_status", fmt.Sprintf("invalid status code %q", codes))
+					continue
+				}
+				statusCodeTTL["status_code"] = code
+			}
+
+			statusCodeTTLs = append(statusCodeTTLs, statusCodeTTL)
+		}
+	}
+
+	if len(statusCodeTTLs) > 0 {
+		c.edgeTTL["status_code_ttl"] = statusCodeTTLs
+	}
+}
+
+func (c *pageRuleConverter) convertCacheKeyFields(fields map[string]interface{}) {
+	if fields == nil {
+		return
+	}
+
+	if cookie := blockValue(fields["cookie"]); cookie != nil {
+		c.customKey["cookie"] = nonEmptyBlock(map[string]interface{}{
+			"check_presence": stringListValue(cookie, "check_presence"),
+			"include":        stringListValue(cookie, "include"),
+		})
+	}
+
+	if header := blockValue(fields["header"]); header != nil {
+		c.customKey["header"] = nonEmptyBlock(map[string]interface{}{
+			"check_presence": stringListValue(header, "check_presence"),
+			"include":        stringListValue(header, "include"),
+		})
+		if len(stringListValue(header, "exclude")) > 0 {
+			c.report("cache_key_fields", "headers can't be excluded from the cache key, only the included headers are converted")
+		}
+	}
+
+	if host := blockValue(fields["host"]); host != nil && boolValue(host, "resolved") {
+		c.customKey["host"] = map[string]interface{}{"resolved": true}
+	}
+
+	if queryString := blockValue(fields["query_string"]); queryString != nil {
+		if boolValue(queryString, "ignore") {
+			c.customKey["query_string"] = map[string]interface{}{"exclude": []string{"*"}}
+		} else {
+			c.customKey["query_string"] = nonEmptyBlock(map[string]interface{}{
+				"exclude": stringListValue(queryString, "exclude"),
+				"include": stringListValue(queryString, "include"),
+			})
+		}
+	}
+
+	if user := blockValue(fields["user"]); user != nil {
+		c.customKey["user"] = mergeBlock(c.customKey["user"], map[string]interface{}{
+			"device_type": boolValue(user, "device_type"),
+			"geo":         boolValue(user, "geo"),
+			"lang":        boolValue(user, "lang"),
+		})
+	}
+
+	for k, v := range c.customKey {
+		if m, ok := v.(map[string]interface{}); ok && len(m) == 0 {
+			delete(c.customKey, k)
+		}
+	}
+}
+
+func (c *pageRuleConverter) convertForwardingURL(forwarding map[string]interface{}) {
+	if forwarding == nil {
+		return
+	}
+
+	url := stringValue(forwarding, "url")
+	value, expression, err := forwardingURLTarget(c.target, url)
+	if err != nil {
+		c.report("forwarding_url", err.Error())
+		return
+	}
+
+	targetURL := map[string]interface{}{}
+	if expression != "" {
+		targetURL["expression"] = expression
+	} else {
+		targetURL["value"] = value
+	}
+
+	c.addRule(phaseDynamicRedirect, "redirect", c.target.Expression, map[string]interface{}{
+		"from_value": map[string]interface{}{
+			"status_code":           intValue(forwarding, "status_code"),
+			"preserve_query_string": !strings.Contains(url, "?"),
+			"target_url":            targetURL,
+		},
+	})
+}
+
+func (c *pageRuleConverter) addRule(phase, action, expression string, actionParameters map[string]interface{}) {
+	c.rules[phase] = append(c.rules[phase], rulesetRule{
+		Action:           action,
+		Expression:       expression,
+		Description:      fmt.Sprintf("%s (%s)", c.pageRule.Address, c.pageRule.Target),
+		Enabled:          c.pageRule.Status != "disabled",
+		ActionParameters: actionParameters,
+	})
+}
+
+func (c *pageRuleConverter) report(action, reason string) {
+	c.unsupported = append(c.unsupported, unsupportedAction{
+		Address: c.pageRule.Address,
+		Action:  action,
+		Reason:  reason,
+	})
+}
+
+// isZeroValue reports whether the action is unset. The state holds every
+// action of the schema with its zero value when it isn't set.
+func isZeroValue(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case bool:
+		return !value
+	case float64:
+		return value == 0
+	case []interface{}:
+		return len(value) == 0
+	}
+	return false
+}
+
+// nonEmptyBlock drops the empty lists of the block.
+func nonEmptyBlock(m map[string]interface{}) map[string]interface{} {
+	for k, v := range m {
+		if list, ok := v.([]string); ok && len(list) == 0 {
+			delete(m, k)
+		}
+	}
+	return m
+}
+
+func mergeBlock(existing interface{}, m map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	if e, ok := existing.(map[string]interface{}); ok {
+		for k, v := range e {
+			merged[k] = v
+		}
+	}
+	for k, v := range m {
+		if b, ok := v.(bool); ok && !b {
+			if _, set := merged[k]; set {
+				continue
+			}
+		}
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testShowJSON = `{
+  "format_version": "0.2",
+  "values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "cloudflare_page_rule.low",
+          "mode": "managed",
+          "type": "cloudflare_page_rule",
+          "values": {
+            "zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+            "target": "example.com/*",
+            "priority": 1,
+            "status": "active",
+            "actions": [{"always_use_https": true, "minify": [], "edge_cache_ttl": 0}]
+          }
+        }
+      ],
+      "child_modules": [
+        {
+          "resources": [
+            {
+              "address": "module.site.cloudflare_page_rule.high",
+              "mode": "managed",
+              "type": "cloudflare_page_rule",
+              "values": {
+                "zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+                "target": "example.com/old/*",
+                "priority": 2,
+                "status": "active",
+                "actions": [{"forwarding_url": [{"url": "https://example.com/new/$1", "status_code": 302}], "cache_on_cookie": "session"}]
+              }
+            }
+          ]
+        }
+      ]
+    }
+  }
+}`
+
+func TestReadPageRules(t *testing.T) {
+	rules, err := readPageRules([]byte(testShowJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 page rules but got %d", len(rules))
+	}
+
+	if rules[0].Address != "cloudflare_page_rule.low" || rules[1].Address != "module.site.cloudflare_page_rule.high" {
+		t.Errorf("unexpected order: %s, %s", rules[0].Address, rules[1].Address)
+	}
+
+	if rules[1].Priority != 2 || rules[1].Target != "example.com/old/*" {
+		t.Errorf("unexpected page rule: %+v", rules[1])
+	}
+}
+
+// supportAllPhases converts the actions of every phase for the duration of
+// the test.
+func supportAllPhases(t *testing.T) {
+	supported := supportedPhases
+	t.Cleanup(func() { supportedPhases = supported })
+
+	supportedPhases = map[string]bool{}
+	for _, phase := range phases {
+		supportedPhases[phase] = true
+	}
+}
+
+func TestConvertPageRulesUnsupportedPhases(t *testing.T) {
+	c := convertPageRules([]pageRule{{
+		Address: "cloudflare_page_rule.example",
+		ZoneID:  "0da42c8d2132a9ddaf714f9e7c920711",
+		Target:  "example.com/*",
+		Actions: map[string]interface{}{
+			"cache_level":           "bypass",
+			"true_client_ip_header": "on",
+		},
+	}})
+
+	zoneRules := c.Rules["0da42c8d2132a9ddaf714f9e7c920711"]
+	if len(zoneRules[phaseCacheSettings]) != 0 {
+		t.Errorf("expected no rules for the unsupported cache phase but got %+v", zoneRules[phaseCacheSettings])
+	}
+	if len(zoneRules[phaseLateTransform]) != 1 {
+		t.Errorf("expected a transform rule but got %+v", zoneRules[phaseLateTransform])
+	}
+
+	if len(c.Unsupported) != 1 || c.Unsupported[0].Action != "cache_level" || !strings.Contains(c.Unsupported[0].Reason, phaseCacheSettings) {
+		t.Errorf("expected cache_level to be reported but got %v", c.Unsupported)
+	}
+}
+
+func TestConvertPageRulesRedirects(t *testing.T) {
+	supportAllPhases(t)
+
+	rules, err := readPageRules([]byte(testShowJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := convertPageRules(rules)
+	redirects := c.Rules["0da42c8d2132a9ddaf714f9e7c920711"][phaseDynamicRedirect]
+
+	if len(redirects) != 2 {
+		t.Fatalf("expected 2 redirect rules but got %d", len(redirects))
+	}
+
+	// The first matching redirect wins so the highest priority comes first.
+	if !strings.HasPrefix(redirects[0].Description, "module.site.cloudflare_page_rule.high") {
+		t.Errorf("expected the highest priority page rule first but got %q", redirects[0].Description)
+	}
+
+	expected := map[string]interface{}{
+		"from_value": map[string]interface{}{
+			"status_code":           302,
+			"preserve_query_string": true,
+			"target_url": map[string]interface{}{
+				"expression": `concat("https://example.com/new/", substring(http.request.uri.path, 5))`,
+			},
+		},
+	}
+	if !reflect.DeepEqual(redirects[0].ActionParameters, expected) {
+		t.Errorf("unexpected action parameters: %#v", redirects[0].ActionParameters)
+	}
+
+	if redirects[1].Expression != `(http.host eq "example.com" and not ssl)` {
+		t.Errorf("unexpected expression: %s", redirects[1].Expression)
+	}
+
+	if len(c.Unsupported) != 1 || c.Unsupported[0].Action != "cache_on_cookie" {
+		t.Errorf("expected cache_on_cookie to be reported but got %v", c.Unsupported)
+	}
+}
+
+func TestConvertPageRuleSettings(t *testing.T) {
+	supportAllPhases(t)
+
+	c := convertPageRules([]pageRule{{
+		Address: "cloudflare_page_rule.example",
+		ZoneID:  "0da42c8d2132a9ddaf714f9e7c920711",
+		Target:  "example.com/*",
+		Status:  "disabled",
+		Actions: map[string]interface{}{
+			"cache_level":                 "bypass",
+			"sort_query_string_for_cache": "on",
+			"browser_check":               "off",
+			"security_level":              "high",
+			"disable_railgun":             true,
+			"host_header_override":        "origin.example.com",
+			"response_buffering":          "on",
+		},
+	}})
+
+	zoneRules := c.Rules["0da42c8d2132a9ddaf714f9e7c920711"]
+
+	cache := zoneRules[phaseCacheSettings]
+	if len(cache) != 1 || cache[0].Enabled {
+		t.Fatalf("expected a disabled cache rule but got %+v", cache)
+	}
+	expectedCache := map[string]interface{}{
+		"cache":     false,
+		"cache_key": map[string]interface{}{"ignore_query_strings_order": true},
+	}
+	if !reflect.DeepEqual(cache[0].ActionParameters, expectedCache) {
+		t.Errorf("unexpected cache settings: %#v", cache[0].ActionParameters)
+	}
+
+	config := zoneRules[phaseConfigSettings]
+	expectedConfig := map[string]interface{}{
+		"bic":             false,
+		"security_level":  "high",
+		"disable_railgun": true,
+	}
+	if len(config) != 1 || !reflect.DeepEqual(config[0].ActionParameters, expectedConfig) {
+		t.Errorf("unexpected config settings: %+v", config)
+	}
+
+	origin := zoneRules[phaseOrigin]
+	if len(origin) != 1 || origin[0].ActionParameters["host_header"] != "origin.example.com" {
+		t.Errorf("unexpected origin rules: %+v", origin)
+	}
+
+	if len(c.Unsupported) != 1 || c.Unsupported[0].Action != "response_buffering" {
+		t.Errorf("expected response_buffering to be reported but got %v", c.Unsupported)
+	}
+}
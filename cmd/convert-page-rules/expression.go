@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// pageRuleTarget is the target URL pattern of a page rule translated to a
+// rule expression.
+type pageRuleTarget struct {
+	Expression string
+
+	// Wildcards is the number of wildcards in the target, referenced as $1,
+	// $2 and so on by forwarding URLs.
+	Wildcards int
+
+	// PathPrefix is the path before the last wildcard when the target ends
+	// with a wildcard in the path, so the wildcard matches the rest of it.
+	PathPrefix       string
+	TrailingWildcard bool
+}
+
+// parseTarget translates the target of a page rule, e.g.
+// `*.example.com/images/*`, to an expression. As with page rules the query
+// string only has to match when the target has one.
+func parseTarget(target string) pageRuleTarget {
+	result := pageRuleTarget{Wildcards: strings.Count(target, "*")}
+	rest := target
+
+	var conditions []string
+
+	if i := strings.Index(rest, "://"); i >= 0 {
+		switch strings.ToLower(rest[:i]) {
+		case "http":
+			conditions = append(conditions, "not ssl")
+		case "https":
+			conditions = append(conditions, "ssl")
+		}
+		rest = rest[i+3:]
+	}
+
+	host, path := rest, "/"
+	if i := strings.Index(rest, "/"); i >= 0 {
+		host, path = rest[:i], rest[i:]
+	}
+
+	var query string
+	hasQuery := false
+	if i := strings.Index(path, "?"); i >= 0 {
+		path, query, hasQuery = path[:i], path[i+1:], true
+	}
+
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		if port, err := strconv.Atoi(host[i+1:]); err == nil {
+			conditions = append(conditions, fmt.Sprintf("cf.edge.server_port eq %d", port))
+			host = host[:i]
+		}
+	}
+
+	conditions = appendCondition(conditions, wildcardCondition("http.host", strings.ToLower(host)))
+	// Paths always start with a slash so it can be left out of the pattern
+	// when followed by a wildcard, e.g. `/*.jpg` becomes an ends_with.
+	pathPattern := path
+	if strings.HasPrefix(pathPattern, "/*") {
+		pathPattern = pathPattern[1:]
+	}
+	conditions = appendCondition(conditions, wildcardCondition("http.request.uri.path", pathPattern))
+	if hasQuery {
+		conditions = appendCondition(conditions, wildcardCondition("http.request.uri.query", query))
+	}
+
+	if !hasQuery && strings.HasSuffix(path, "*") {
+		result.TrailingWildcard = true
+		result.PathPrefix = strings.TrimSuffix(path, "*")
+	}
+
+	if len(conditions) == 0 {
+		result.Expression = "true"
+	} else if len(conditions) == 1 {
+		result.Expression = conditions[0]
+	} else {
+		result.Expression = "(" + strings.Join(conditions, " and ") + ")"
+	}
+
+	return result
+}
+
+func appendCondition(conditions []string, condition string) []string {
+	if condition == "" {
+		return conditions
+	}
+	return append(conditions, condition)
+}
+
+// wildcardCondition returns the condition matching the field against the
+// pattern where `*` matches any characters. The functions are preferred to
+// `matches` as regular expressions need a Business or Enterprise plan.
+func wildcardCondition(field, pattern string) string {
+	parts := strings.Split(pattern, "*")
+
+	switch {
+	case len(parts) == 1:
+		return fmt.Sprintf("%s eq %s", field, quote(pattern))
+	case strings.Trim(pattern, "*") == "":
+		return ""
+	case len(parts) == 2 && parts[1] == "":
+		return fmt.Sprintf("starts_with(%s, %s)", field, quote(parts[0]))
+	case len(parts) == 2 && parts[0] == "":
+		return fmt.Sprintf("ends_with(%s, %s)", field, quote(parts[1]))
+	}
+
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	return fmt.Sprintf("%s matches %s", field, quote("^"+strings.Join(parts, ".*")+"$"))
+}
+
+var forwardingURLReference = regexp.MustCompile(`\$(\d+)`)
+
+// forwardingURLTarget returns the target URL of the redirect of a forwarding
+// URL. References to the wildcards of the target are only supported for the
+// common case of a trailing path wildcard used at the end of the URL, e.g.
+// `https://www.example.com/$1` for `example.com/*`.
+func forwardingURLTarget(target pageRuleTarget, url string) (value string, expression string, err error) {
+	references := forwardingURLReference.FindAllStringSubmatchIndex(url, -1)
+	if len(references) == 0 {
+		return url, "", nil
+	}
+
+	last := references[len(references)-1]
+	n, _ := strconv.Atoi(url[last[2]:last[3]])
+
+	if len(references) > 1 || last[1] != len(url) || !target.TrailingWildcard || n != target.Wildcards {
+		return "", "", fmt.Errorf("forwarding URL %q references a wildcard that can't be translated", url)
+	}
+
+	return "", fmt.Sprintf("concat(%s, substring(http.request.uri.path, %d))", quote(url[:last[0]]), len(target.PathPrefix)), nil
+}
+
+// quote returns the string literal of the rules language for the value.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
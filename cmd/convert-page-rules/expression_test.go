@@ -0,0 +1,59 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestParseTarget(t *testing.T) {
+	targets := []struct {
+		target, expected string
+	}{
+		{"example.com/*", `http.host eq "example.com"`},
+		{"example.com", `(http.host eq "example.com" and http.request.uri.path eq "/")`},
+		{"*.example.com/images/*", `(ends_with(http.host, ".example.com") and starts_with(http.request.uri.path, "/images/"))`},
+		{"https://example.com/*.jpg", `(ssl and http.host eq "example.com" and ends_with(http.request.uri.path, ".jpg"))`},
+		{"http://example.com:8080/a/*/b", `(not ssl and cf.edge.server_port eq 8080 and http.host eq "example.com" and http.request.uri.path matches "^/a/.*/b$")`},
+		{"example.com/search?q=*", `(http.host eq "example.com" and http.request.uri.path eq "/search" and starts_with(http.request.uri.query, "q="))`},
+		{"Example.com/a.b/*", `(http.host eq "example.com" and starts_with(http.request.uri.path, "/a.b/"))`},
+		{"*/*", "true"},
+	}
+
+	for _, tc := range targets {
+		if expression := parseTarget(tc.target).Expression; expression != tc.expected {
+			t.Errorf("expected %s for %q but got %s", tc.expected, tc.target, expression)
+		}
+	}
+}
+
+func TestWildcardConditionEscapesRegularExpressions(t *testing.T) {
+	expected := `http.request.uri.path matches "^/a\\.b/.*/c\\?$"`
+	if condition := wildcardCondition("http.request.uri.path", "/a.b/*/c?"); condition != expected {
+		t.Errorf("expected %s but got %s", expected, condition)
+	}
+}
+
+func TestForwardingURLTarget(t *testing.T) {
+	urls := []struct {
+		target, url, value, expression string
+		err                            bool
+	}{
+		{"example.com/*", "https://www.example.com/", "https://www.example.com/", "", false},
+		{"example.com/*", "https://www.example.com/$1", "", `concat("https://www.example.com/", substring(http.request.uri.path, 1))`, false},
+		{"*.example.com/blog/*", "https://blog.example.com/$2", "", `concat("https://blog.example.com/", substring(http.request.uri.path, 6))`, false},
+		{"*.example.com/*", "https://$1.example.net/", "", "", true},
+		{"example.com/*", "https://www.example.com/$1/index.html", "", "", true},
+		{"example.com/*?a=*", "https://www.example.com/$2", "", "", true},
+	}
+
+	for _, tc := range urls {
+		value, expression, err := forwardingURLTarget(parseTarget(tc.target), tc.url)
+		if (err != nil) != tc.err {
+			t.Errorf("unexpected error for %q: %v", tc.url, err)
+			continue
+		}
+
+		if value != tc.value || expression != tc.expression {
+			t.Errorf("expected %q, %q for %q but got %q, %q", tc.value, tc.expression, tc.url, value, expression)
+		}
+	}
+}
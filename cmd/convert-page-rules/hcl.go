@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// phaseNames are the short names of the phases used to name the rulesets.
+var phaseNames = map[string]string{
+	phaseCacheSettings:   "cache_settings",
+	phaseConfigSettings:  "config_settings",
+	phaseDynamicRedirect: "dynamic_redirect",
+	phaseLateTransform:   "late_transform",
+	phaseOrigin:          "origin",
+}
+
+// writeRulesets returns the configuration of a `cloudflare_ruleset` for each
+// phase of each zone with rules.
+func writeRulesets(c *conversion) []byte {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	zoneIDs := make([]string, 0, len(c.Rules))
+	for zoneID := range c.Rules {
+		zoneIDs = append(zoneIDs, zoneID)
+	}
+	sort.Strings(zoneIDs)
+
+	first := true
+	for _, zoneID := range zoneIDs {
+		for _, phase := range phases {
+			rules := c.Rules[zoneID][phase]
+			if len(rules) == 0 {
+				continue
+			}
+
+			if !first {
+				body.AppendNewline()
+			}
+			first = false
+
+			name := "page_rules_" + phaseNames[phase]
+			if len(zoneIDs) > 1 {
+				name += "_" + zoneID
+			}
+
+			block := body.AppendNewBlock("resource", []string{"cloudflare_ruleset", name})
+			writeRuleset(block.Body(), zoneID, phase, rules)
+		}
+	}
+
+	return f.Bytes()
+}
+
+func writeRuleset(body *hclwrite.Body, zoneID, phase string, rules []rulesetRule) {
+	body.SetAttributeValue("zone_id", cty.StringVal(zoneID))
+	body.SetAttributeValue("name", cty.StringVal(fmt.Sprintf("Page rules converted to the %s phase", phase)))
+	body.SetAttributeValue("description", cty.StringVal(fmt.Sprintf("Converted from the page rules of zone %s", zoneID)))
+	body.SetAttributeValue("kind", cty.StringVal("zone"))
+	body.SetAttributeValue("phase", cty.StringVal(phase))
+
+	for _, rule := range rules {
+		ruleBody := body.AppendNewBlock("rules", nil).Body()
+		ruleBody.SetAttributeValue("action", cty.StringVal(rule.Action))
+		ruleBody.SetAttributeValue("expression", cty.StringVal(rule.Expression))
+		ruleBody.SetAttributeValue("description", cty.StringVal(rule.Description))
+		ruleBody.SetAttributeValue("enabled", cty.BoolVal(rule.Enabled))
+
+		if len(rule.ActionParameters) > 0 {
+			writeBlock(ruleBody.AppendNewBlock("action_parameters", nil).Body(), rule.ActionParameters)
+		}
+	}
+}
+
+// writeBlock writes the values as attributes followed by the maps as nested
+// blocks, both sorted by name.
+func writeBlock(body *hclwrite.Body, values map[string]interface{}) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var blocks []string
+	for _, k := range keys {
+		switch values[k].(type) {
+		case map[string]interface{}, []map[string]interface{}:
+			blocks = append(blocks, k)
+		default:
+			body.SetAttributeValue(k, ctyValue(values[k]))
+		}
+	}
+
+	for _, k := range blocks {
+		switch v := values[k].(type) {
+		case map[string]interface{}:
+			writeBlock(body.AppendNewBlock(k, nil).Body(), v)
+		case []map[string]interface{}:
+			for _, item := range v {
+				writeBlock(body.AppendNewBlock(k, nil).Body(), item)
+			}
+		}
+	}
+}
+
+func ctyValue(v interface{}) cty.Value {
+	switch value := v.(type) {
+	case bool:
+		return cty.BoolVal(value)
+	case int:
+		return cty.NumberIntVal(int64(value))
+	case []string:
+		list := make([]cty.Value, 0, len(value))
+		for _, s := range value {
+			list = append(list, cty.StringVal(s))
+		}
+		if len(list) == 0 {
+			return cty.ListValEmpty(cty.String)
+		}
+		return cty.ListVal(list)
+	default:
+		return cty.StringVal(fmt.Sprint(value))
+	}
+}
+
+// writeReport returns the actions which weren't converted, one per line.
+func writeReport(c *conversion) string {
+	var b strings.Builder
+	for _, u := range c.Unsupported {
+		fmt.Fprintln(&b, u.String())
+	}
+	return b.String()
+}
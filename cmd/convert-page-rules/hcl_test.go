@@ -0,0 +1,165 @@
+package main
+
+import (
+	"testing"
+
+	provider "github.com/cloudflare/terraform-provider-cloudflare/cloudflare"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// testPageRules use every action which is converted once its phase is
+// supported.
+var testPageRules = []pageRule{
+	{
+		Address:  "cloudflare_page_rule.cache",
+		ZoneID:   "0da42c8d2132a9ddaf714f9e7c920711",
+		Target:   "example.com/*",
+		Priority: 1,
+		Actions: map[string]interface{}{
+			"cache_level":                 "cache_everything",
+			"edge_cache_ttl":              float64(7200),
+			"browser_cache_ttl":           "1800",
+			"bypass_cache_on_cookie":      "session=.*",
+			"cache_by_device_type":        "on",
+			"cache_deception_armor":       "on",
+			"sort_query_string_for_cache": "on",
+			"respect_strong_etag":         "on",
+			"origin_error_page_pass_thru": "on",
+			"explicit_cache_control":      "on",
+			"cache_ttl_by_status": []interface{}{
+				map[string]interface{}{"codes": "200", "ttl": float64(3600)},
+				map[string]interface{}{"codes": "500-599", "ttl": float64(0)},
+			},
+			"cache_key_fields": []interface{}{map[string]interface{}{
+				"cookie":       []interface{}{map[string]interface{}{"check_presence": []interface{}{"session"}, "include": []interface{}{"lang"}}},
+				"header":       []interface{}{map[string]interface{}{"include": []interface{}{"accept-language"}}},
+				"host":         []interface{}{map[string]interface{}{"resolved": true}},
+				"query_string": []interface{}{map[string]interface{}{"include": []interface{}{"page"}}},
+				"user":         []interface{}{map[string]interface{}{"geo": true, "lang": true}},
+			}},
+			"automatic_https_rewrites": "on",
+			"email_obfuscation":        "on",
+			"mirage":                   "off",
+			"opportunistic_encryption": "on",
+			"rocket_loader":            "off",
+			"browser_check":            "on",
+			"server_side_exclude":      "on",
+			"disable_apps":             true,
+			"disable_railgun":          true,
+			"minify":                   []interface{}{map[string]interface{}{"css": "on", "html": "off", "js": "on"}},
+			"polish":                   "lossless",
+			"security_level":           "high",
+			"ssl":                      "strict",
+			"true_client_ip_header":    "on",
+			"always_use_https":         true,
+			"host_header_override":     "origin.example.com",
+			"resolve_override":         "backend.example.com",
+		},
+	},
+	{
+		Address:  "cloudflare_page_rule.static",
+		ZoneID:   "0da42c8d2132a9ddaf714f9e7c920711",
+		Target:   "*.example.com/static/*",
+		Priority: 2,
+		Actions: map[string]interface{}{
+			"cache_level":         "simplified",
+			"disable_performance": true,
+		},
+	},
+	{
+		Address:  "cloudflare_page_rule.redirect",
+		ZoneID:   "0da42c8d2132a9ddaf714f9e7c920711",
+		Target:   "example.com/old/*",
+		Priority: 3,
+		Actions: map[string]interface{}{
+			"forwarding_url": []interface{}{map[string]interface{}{"url": "https://example.com/new/$1", "status_code": float64(301)}},
+		},
+	},
+}
+
+func TestWriteRulesetsProviderSchema(t *testing.T) {
+	c := convertPageRules(testPageRules)
+	for _, u := range c.Unsupported {
+		if phase := actionPhases[u.Action]; supportedPhases[phase] {
+			t.Errorf("expected %s to be converted but got %s", u.Action, u)
+		}
+	}
+
+	config := writeRulesets(c)
+	file, diags := hclsyntax.ParseConfig(config, "rulesets.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("invalid configuration: %s\n%s", diags, config)
+	}
+
+	rulesetSchema := provider.Provider().ResourcesMap["cloudflare_ruleset"]
+
+	written := map[string]bool{}
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		if block.Type != "resource" || block.Labels[0] != "cloudflare_ruleset" {
+			t.Errorf("unexpected block %s %v", block.Type, block.Labels)
+			continue
+		}
+
+		raw := hclBodyToRaw(t, block.Body)
+		written[raw["phase"].(string)] = true
+
+		for _, d := range rulesetSchema.Validate(terraform.NewResourceConfigRaw(raw)) {
+			t.Errorf("%s: %s: %s", block.Labels[1], d.Summary, d.Detail)
+		}
+	}
+
+	for _, phase := range phases {
+		if supportedPhases[phase] && !written[phase] {
+			t.Errorf("expected a ruleset for the %s phase", phase)
+		}
+	}
+
+	if t.Failed() {
+		t.Logf("configuration:\n%s", config)
+	}
+}
+
+// hclBodyToRaw returns the attributes and blocks of the body in the shape of
+// a raw resource configuration.
+func hclBodyToRaw(t *testing.T, body *hclsyntax.Body) map[string]interface{} {
+	raw := map[string]interface{}{}
+
+	for name, attribute := range body.Attributes {
+		value, diags := attribute.Expr.Value(nil)
+		if diags.HasErrors() {
+			t.Fatalf("invalid value of %s: %s", name, diags)
+		}
+		raw[name] = ctyToRaw(value)
+	}
+
+	for _, block := range body.Blocks {
+		blocks, _ := raw[block.Type].([]interface{})
+		raw[block.Type] = append(blocks, hclBodyToRaw(t, block.Body))
+	}
+
+	return raw
+}
+
+func ctyToRaw(value cty.Value) interface{} {
+	switch {
+	case value.Type() == cty.Bool:
+		return value.True()
+	case value.Type() == cty.Number:
+		i, _ := value.AsBigFloat().Int64()
+		return int(i)
+	case value.Type() == cty.String:
+		return value.AsString()
+	case value.CanIterateElements():
+		var list []interface{}
+		for it := value.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			list = append(list, ctyToRaw(v))
+		}
+		return list
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+// Command convert-page-rules converts the `cloudflare_page_rule` resources of
+// a Terraform state to `cloudflare_ruleset` resources for the cache, config,
+// redirect, transform and origin phases.
+//
+// The state is either a state file or the output of `terraform show -json`.
+// Actions without an equivalent in the rulesets engine are reported rather
+// than dropped.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+)
+
+func main() {
+	var statePath, output, report string
+	var strict bool
+
+	flag.StringVar(&statePath, "state", "terraform.tfstate", "state file or `terraform show -json` output to read the page rules from, - for stdin")
+	flag.StringVar(&output, "output", "-", "file to write the rulesets configuration to, - for stdout")
+	flag.StringVar(&report, "report", "", "file to write the actions which weren't converted to, defaults to stderr")
+	flag.BoolVar(&strict, "strict", false, "exit with status 2 when an action wasn't converted")
+	flag.Parse()
+
+	unsupported, err := run(statePath, output, report)
+	if err != nil {
+		log.Fatalf("[ERROR] %s", err)
+	}
+
+	if strict && unsupported > 0 {
+		os.Exit(2)
+	}
+}
+
+func run(statePath, output, report string) (int, error) {
+	var content []byte
+	var err error
+	if statePath == "-" {
+		content, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		content, err = ioutil.ReadFile(statePath)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error reading state: %w", err)
+	}
+
+	pageRules, err := readPageRules(content)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Printf("[INFO] Converting %d page rules", len(pageRules))
+
+	c := convertPageRules(pageRules)
+
+	if err := writeOutput(output, writeRulesets(c), os.Stdout); err != nil {
+		return 0, fmt.Errorf("error writing rulesets: %w", err)
+	}
+
+	if len(c.Unsupported) > 0 {
+		log.Printf("[WARN] %d page rule actions weren't converted", len(c.Unsupported))
+	}
+
+	if err := writeOutput(report, []byte(writeReport(c)), os.Stderr); err != nil {
+		return 0, fmt.Errorf("error writing report: %w", err)
+	}
+
+	return len(c.Unsupported), nil
+}
+
+// writeOutput writes the content to the file, or to w when the path is empty
+// or -.
+func writeOutput(path string, content []byte, w io.Writer) error {
+	if path == "" || path == "-" {
+		_, err := w.Write(content)
+		return err
+	}
+
+	return ioutil.WriteFile(path, content, 0644)
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+const pageRuleResourceType = "cloudflare_page_rule"
+
+// pageRule is a page rule read from the state. The actions keep the shape of
+// the `actions` block of the resource.
+type pageRule struct {
+	Address  string
+	ZoneID   string
+	Target   string
+	Priority int
+	Status   string
+	Actions  map[string]interface{}
+}
+
+// state covers both a state file and the output of `terraform show -json`.
+type state struct {
+	// Resources is set in state files.
+	Resources []stateResource `json:"resources"`
+
+	// Values is set in the output of `terraform show -json`.
+	Values *struct {
+		RootModule stateModule `json:"root_module"`
+	} `json:"values"`
+}
+
+type stateResource struct {
+	Module    string `json:"module"`
+	Mode      string `json:"mode"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Instances []struct {
+		IndexKey   interface{}            `json:"index_key"`
+		Attributes map[string]interface{} `json:"attributes"`
+	} `json:"instances"`
+}
+
+type stateModule struct {
+	Resources []struct {
+		Address string                 `json:"address"`
+		Mode    string                 `json:"mode"`
+		Type    string                 `json:"type"`
+		Values  map[string]interface{} `json:"values"`
+	} `json:"resources"`
+	ChildModules []stateModule `json:"child_modules"`
+}
+
+// readPageRules returns the page rules of the state, ordered by zone and
+// priority.
+func readPageRules(content []byte) ([]pageRule, error) {
+	var s state
+	if err := json.Unmarshal(content, &s); err != nil {
+		return nil, fmt.Errorf("error parsing state: %w", err)
+	}
+
+	var rules []pageRule
+	if s.Values != nil {
+		rules = s.Values.RootModule.pageRules()
+	}
+
+	for _, r := range s.Resources {
+		if r.Mode != "managed" || r.Type != pageRuleResourceType {
+			continue
+		}
+
+		address := fmt.Sprintf("%s.%s", r.Type, r.Name)
+		if r.Module != "" {
+			address = r.Module + "." + address
+		}
+
+		for _, instance := range r.Instances {
+			instanceAddress := address
+			switch key := instance.IndexKey.(type) {
+			case string:
+				instanceAddress += fmt.Sprintf("[%q]", key)
+			case float64:
+				instanceAddress += fmt.Sprintf("[%d]", int(key))
+			}
+
+			rules = append(rules, newPageRule(instanceAddress, instance.Attributes))
+		}
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].ZoneID != rules[j].ZoneID {
+			return rules[i].ZoneID < rules[j].ZoneID
+		}
+		return rules[i].Priority < rules[j].Priority
+	})
+
+	return rules, nil
+}
+
+func (m stateModule) pageRules() []pageRule {
+	var rules []pageRule
+	for _, r := range m.Resources {
+		if r.Mode == "managed" && r.Type == pageRuleResourceType {
+			rules = append(rules, newPageRule(r.Address, r.Values))
+		}
+	}
+
+	for _, child := range m.ChildModules {
+		rules = append(rules, child.pageRules()...)
+	}
+
+	return rules
+}
+
+func newPageRule(address string, attributes map[string]interface{}) pageRule {
+	rule := pageRule{
+		Address: address,
+		Actions: map[string]interface{}{},
+	}
+
+	rule.ZoneID, _ = attributes["zone_id"].(string)
+	rule.Target, _ = attributes["target"].(string)
+	rule.Status, _ = attributes["status"].(string)
+
+	if priority, ok := attributes["priority"].(float64); ok {
+		rule.Priority = int(priority)
+	}
+
+	if actions := listValue(attributes["actions"]); len(actions) > 0 {
+		if m, ok := actions[0].(map[string]interface{}); ok {
+			rule.Actions = m
+		}
+	}
+
+	return rule
+}
+
+// listValue returns the list of a nested block, which is null when the block
+// isn't set.
+func listValue(v interface{}) []interface{} {
+	list, _ := v.([]interface{})
+	return list
+}
+
+// blockValue returns the attributes of a block with at most one item.
+func blockValue(v interface{}) map[string]interface{} {
+	if list := listValue(v); len(list) > 0 {
+		m, _ := list[0].(map[string]interface{})
+		return m
+	}
+	return nil
+}
+
+func stringValue(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+func boolValue(m map[string]interface{}, key string) bool {
+	b, _ := m[key].(bool)
+	return b
+}
+
+func intValue(m map[string]interface{}, key string) int {
+	f, _ := m[key].(float64)
+	return int(f)
+}
+
+func stringListValue(m map[string]interface{}, key string) []string {
+	var result []string
+	for _, item := range listValue(m[key]) {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
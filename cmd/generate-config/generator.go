@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// generatedResource is an imported resource waiting to be written out.
+type generatedResource struct {
+	resourceType string
+	name         string
+	importID     string
+	data         *schema.ResourceData
+}
+
+func (r generatedResource) address() string {
+	return fmt.Sprintf("%s.%s", r.resourceType, r.name)
+}
+
+type generator struct {
+	provider  *schema.Provider
+	client    *cloudflare.API
+	accountID string
+	zones     []cloudflare.Zone
+	types     []string
+
+	resources []generatedResource
+	names     map[string]bool
+}
+
+// run imports every resource of the selected types using the importer and
+// read function of the resource, exactly like `terraform import` does.
+func (g *generator) run(ctx context.Context) {
+	g.names = make(map[string]bool)
+
+	for _, source := range resourceSources {
+		if len(g.types) > 0 && !contains(g.types, source.resourceType) {
+			continue
+		}
+
+		var found []importable
+		if source.listZone != nil {
+			for _, zone := range g.zones {
+				items, err := source.listZone(ctx, g.client, zone)
+				if err != nil {
+					log.Printf("[WARN] Skipping %s of zone %s: %s", source.resourceType, zone.Name, err)
+					continue
+				}
+				found = append(found, items...)
+			}
+		}
+
+		if source.listAccount != nil {
+			if g.accountID == "" {
+				log.Printf("[INFO] Skipping account level %s as no account ID is set", source.resourceType)
+			} else {
+				items, err := source.listAccount(ctx, g.client, g.accountID)
+				if err != nil {
+					log.Printf("[WARN] Skipping %s of account %s: %s", source.resourceType, g.accountID, err)
+				}
+				found = append(found, items...)
+			}
+		}
+
+		for _, item := range found {
+			d, err := g.importResource(ctx, source.resourceType, item.ImportID)
+			if err != nil {
+				log.Printf("[WARN] Skipping %s %s: %s", source.resourceType, item.ImportID, err)
+				continue
+			}
+
+			g.resources = append(g.resources, generatedResource{
+				resourceType: source.resourceType,
+				name:         g.uniqueName(source.resourceType, item.NameHint),
+				importID:     item.ImportID,
+				data:         d,
+			})
+		}
+	}
+}
+
+func (g *generator) importResource(ctx context.Context, resourceType, importID string) (*schema.ResourceData, error) {
+	resource, ok := g.provider.ResourcesMap[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type")
+	}
+
+	d := resource.Data(&terraform.InstanceState{ID: importID})
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		imported, err := resource.Importer.StateContext(ctx, d, g.provider.Meta())
+		if err != nil {
+			return nil, err
+		}
+		if len(imported) == 0 {
+			return nil, fmt.Errorf("nothing imported")
+		}
+		d = imported[0]
+	}
+
+	if diags := resource.ReadContext(ctx, d, g.provider.Meta()); diags.HasError() {
+		for _, diagnostic := range diags {
+			return nil, fmt.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("not found")
+	}
+
+	return d, nil
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// uniqueName turns the hint into a valid resource name that isn't used yet by
+// another resource of the same type.
+func (g *generator) uniqueName(resourceType, hint string) string {
+	name := strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(hint), "_"), "_")
+	if name == "" {
+		name = strings.TrimPrefix(resourceType, "cloudflare_")
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+
+	unique := name
+	for i := 2; g.names[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	g.names[resourceType+"."+unique] = true
+
+	return unique
+}
+
+// write writes a file with the configuration of each resource type, the
+// variables for the sensitive values and a script importing all resources.
+func (g *generator) write(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	r := &renderer{references: make(map[string]string)}
+	for _, resource := range g.resources {
+		r.references[resource.data.Id()] = resource.address()
+	}
+
+	files := make(map[string]*hclwrite.File)
+	var order []string
+	for _, resource := range g.resources {
+		f, ok := files[resource.resourceType]
+		if !ok {
+			f = hclwrite.NewEmptyFile()
+			files[resource.resourceType] = f
+			order = append(order, resource.resourceType)
+		} else {
+			f.Body().AppendNewline()
+		}
+
+		s := g.provider.ResourcesMap[resource.resourceType].Schema
+		r.writeResource(f.Body(), resource.resourceType, resource.name, s, resource.data)
+	}
+
+	for _, resourceType := range order {
+		if err := writeFile(filepath.Join(dir, resourceType+".tf"), hclwrite.Format(files[resourceType].Bytes()), 0644); err != nil {
+			return err
+		}
+	}
+
+	if len(r.variables) > 0 {
+		f := hclwrite.NewEmptyFile()
+		sort.Strings(r.variables)
+		for i, variable := range r.variables {
+			if i > 0 {
+				f.Body().AppendNewline()
+			}
+			block := f.Body().AppendNewBlock("variable", []string{variable})
+			block.Body().SetAttributeRaw("type", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte("string")}})
+			block.Body().SetAttributeValue("sensitive", cty.True)
+		}
+		if err := writeFile(filepath.Join(dir, "variables.tf"), hclwrite.Format(f.Bytes()), 0644); err != nil {
+			return err
+		}
+	}
+
+	var script strings.Builder
+	script.WriteString("#!/bin/sh\nset -e\n\n")
+	for _, resource := range g.resources {
+		script.WriteString(fmt.Sprintf("terraform import '%s' '%s'\n", resource.address(), resource.importID))
+	}
+
+	return writeFile(filepath.Join(dir, "imports.sh"), []byte(script.String()), 0755)
+}
+
+func writeFile(path string, content []byte, perm os.FileMode) error {
+	log.Printf("[INFO] Writing %s", path)
+	return ioutil.WriteFile(path, content, perm)
+}
+
+func contains(slice []string, item string) bool {
+	for _, s := range slice {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestGeneratorUniqueName(t *testing.T) {
+	g := &generator{names: make(map[string]bool)}
+
+	names := []struct {
+		resourceType, hint, expected string
+	}{
+		{"cloudflare_record", "www.example.com_A", "www_example_com_a"},
+		{"cloudflare_record", "www.example.com_A", "www_example_com_a_2"},
+		{"cloudflare_record", "www.example.com_A", "www_example_com_a_3"},
+		{"cloudflare_page_rule", "www.example.com_A", "www_example_com_a"},
+		{"cloudflare_record", "*.example.com_CNAME", "example_com_cname"},
+		{"cloudflare_record", "1.example.com_A", "_1_example_com_a"},
+		{"cloudflare_filter", "", "filter"},
+	}
+
+	for _, n := range names {
+		if name := g.uniqueName(n.resourceType, n.hint); name != n.expected {
+			t.Errorf("expected %q for %q but got %q", n.expected, n.hint, name)
+		}
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	if list := splitList(" a.com, ,b.com "); len(list) != 2 || list[0] != "a.com" || list[1] != "b.com" {
+		t.Errorf("unexpected list: %v", list)
+	}
+
+	if list := splitList(""); len(list) != 0 {
+		t.Errorf("unexpected list: %v", list)
+	}
+}
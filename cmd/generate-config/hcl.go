@@ -0,0 +1,232 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// minReferenceIDLength keeps short values like "on" from being mistaken for
+// the ID of another generated resource.
+const minReferenceIDLength = 16
+
+// renderer writes the state of resources as configuration using the schema
+// of the resource, so the configuration matches what the provider reads.
+type renderer struct {
+	// references maps the IDs of the generated resources to their address so
+	// values holding them are written as references.
+	references map[string]string
+
+	// variables collects the sensitive values which are written as variables
+	// instead of being put in the configuration.
+	variables []string
+}
+
+// writeResource appends the resource block of the resource to the body.
+func (r *renderer) writeResource(body *hclwrite.Body, resourceType, name string, s map[string]*schema.Schema, d *schema.ResourceData) {
+	values := make(map[string]interface{}, len(s))
+	for k := range s {
+		values[k] = d.Get(k)
+	}
+
+	block := body.AppendNewBlock("resource", []string{resourceType, name})
+	r.writeBody(block.Body(), s, values, []string{name}, fmt.Sprintf("%s.%s", resourceType, name))
+}
+
+func (r *renderer) writeBody(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]interface{}, path []string, self string) {
+	var attributes, blocks []string
+	for k, sch := range s {
+		if !shouldWriteAttribute(k, sch, values) {
+			continue
+		}
+
+		if _, ok := sch.Elem.(*schema.Resource); ok && (sch.Type == schema.TypeList || sch.Type == schema.TypeSet) {
+			blocks = append(blocks, k)
+		} else {
+			attributes = append(attributes, k)
+		}
+	}
+	sort.Strings(attributes)
+	sort.Strings(blocks)
+
+	for _, k := range attributes {
+		sch := s[k]
+		attrPath := append(append([]string{}, path...), k)
+
+		if sch.Sensitive {
+			variable := strings.Join(attrPath, "_")
+			r.variables = append(r.variables, variable)
+			body.SetAttributeTraversal(k, hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: variable},
+			})
+			continue
+		}
+
+		body.SetAttributeRaw(k, r.tokensForValue(sch, values[k], self))
+	}
+
+	for _, k := range blocks {
+		elem := s[k].Elem.(*schema.Resource)
+		for i, item := range listValues(values[k]) {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			itemPath := append(append([]string{}, path...), k)
+			if len(listValues(values[k])) > 1 {
+				itemPath = append(itemPath, fmt.Sprint(i))
+			}
+
+			body.AppendNewline()
+			block := body.AppendNewBlock(k, nil)
+			r.writeBody(block.Body(), elem.Schema, m, itemPath, self)
+		}
+	}
+}
+
+// shouldWriteAttribute leaves out computed attributes, deprecated ones and
+// optional ones that are empty or set to their default.
+func shouldWriteAttribute(k string, sch *schema.Schema, values map[string]interface{}) bool {
+	if k == "id" || sch.Deprecated != "" {
+		return false
+	}
+
+	if sch.Computed && !sch.Optional && !sch.Required {
+		return false
+	}
+
+	value := values[k]
+	if sch.Required {
+		return true
+	}
+
+	if sch.Default != nil {
+		return fmt.Sprint(sch.Default) != fmt.Sprint(value)
+	}
+
+	if isEmptyValue(value) {
+		return false
+	}
+
+	// Computed attributes that conflict with a configured one are derived from
+	// it, e.g. the `value` of a record with `data`.
+	if sch.Computed {
+		for _, conflict := range sch.ConflictsWith {
+			if other, ok := values[conflict]; ok && !isEmptyValue(other) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return len(listValues(value)) == 0
+	}
+}
+
+func listValues(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+func (r *renderer) tokensForValue(sch *schema.Schema, value interface{}, self string) hclwrite.Tokens {
+	switch sch.Type {
+	case schema.TypeList, schema.TypeSet:
+		elem, _ := sch.Elem.(*schema.Schema)
+		tokens := hclwrite.Tokens{{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")}}
+		for i, item := range listValues(value) {
+			if i > 0 {
+				tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")})
+			}
+			tokens = append(tokens, r.tokensForValue(elem, item, self)...)
+		}
+		return append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+	case schema.TypeMap:
+		m, _ := value.(map[string]interface{})
+		attrs := make(map[string]cty.Value, len(m))
+		for k, v := range m {
+			attrs[k] = primitiveValue(v)
+		}
+		return hclwrite.TokensForValue(cty.ObjectVal(attrs))
+	}
+
+	if s, ok := value.(string); ok {
+		if address, ok := r.references[s]; ok && len(s) >= minReferenceIDLength && address != self {
+			return hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: strings.SplitN(address, ".", 2)[0]},
+				hcl.TraverseAttr{Name: strings.SplitN(address, ".", 2)[1]},
+				hcl.TraverseAttr{Name: "id"},
+			})
+		}
+
+		if tokens := heredocTokens(s); tokens != nil {
+			return tokens
+		}
+	}
+
+	return hclwrite.TokensForValue(primitiveValue(value))
+}
+
+func primitiveValue(value interface{}) cty.Value {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	}
+	return cty.StringVal(fmt.Sprint(value))
+}
+
+// heredocTokens returns multi-line strings, such as scripts, as a heredoc.
+// Heredocs always end with a new line so other strings are left quoted.
+func heredocTokens(s string) hclwrite.Tokens {
+	if !strings.Contains(strings.TrimSuffix(s, "\n"), "\n") || !strings.HasSuffix(s, "\n") {
+		return nil
+	}
+
+	for _, line := range strings.Split(s, "\n") {
+		if strings.TrimSpace(line) == "EOT" {
+			return nil
+		}
+	}
+
+	escaped := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(s)
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenOHeredoc, Bytes: []byte("<<EOT\n")},
+		{Type: hclsyntax.TokenStringLit, Bytes: []byte(escaped)},
+		{Type: hclsyntax.TokenCHeredoc, Bytes: []byte("EOT")},
+	}
+}
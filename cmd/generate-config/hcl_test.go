@@ -0,0 +1,132 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var testResourceSchema = map[string]*schema.Schema{
+	"zone_id": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"value": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"data"},
+	},
+	"ttl": {
+		Type:     schema.TypeInt,
+		Optional: true,
+		Default:  1,
+	},
+	"proxied": {
+		Type:     schema.TypeBool,
+		Optional: true,
+	},
+	"comment": {
+		Type:       schema.TypeString,
+		Optional:   true,
+		Deprecated: "use `notes` instead",
+	},
+	"secret": {
+		Type:      schema.TypeString,
+		Optional:  true,
+		Sensitive: true,
+	},
+	"script": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"tags": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"data": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"port": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"target": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	},
+}
+
+func TestRendererWriteResource(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testResourceSchema, map[string]interface{}{
+		"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+		"name":    "_sip._tcp",
+		"value":   "10 5060 sip.example.com",
+		"ttl":     1,
+		"comment": "old",
+		"secret":  "hunter2",
+		"script":  "line ${one}\nline two\n",
+		"tags":    []interface{}{"b", "a"},
+		"data": []interface{}{
+			map[string]interface{}{"port": 5060, "target": "sip.example.com"},
+		},
+	})
+	d.Set("created_on", "2021-01-01T00:00:00Z")
+
+	r := &renderer{references: map[string]string{
+		"0da42c8d2132a9ddaf714f9e7c920711": "cloudflare_zone.example_com",
+	}}
+	f := hclwrite.NewEmptyFile()
+	r.writeResource(f.Body(), "cloudflare_record", "sip", testResourceSchema, d)
+
+	expected := `resource "cloudflare_record" "sip" {
+  name    = "_sip._tcp"
+  script  = <<EOT
+line $${one}
+line two
+EOT
+  secret  = var.sip_secret
+  tags    = ["a", "b"]
+  zone_id = cloudflare_zone.example_com.id
+
+  data {
+    port   = 5060
+    target = "sip.example.com"
+  }
+}
+`
+	if got := string(hclwrite.Format(f.Bytes())); got != expected {
+		t.Errorf("unexpected configuration:\n got:\n%s\nwant:\n%s", got, expected)
+	}
+
+	if len(r.variables) != 1 || r.variables[0] != "sip_secret" {
+		t.Errorf("unexpected variables: %v", r.variables)
+	}
+}
+
+func TestHeredocTokens(t *testing.T) {
+	for _, s := range []string{"single line", "no trailing\nnew line", "one line\n", "contains\nEOT\n"} {
+		if heredocTokens(s) != nil {
+			t.Errorf("%q should not be written as a heredoc", s)
+		}
+	}
+
+	if heredocTokens("two\nlines\n") == nil {
+		t.Error("multi-line strings should be written as a heredoc")
+	}
+}
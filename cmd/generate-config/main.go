@@ -0,0 +1,87 @@
+// Command generate-config writes Terraform configuration and import commands
+// for the resources that already exist in a Cloudflare account.
+//
+// It uses the provider itself to read the resources so the configuration
+// matches what the provider reads and `terraform plan` shows no changes after
+// running the generated imports. Credentials are read from the same
+// environment variables as the provider, e.g. CLOUDFLARE_API_TOKEN.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	provider "github.com/cloudflare/terraform-provider-cloudflare/cloudflare"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func main() {
+	var output, zones, accountID, types string
+
+	flag.StringVar(&output, "output", "generated", "directory to write the configuration to")
+	flag.StringVar(&zones, "zones", "", "comma separated names of the zones to generate, defaults to all zones")
+	flag.StringVar(&accountID, "account-id", os.Getenv("CLOUDFLARE_ACCOUNT_ID"), "account to generate the account level resources for")
+	flag.StringVar(&types, "resources", "", "comma separated resource types to generate, defaults to all supported types")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags]\n\nSupported resource types:\n", os.Args[0])
+		for _, source := range resourceSources {
+			fmt.Fprintf(flag.CommandLine.Output(), "  %s\n", source.resourceType)
+		}
+		fmt.Fprintf(flag.CommandLine.Output(), "\nFlags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(context.Background(), output, splitList(zones), accountID, splitList(types)); err != nil {
+		log.Fatalf("[ERROR] %s", err)
+	}
+}
+
+func run(ctx context.Context, output string, zoneNames []string, accountID string, types []string) error {
+	p := provider.Provider()
+
+	config := map[string]interface{}{}
+	if accountID != "" {
+		config["account_id"] = accountID
+	}
+
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		for _, diagnostic := range diags {
+			return fmt.Errorf("error configuring the provider: %s", diagnostic.Summary)
+		}
+	}
+
+	client := provider.APIClient(p.Meta())
+
+	zones, err := client.ListZones(ctx, zoneNames...)
+	if err != nil {
+		return fmt.Errorf("error listing zones: %w", err)
+	}
+
+	g := &generator{
+		provider:  p,
+		client:    client,
+		accountID: accountID,
+		zones:     zones,
+		types:     types,
+	}
+	g.run(ctx)
+
+	log.Printf("[INFO] Generating configuration for %d resources", len(g.resources))
+
+	return g.write(output)
+}
+
+func splitList(s string) []string {
+	var result []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
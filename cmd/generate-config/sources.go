@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+// importable is an existing resource found in the account or zone. ImportID
+// is the ID `terraform import` expects for the resource type and NameHint is
+// turned into the resource name.
+type importable struct {
+	ImportID string
+	NameHint string
+}
+
+// resourceSource lists the resources of a single type. Zone level resources
+// implement listZone and account level resources listAccount.
+type resourceSource struct {
+	resourceType string
+	listZone     func(ctx context.Context, client *cloudflare.API, zone cloudflare.Zone) ([]importable, error)
+	listAccount  func(ctx context.Context, client *cloudflare.API, accountID string) ([]importable, error)
+}
+
+// resourceSources are the supported resource types in the order they are
+// generated. Resources referenced by others come first.
+var resourceSources = []resourceSource{
+	{
+		resourceType: "cloudflare_zone",
+		listZone: func(ctx context.Context, client *cloudflare.API, zone cloudflare.Zone) ([]importable, error) {
+			return []importable{{ImportID: zone.ID, NameHint: zone.Name}}, nil
+		},
+	},
+	{
+		resourceType: "cloudflare_record",
+		listZone: func(ctx context.Context, client *cloudflare.API, zone cloudflare.Zone) ([]importable, error) {
+			records, err := client.DNSRecords(ctx, zone.ID, cloudflare.DNSRecord{})
+			if err != nil {
+				return nil, err
+			}
+
+			result := make([]importable, 0, len(records))
+			for _, record := range records {
+				result = append(result, importable{
+					ImportID: fmt.Sprintf("%s/%s", zone.ID, record.ID),
+					NameHint: fmt.Sprintf("%s_%s", record.Name, record.Type),
+				})
+			}
+			return result, nil
+		},
+	},
+	{
+		resourceType: "cloudflare_page_rule",
+		listZone: func(ctx context.Context, client *cloudflare.API, zone cloudflare.Zone) ([]importable, error) {
+			pageRules, err := client.ListPageRules(ctx, zone.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			result := make([]importable, 0, len(pageRules))
+			for _, pageRule := range pageRules {
+				hint := zone.Name
+				if len(pageRule.Targets) > 0 {
+					hint = fmt.Sprintf("%v", pageRule.Targets[0].Constraint.Value)
+				}
+				result = append(result, importable{
+					ImportID: fmt.Sprintf("%s/%s", zone.ID, pageRule.ID),
+					NameHint: hint,
+				})
+			}
+			return result, nil
+		},
+	},
+	{
+		resourceType: "cloudflare_filter",
+		listZone: func(ctx context.Context, client *cloudflare.API, zone cloudflare.Zone) ([]importable, error) {
+			filters, err := client.Filters(ctx, zone.ID, cloudflare.PaginationOptions{})
+			if err != nil {
+				return nil, err
+			}
+
+			result := make([]importable, 0, len(filters))
+			for _, filter := range filters {
+				result = append(result, importable{
+					ImportID: fmt.Sprintf("%s/%s", zone.ID, filter.ID),
+					NameHint: firstNonEmpty(filter.Description, zone.Name+"_filter"),
+				})
+			}
+			return result, nil
+		},
+	},
+	{
+		resourceType: "cloudflare_firewall_rule",
+		listZone: func(ctx context.Context, client *cloudflare.API, zone cloudflare.Zone) ([]importable, error) {
+			rules, err := client.FirewallRules(ctx, zone.ID, cloudflare.PaginationOptions{})
+			if err != nil {
+				return nil, err
+			}
+
+			result := make([]importable, 0, len(rules))
+			for _, rule := range rules {
+				result = append(result, importable{
+					ImportID: fmt.Sprintf("%s/%s", zone.ID, rule.ID),
+					NameHint: firstNonEmpty(rule.Description, zone.Name+"_firewall_rule"),
+				})
+			}
+			return result, nil
+		},
+	},
+	{
+		resourceType: "cloudflare_ruleset",
+		listZone: func(ctx context.Context, client *cloudflare.API, zone cloudflare.Zone) ([]importable, error) {
+			rulesets, err := client.ListZoneRulesets(ctx, zone.ID)
+			if err != nil {
+				return nil, err
+			}
+			return rulesetImportables("zone", zone.ID, rulesets), nil
+		},
+		listAccount: func(ctx context.Context, client *cloudflare.API, accountID string) ([]importable, error) {
+			rulesets, err := client.ListAccountRulesets(ctx, accountID)
+			if err != nil {
+				return nil, err
+			}
+			return rulesetImportables("account", accountID, rulesets), nil
+		},
+	},
+	{
+		resourceType: "cloudflare_load_balancer_monitor",
+		listAccount: func(ctx context.Context, client *cloudflare.API, accountID string) ([]importable, error) {
+			monitors, err := client.ListLoadBalancerMonitors(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			result := make([]importable, 0, len(monitors))
+			for _, monitor := range monitors {
+				result = append(result, importable{
+					ImportID: monitor.ID,
+					NameHint: firstNonEmpty(monitor.Description, monitor.Type+"_monitor"),
+				})
+			}
+			return result, nil
+		},
+	},
+	{
+		resourceType: "cloudflare_load_balancer_pool",
+		listAccount: func(ctx context.Context, client *cloudflare.API, accountID string) ([]importable, error) {
+			pools, err := client.ListLoadBalancerPools(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			result := make([]importable, 0, len(pools))
+			for _, pool := range pools {
+				result = append(result, importable{ImportID: pool.ID, NameHint: pool.Name})
+			}
+			return result, nil
+		},
+	},
+	{
+		resourceType: "cloudflare_load_balancer",
+		listZone: func(ctx context.Context, client *cloudflare.API, zone cloudflare.Zone) ([]importable, error) {
+			loadBalancers, err := client.ListLoadBalancers(ctx, zone.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			result := make([]importable, 0, len(loadBalancers))
+			for _, loadBalancer := range loadBalancers {
+				result = append(result, importable{
+					ImportID: fmt.Sprintf("%s/%s", zone.ID, loadBalancer.ID),
+					NameHint: loadBalancer.Name,
+				})
+			}
+			return result, nil
+		},
+	},
+	{
+		resourceType: "cloudflare_access_application",
+		listAccount: func(ctx context.Context, client *cloudflare.API, accountID string) ([]importable, error) {
+			var result []importable
+			for page := 1; ; page++ {
+				apps, info, err := client.AccessApplications(ctx, accountID, cloudflare.PaginationOptions{Page: page, PerPage: 50})
+				if err != nil {
+					return nil, err
+				}
+
+				for _, app := range apps {
+					result = append(result, importable{
+						ImportID: fmt.Sprintf("%s/%s", accountID, app.ID),
+						NameHint: app.Name,
+					})
+				}
+
+				if info.TotalPages <= page {
+					return result, nil
+				}
+			}
+		},
+	},
+	{
+		resourceType: "cloudflare_worker_script",
+		listAccount: func(ctx context.Context, client *cloudflare.API, accountID string) ([]importable, error) {
+			scripts, err := client.ListWorkerScripts(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			result := make([]importable, 0, len(scripts.WorkerList))
+			for _, script := range scripts.WorkerList {
+				result = append(result, importable{ImportID: script.ID, NameHint: script.ID})
+			}
+			return result, nil
+		},
+	},
+}
+
+// rulesetImportables skips the managed rulesets as they can only be deployed,
+// not changed.
+func rulesetImportables(identifierType, identifier string, rulesets []cloudflare.Ruleset) []importable {
+	result := make([]importable, 0, len(rulesets))
+	for _, ruleset := range rulesets {
+		if ruleset.Kind == string(cloudflare.RulesetKindManaged) {
+			continue
+		}
+		result = append(result, importable{
+			ImportID: fmt.Sprintf("%s/%s/%s", identifierType, identifier, ruleset.ID),
+			NameHint: firstNonEmpty(ruleset.Name, ruleset.Phase),
+		})
+	}
+	return result
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}